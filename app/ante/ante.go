@@ -7,6 +7,8 @@ import (
 	blobante "github.com/celestiaorg/celestia-app/v6/x/blob/ante"
 	blob "github.com/celestiaorg/celestia-app/v6/x/blob/keeper"
 	minfeekeeper "github.com/celestiaorg/celestia-app/v6/x/minfee/keeper"
+	nsregistryante "github.com/celestiaorg/celestia-app/v6/x/nsregistry/ante"
+	nsregistrykeeper "github.com/celestiaorg/celestia-app/v6/x/nsregistry/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -23,6 +25,7 @@ func NewAnteHandler(
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	channelKeeper *ibckeeper.Keeper,
 	minfeeKeeper *minfeekeeper.Keeper,
+	nsRegistryKeeper *nsregistrykeeper.Keeper,
 	circuitkeeper *circuitkeeper.Keeper,
 	paramFilters map[string]ParamFilter,
 ) sdk.AnteHandler {
@@ -62,6 +65,10 @@ func NewAnteHandler(
 		// account sequence number of the signer.
 		// Note: does not consume gas from the gas meter.
 		ante.NewSigVerificationDecorator(accountKeeper, signModeHandler),
+		// Side effect: routes the fractions of the fee of a PFB set by the blob
+		// params to a burn, to the community pool and to the fee recipient.
+		// Only active from the app version x/blob/types.FeeSplitAppVersion onwards.
+		blobante.NewFeeSplitDecorator(blobKeeper),
 		// Ensure that the tx does not contain a MsgExec with a nested MsgExec
 		// or MsgPayForBlobs.
 		NewMsgExecDecorator(),
@@ -69,6 +76,9 @@ func NewAnteHandler(
 		// Contract: must be called after all decorators that consume gas.
 		// Note: does not consume gas from the gas meter.
 		blobante.NewMinGasPFBDecorator(blobKeeper),
+		// Ensure that the signer of a MsgPayForBlobs is allowed to pay for
+		// blobs in the namespaces reserved in the namespace registry.
+		nsregistryante.NewReservationDecorator(nsRegistryKeeper),
 		// Ensure that the blob shares occupied by the tx <= the max shares
 		// available to blob data in a data square.
 		blobante.NewBlobShareDecorator(blobKeeper),
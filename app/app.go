@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -10,6 +11,7 @@ import (
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
@@ -31,7 +33,9 @@ import (
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	"github.com/celestiaorg/celestia-app/v6/app/ante"
 	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobusage"
 	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/squareplacement"
 	celestiatx "github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/pkg/proof"
@@ -44,6 +48,9 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/x/mint"
 	mintkeeper "github.com/celestiaorg/celestia-app/v6/x/mint/keeper"
 	minttypes "github.com/celestiaorg/celestia-app/v6/x/mint/types"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry"
+	nsregistrykeeper "github.com/celestiaorg/celestia-app/v6/x/nsregistry/keeper"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
 	"github.com/celestiaorg/celestia-app/v6/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v6/x/signal/types"
 	"github.com/celestiaorg/go-square/v2/share"
@@ -130,6 +137,8 @@ var maccPerms = map[string][]string{
 	authtypes.FeeCollectorName:     nil,
 	distrtypes.ModuleName:          nil,
 	govtypes.ModuleName:            {authtypes.Burner},
+	blobtypes.ModuleName:           {authtypes.Burner},
+	nsregistrytypes.ModuleName:     {authtypes.Burner},
 	minttypes.ModuleName:           {authtypes.Minter},
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
@@ -171,6 +180,7 @@ type App struct {
 	UpgradeKeeper       *upgradekeeper.Keeper // Upgrades are set in endblock when signaled
 	SignalKeeper        signal.Keeper
 	MinFeeKeeper        *minfeekeeper.Keeper
+	NsRegistryKeeper    *nsregistrykeeper.Keeper
 	ParamsKeeper        paramskeeper.Keeper
 	IBCKeeper           *ibckeeper.Keeper // IBCKeeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper      evidencekeeper.Keeper
@@ -194,6 +204,24 @@ type App struct {
 	// useful for testing purposes and should not be used on public networks
 	// (Arabica, Mocha, or Mainnet Beta).
 	timeoutCommit time.Duration
+	// txPrioritizer decides which blob txs are included in the proposals of
+	// this node and in which order.
+	txPrioritizer TxPrioritizer
+	// proposalTracer records the fate of the txs of prepared proposals and the
+	// reasons of rejected proposals.
+	proposalTracer *proposalTracer
+	// gasPriceHistory keeps the gas prices of recently committed blocks for
+	// the gas estimation service.
+	gasPriceHistory *gasestimation.GasPriceHistory
+	// blockTxsBytes is the total size of the txs of the block being finalized.
+	// It determines the square fullness used to adjust the network min gas
+	// price at the end of the block.
+	blockTxsBytes int
+	// blobUsageIndex records the blobs paid for per namespace and per signer
+	// if enabled. It is nil otherwise.
+	blobUsageIndex *blobusage.Index
+	// squarePlacementEnabled enables the square placement service.
+	squarePlacementEnabled bool
 }
 
 // New returns a reference to an uninitialized app. Callers must subsequently
@@ -258,7 +286,7 @@ func New(
 		encodingConfig.Codec, runtime.NewKVStoreService(keys[stakingtypes.StoreKey]), app.AccountKeeper, app.BankKeeper, govModuleAddr, encodingConfig.ValidatorAddressCodec, encodingConfig.ConsensusAddressCodec,
 	)
 
-	app.MintKeeper = mintkeeper.NewKeeper(encodingConfig.Codec, keys[minttypes.StoreKey], app.StakingKeeper, app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName)
+	app.MintKeeper = mintkeeper.NewKeeper(encodingConfig.Codec, keys[minttypes.StoreKey], app.StakingKeeper, app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, govModuleAddr, app.ModuleAccountAddrs())
 
 	app.DistrKeeper = distrkeeper.NewKeeper(encodingConfig.Codec, runtime.NewKVStoreService(keys[distrtypes.StoreKey]), app.AccountKeeper, app.BankKeeper, app.StakingKeeper, authtypes.FeeCollectorName, govModuleAddr)
 
@@ -293,6 +321,7 @@ func New(
 		encodingConfig.Codec,
 		keys[signaltypes.StoreKey],
 		app.StakingKeeper,
+		govModuleAddr,
 	)
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -362,11 +391,16 @@ func New(
 		encodingConfig.Codec,
 		keys[blobtypes.StoreKey],
 		app.GetSubspace(blobtypes.ModuleName),
+		app.BankKeeper,
+		app.DistrKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.ModuleAccountAddrs(),
 	)
 
 	app.MinFeeKeeper = minfeekeeper.NewKeeper(encodingConfig.Codec, keys[minfeetypes.StoreKey], app.ParamsKeeper, app.GetSubspace(minfeetypes.ModuleName), authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
+	app.NsRegistryKeeper = nsregistrykeeper.NewKeeper(encodingConfig.Codec, keys[nsregistrytypes.StoreKey], app.BankKeeper, govModuleAddr)
+
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 	ibcRouter := ibcporttypes.NewRouter()                                                   // Create static IBC router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)                          // Add transfer route
@@ -416,6 +450,7 @@ func New(
 		blob.NewAppModule(encodingConfig.Codec, app.BlobKeeper),
 		signal.NewAppModule(app.SignalKeeper),
 		minfee.NewAppModule(encodingConfig.Codec, app.MinFeeKeeper),
+		nsregistry.NewAppModule(encodingConfig.Codec, app.NsRegistryKeeper),
 		pfm{packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName))},
 		icaModule{ica.NewAppModule(nil, &app.ICAHostKeeper)}, // The first argument is nil because the ICA controller is not enabled on celestia-app.
 		// ensure the light client module types are registered.
@@ -443,6 +478,7 @@ func New(
 
 	app.CustomQueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
 	app.CustomQueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.CustomQueryRouter().AddRoute(proof.NamespaceAbsenceQueryPath, proof.QueryNamespaceAbsenceProof)
 
 	app.configurator = module.NewConfigurator(encodingConfig.Codec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	if err := app.ModuleManager.RegisterServices(app.configurator); err != nil {
@@ -473,6 +509,7 @@ func New(
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
 		app.MinFeeKeeper,
+		app.NsRegistryKeeper,
 		&app.CircuitKeeper,
 		app.GovParamFilters(),
 	))
@@ -491,6 +528,28 @@ func New(
 		}
 	}
 
+	app.txPrioritizer, err = NewTxPrioritizer(TxPrioritizationConfigFromAppOptions(appOpts), encodingConfig.TxConfig.TxDecoder())
+	if err != nil {
+		panic(err)
+	}
+	app.proposalTracer = newProposalTracer(logger, ProposalTraceConfigFromAppOptions(appOpts))
+	app.gasPriceHistory, err = openGasPriceHistory(appOpts, encodingConfig.TxConfig.TxDecoder())
+	if err != nil {
+		panic(err)
+	}
+	app.blobUsageIndex, err = openBlobUsageIndex(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	app.squarePlacementEnabled = SquarePlacementConfigFromAppOptions(appOpts).Enabled
+	// the listeners only serve queries so their errors are logged and the
+	// blocks are still finalized
+	listeners := []storetypes.ABCIListener{app.gasPriceHistory}
+	if app.blobUsageIndex != nil {
+		listeners = append(listeners, app.blobUsageIndex)
+	}
+	app.SetStreamingManager(storetypes.StreamingManager{ABCIListeners: listeners})
+
 	app.encodingConfig = encodingConfig
 	if err := app.LoadLatestVersion(); err != nil {
 		panic(err)
@@ -517,7 +576,11 @@ func (app *App) Info(req *abci.RequestInfo) (*abci.ResponseInfo, error) {
 }
 
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	app.blockTxsBytes = 0
+	for _, tx := range req.Txs {
+		app.blockTxsBytes += len(tx)
+	}
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -533,6 +596,10 @@ func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 		return sdk.EndBlock{}, err
 	}
 
+	// The network min gas price is adjusted after all txs of the block were
+	// executed so that they pay the price they were validated against.
+	app.MinFeeKeeper.AdjustNetworkMinGasPrice(ctx, app.squareFullness(ctx))
+
 	currentVersion, err := app.AppVersion(ctx)
 	if err != nil {
 		return sdk.EndBlock{}, err
@@ -603,6 +670,16 @@ func (app *App) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
 }
 
+// Close closes the app's databases.
+func (app *App) Close() error {
+	err := app.BaseApp.Close()
+	err = errors.Join(err, app.gasPriceHistory.Close())
+	if app.blobUsageIndex != nil {
+		err = errors.Join(err, app.blobUsageIndex.Close())
+	}
+	return err
+}
+
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *App) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
@@ -702,6 +779,8 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new celestia routes from grpc-gateway.
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	proof.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	blobusage.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register grpc-gateway routes for all modules.
 	app.BasicManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
@@ -710,7 +789,14 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.encodingConfig.InterfaceRegistry)
 	celestiatx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.InterfaceRegistry)
-	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.Simulate, app.getMinGasPrice)
+	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.Simulate, app.getMinGasPrice, app.getGasParams, app.getStateAccessGas, app.gasPriceHistory)
+	fillSquareFn := app.fillSquare
+	if !app.squarePlacementEnabled {
+		fillSquareFn = nil
+	}
+	squareplacement.RegisterSquarePlacementService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), fillSquareFn, app.LastBlockHeight)
+	proof.RegisterQueryService(app.GRPCQueryRouter(), clientCtx)
+	blobusage.RegisterBlobUsageService(app.GRPCQueryRouter(), app.blobUsageIndex)
 }
 
 func (app *App) getGovMaxSquareBytes() (uint64, error) {
@@ -722,6 +808,18 @@ func (app *App) getGovMaxSquareBytes() (uint64, error) {
 	return maxSquareSize * maxSquareSize * share.ShareSize, nil
 }
 
+// squareFullness returns the fraction of the governance max square filled by
+// the txs of the block being finalized.
+func (app *App) squareFullness(ctx sdk.Context) sdkmath.LegacyDec {
+	maxSquareSize := app.BlobKeeper.GetParams(ctx).GovMaxSquareSize
+	maxSquareBytes := int64(maxSquareSize * maxSquareSize * share.ShareSize)
+	if maxSquareBytes == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+	fullness := sdkmath.LegacyNewDec(int64(app.blockTxsBytes)).QuoInt64(maxSquareBytes)
+	return sdkmath.LegacyMinDec(fullness, sdkmath.LegacyOneDec())
+}
+
 // getMinGasPrice is used by the gas estimation service to get the higher of the network minimum gas price
 // or the nodes locally configured minimum gas price.
 func (app *App) getMinGasPrice() (float64, error) {
@@ -733,11 +831,26 @@ func (app *App) getMinGasPrice() (float64, error) {
 	if err != nil {
 		return localMinGasPrice, err
 	}
-	params := app.MinFeeKeeper.GetParams(ctx)
-	networkMinGasPrice := params.NetworkMinGasPrice.MustFloat64()
+	networkMinGasPrice := app.MinFeeKeeper.GetNetworkMinGasPrice(ctx).MustFloat64()
 	return math.Max(networkMinGasPrice, localMinGasPrice), nil
 }
 
+// getGasParams is used by the gas estimation service to get the parameters that
+// determine the gas consumed by a PayForBlobs.
+func (app *App) getGasParams() (gasestimation.GasParams, error) {
+	ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+	if err != nil {
+		return gasestimation.GasParams{}, err
+	}
+	authParams := app.AccountKeeper.GetParams(ctx)
+	return gasestimation.GasParams{
+		GasPerBlobByte:         app.BlobKeeper.GetParams(ctx).GasPerBlobByte,
+		TxSizeCostPerByte:      authParams.TxSizeCostPerByte,
+		SigVerifyCostSecp256k1: authParams.SigVerifyCostSecp256k1,
+		SigVerifyCostSecp256r1: authParams.SigVerifyCostSecp256r1(),
+	}, nil
+}
+
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(clientCtx, app.GRPCQueryRouter(), app.encodingConfig.InterfaceRegistry, app.Query)
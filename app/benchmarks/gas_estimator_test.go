@@ -73,6 +73,9 @@ func BenchmarkGasPriceEstimation(b *testing.B) {
 				func() (uint64, error) { return 128 * 128 * share.ContinuationSparseShareContentSize, nil },
 				func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) { return sdk.GasInfo{}, nil, nil },
 				func() (float64, error) { return appconsts.DefaultNetworkMinGasPrice, nil },
+				nil,
+				nil,
+				nil,
 			)
 			for i := 0; i < b.N; i++ {
 				_, err := gasEstimationServer.EstimateGasPrice(context.Background(), &gasestimation.EstimateGasPriceRequest{})
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobusage"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const FlagBlobUsageIndexEnabled = "blob-usage-index.enabled"
+
+// BlobUsageIndexConfigTemplate is the app.toml template of the
+// BlobUsageIndexConfig.
+const BlobUsageIndexConfigTemplate = `
+###############################################################################
+###                      Blob Usage Index Configuration                     ###
+###############################################################################
+
+# The blob usage index records the blobs paid for by PayForBlobs per namespace
+# and per signer for every block committed while it is enabled. It is stored
+# in the node's data directory and served by the BlobUsage gRPC service.
+[blob-usage-index]
+
+# Enabled turns on the index.
+enabled = {{ .BlobUsageIndex.Enabled }}
+`
+
+// BlobUsageIndexConfig configures the blob usage index of the node.
+type BlobUsageIndexConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// DefaultBlobUsageIndexConfig returns the config with the index disabled.
+func DefaultBlobUsageIndexConfig() BlobUsageIndexConfig {
+	return BlobUsageIndexConfig{}
+}
+
+// BlobUsageIndexConfigFromAppOptions reads the BlobUsageIndexConfig from the
+// app options, falling back to the defaults for unset options.
+func BlobUsageIndexConfigFromAppOptions(appOpts servertypes.AppOptions) BlobUsageIndexConfig {
+	cfg := DefaultBlobUsageIndexConfig()
+	cfg.Enabled = cast.ToBool(appOpts.Get(FlagBlobUsageIndexEnabled))
+	return cfg
+}
+
+// openBlobUsageIndex opens the blob usage index in the node's data directory.
+// It returns nil if the index is disabled.
+func openBlobUsageIndex(appOpts servertypes.AppOptions) (*blobusage.Index, error) {
+	if !BlobUsageIndexConfigFromAppOptions(appOpts).Enabled {
+		return nil, nil
+	}
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB(blobusage.DBName, server.GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		return nil, err
+	}
+	return blobusage.NewIndex(db), nil
+}
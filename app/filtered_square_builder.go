@@ -14,9 +14,12 @@ import (
 // FilteredSquareBuilder filters txs and blobs using a copy of the state and tx validity
 // rules before adding it the square.
 type FilteredSquareBuilder struct {
-	handler  sdk.AnteHandler
-	txConfig client.TxConfig
-	builder  *square.Builder
+	handler       sdk.AnteHandler
+	txConfig      client.TxConfig
+	builder       *square.Builder
+	prioritizer   TxPrioritizer
+	maxSquareSize int
+	txTraces      []TxTrace
 }
 
 func NewFilteredSquareBuilder(
@@ -30,12 +33,21 @@ func NewFilteredSquareBuilder(
 		return nil, err
 	}
 	return &FilteredSquareBuilder{
-		handler:  handler,
-		txConfig: txConfig,
-		builder:  builder,
+		handler:       handler,
+		txConfig:      txConfig,
+		builder:       builder,
+		prioritizer:   &txPrioritizer{cfg: DefaultTxPrioritizationConfig()},
+		maxSquareSize: maxSquareSize,
 	}, nil
 }
 
+// SetTxPrioritizer sets the TxPrioritizer that decides which blob txs Fill
+// considers and in which order. By default, blob txs are considered in the
+// order they are passed to Fill.
+func (fsb *FilteredSquareBuilder) SetTxPrioritizer(prioritizer TxPrioritizer) {
+	fsb.prioritizer = prioritizer
+}
+
 func (fsb *FilteredSquareBuilder) Build() (square.Square, error) {
 	return fsb.builder.Export()
 }
@@ -44,11 +56,34 @@ func (fsb *FilteredSquareBuilder) Builder() *square.Builder {
 	return fsb.builder
 }
 
+// TxTraces returns the fate of each tx passed to Fill.
+func (fsb *FilteredSquareBuilder) TxTraces() []TxTrace {
+	return fsb.txTraces
+}
+
+func (fsb *FilteredSquareBuilder) trace(tx []byte, fate TxFate, err error) {
+	trace := TxTrace{
+		Hash: tmbytes.HexBytes(coretypes.Tx(tx).Hash()).String(),
+		Fate: fate,
+	}
+	if err != nil {
+		trace.Error = err.Error()
+	}
+	fsb.txTraces = append(fsb.txTraces, trace)
+}
+
 func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 	logger := ctx.Logger().With("app/filtered-square-builder")
 
 	// note that there is an additional filter step for tx size of raw txs here
-	normalTxs, blobTxs := separateTxs(fsb.txConfig, txs)
+	normalTxs, blobTxs, tooLargeTxs := separateTxs(fsb.txConfig, txs)
+	for _, rawTx := range tooLargeTxs {
+		// blob txs are identified by the hash of the tx without the blobs
+		if bTx, isBlob, err := tx.UnmarshalBlobTx(rawTx); isBlob && err == nil {
+			rawTx = bTx.Tx
+		}
+		fsb.trace(rawTx, TxFateTooLarge, nil)
+	}
 
 	var (
 		nonPFBMessageCount = 0
@@ -62,6 +97,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		sdkTx, err := dec(tx)
 		if err != nil {
 			logger.Error("decoding already checked transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()), "error", err)
+			fsb.trace(tx, TxFateDecodeFailed, err)
 			continue
 		}
 
@@ -71,11 +107,13 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		msgTypes := msgTypes(sdkTx)
 		if nonPFBMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxNonPFBMessages {
 			logger.Debug("skipping tx because the max non PFB message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+			fsb.trace(tx, TxFateOverMessageCap, nil)
 			continue
 		}
 
 		if !fsb.builder.AppendTx(tx) {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+			fsb.trace(tx, TxFateTooLarge, nil)
 			continue
 		}
 
@@ -91,6 +129,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 				"msgs", msgTypes,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_std_txs")
+			fsb.trace(tx, TxFateAnteFailed, err)
 			err = fsb.builder.RevertLastTx()
 			if err != nil {
 				logger.Error("reverting last transaction", "error", err)
@@ -99,14 +138,18 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		}
 
 		nonPFBMessageCount += len(sdkTx.GetMsgs())
+		fsb.trace(tx, TxFateIncluded, nil)
 		normalTxs[n] = tx
 		n++
 	}
 
+	blobTxs = fsb.prioritizer.PrioritizeBlobTxs(blobTxs)
+	usage := newSquareUsage(fsb.maxSquareSize)
 	for _, tx := range blobTxs {
 		sdkTx, err := dec(tx.Tx)
 		if err != nil {
 			logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
+			fsb.trace(tx.Tx, TxFateDecodeFailed, err)
 			continue
 		}
 
@@ -115,11 +158,28 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 
 		if pfbMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxPFBMessages {
 			logger.Debug("skipping blob tx because the max pfb message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.trace(tx.Tx, TxFateOverMessageCap, nil)
 			continue
 		}
 
+		sizeBefore := fsb.builder.CurrentSize()
 		if !fsb.builder.AppendBlobTx(tx) {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.trace(tx.Tx, TxFateTooLarge, nil)
+			continue
+		}
+
+		// the builder's size change includes the padding and the compact
+		// shares of the PFB tx, not only the blob shares
+		shares := fsb.builder.CurrentSize() - sizeBefore
+		usage.add(tx, shares)
+		if !fsb.prioritizer.AdmitBlobTx(tx, *usage) {
+			logger.Debug("skipping blob tx because it was not admitted by the tx prioritizer", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.trace(tx.Tx, TxFateNotAdmitted, nil)
+			usage.remove(tx, shares)
+			if err := fsb.builder.RevertLastBlobTx(); err != nil {
+				logger.Error("reverting last blob transaction failed", "error", err)
+			}
 			continue
 		}
 
@@ -132,6 +192,8 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 				"filtering already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_blob_txs")
+			fsb.trace(tx.Tx, TxFateAnteFailed, err)
+			usage.remove(tx, shares)
 			err = fsb.builder.RevertLastBlobTx()
 			if err != nil {
 				logger.Error("reverting last blob transaction failed", "error", err)
@@ -140,6 +202,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		}
 
 		pfbMessageCount += len(sdkTx.GetMsgs())
+		fsb.trace(tx.Tx, TxFateIncluded, nil)
 		blobTxs[m] = tx
 		m++
 	}
@@ -171,15 +234,18 @@ func encodeBlobTxs(blobTxs []*tx.BlobTx) [][]byte {
 	return txs
 }
 
-// separateTxs decodes raw tendermint txs into normal and blob txs.
-func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []*tx.BlobTx) {
+// separateTxs decodes raw tendermint txs into normal and blob txs. Txs that
+// exceed the max tx size are returned separately.
+func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []*tx.BlobTx, [][]byte) {
 	normalTxs := make([][]byte, 0, len(rawTxs))
 	blobTxs := make([]*tx.BlobTx, 0, len(rawTxs))
+	var tooLargeTxs [][]byte
 	for _, rawTx := range rawTxs {
 		// this check in theory shouldn't get hit, as txs should be filtered
 		// in CheckTx. However in tests we're inserting too large of txs
 		// therefore also filter here.
 		if len(rawTx) > appconsts.MaxTxSize {
+			tooLargeTxs = append(tooLargeTxs, rawTx)
 			continue
 		}
 
@@ -193,5 +259,5 @@ func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []*tx.BlobTx) {
 			normalTxs = append(normalTxs, rawTx)
 		}
 	}
-	return normalTxs, blobTxs
+	return normalTxs, blobTxs, tooLargeTxs
 }
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+)
+
+// openGasPriceHistory opens the gas price history in the node's data
+// directory. Without a home directory, e.g. for an app that only serves
+// tests, the history is kept in memory.
+func openGasPriceHistory(appOpts servertypes.AppOptions, txDecoder sdk.TxDecoder) (*gasestimation.GasPriceHistory, error) {
+	var db dbm.DB = dbm.NewMemDB()
+	if home := cast.ToString(appOpts.Get(flags.FlagHome)); home != "" {
+		var err error
+		db, err = dbm.NewDB(gasestimation.GasPriceHistoryDBName, server.GetAppDBBackend(appOpts), filepath.Join(home, "data"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return gasestimation.NewGasPriceHistory(db, txDecoder, gasestimation.DefaultGasPriceHistorySize)
+}
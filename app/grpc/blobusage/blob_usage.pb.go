@@ -0,0 +1,2327 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/blob_usage/blob_usage.proto
+
+package blobusage
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Usage the totals of the blobs paid for by PayForBlobs.
+type Usage struct {
+	// pfb_count the number of PayForBlobs.
+	PfbCount uint64 `protobuf:"varint,1,opt,name=pfb_count,json=pfbCount,proto3" json:"pfb_count,omitempty"`
+	// blob_count the number of blobs.
+	BlobCount uint64 `protobuf:"varint,2,opt,name=blob_count,json=blobCount,proto3" json:"blob_count,omitempty"`
+	// blob_bytes the total size of the blobs.
+	BlobBytes uint64 `protobuf:"varint,3,opt,name=blob_bytes,json=blobBytes,proto3" json:"blob_bytes,omitempty"`
+	// shares the number of shares occupied by the blobs, assuming share version
+	// zero.
+	Shares uint64 `protobuf:"varint,4,opt,name=shares,proto3" json:"shares,omitempty"`
+	// fees the fees paid by the PayForBlobs. The fee of a PayForBlobs with blobs
+	// in several namespaces is split between them proportionally to their
+	// shares.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *Usage) Reset()         { *m = Usage{} }
+func (m *Usage) String() string { return proto.CompactTextString(m) }
+func (*Usage) ProtoMessage()    {}
+func (*Usage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87df4128ddc352ad, []int{0}
+}
+func (m *Usage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Usage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Usage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Usage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Usage.Merge(m, src)
+}
+func (m *Usage) XXX_Size() int {
+	return m.Size()
+}
+func (m *Usage) XXX_DiscardUnknown() {
+	xxx_messageInfo_Usage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Usage proto.InternalMessageInfo
+
+func (m *Usage) GetPfbCount() uint64 {
+	if m != nil {
+		return m.PfbCount
+	}
+	return 0
+}
+
+func (m *Usage) GetBlobCount() uint64 {
+	if m != nil {
+		return m.BlobCount
+	}
+	return 0
+}
+
+func (m *Usage) GetBlobBytes() uint64 {
+	if m != nil {
+		return m.BlobBytes
+	}
+	return 0
+}
+
+func (m *Usage) GetShares() uint64 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *Usage) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// NamespaceUsageRequest the request for the usage of a namespace. A zero
+// from_height starts at the first indexed height and a zero to_height ends at
+// the last indexed height.
+type NamespaceUsageRequest struct {
+	Namespace  []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FromHeight int64  `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64  `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *NamespaceUsageRequest) Reset()         { *m = NamespaceUsageRequest{} }
+func (m *NamespaceUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*NamespaceUsageRequest) ProtoMessage()    {}
+func (*NamespaceUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87df4128ddc352ad, []int{1}
+}
+func (m *NamespaceUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceUsageRequest.Merge(m, src)
+}
+func (m *NamespaceUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceUsageRequest proto.InternalMessageInfo
+
+func (m *NamespaceUsageRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *NamespaceUsageRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *NamespaceUsageRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// NamespaceUsageResponse the usage of a namespace in the indexed heights of
+// the requested range.
+type NamespaceUsageResponse struct {
+	Usage      Usage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *NamespaceUsageResponse) Reset()         { *m = NamespaceUsageResponse{} }
+func (m *NamespaceUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*NamespaceUsageResponse) ProtoMessage()    {}
+func (*NamespaceUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87df4128ddc352ad, []int{2}
+}
+func (m *NamespaceUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceUsageResponse.Merge(m, src)
+}
+func (m *NamespaceUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceUsageResponse proto.InternalMessageInfo
+
+func (m *NamespaceUsageResponse) GetUsage() Usage {
+	if m != nil {
+		return m.Usage
+	}
+	return Usage{}
+}
+
+func (m *NamespaceUsageResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *NamespaceUsageResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// SignerUsageRequest the request for the usage of a signer. A zero
+// from_height starts at the first indexed height and a zero to_height ends at
+// the last indexed height.
+type SignerUsageRequest struct {
+	Signer     string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	FromHeight int64  `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64  `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *SignerUsageRequest) Reset()         { *m = SignerUsageRequest{} }
+func (m *SignerUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerUsageRequest) ProtoMessage()    {}
+func (*SignerUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87df4128ddc352ad, []int{3}
+}
+func (m *SignerUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerUsageRequest.Merge(m, src)
+}
+func (m *SignerUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerUsageRequest proto.InternalMessageInfo
+
+func (m *SignerUsageRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *SignerUsageRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *SignerUsageRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// SignerUsageResponse the usage of a signer in the indexed heights of the
+// requested range.
+type SignerUsageResponse struct {
+	Usage      Usage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *SignerUsageResponse) Reset()         { *m = SignerUsageResponse{} }
+func (m *SignerUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerUsageResponse) ProtoMessage()    {}
+func (*SignerUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87df4128ddc352ad, []int{4}
+}
+func (m *SignerUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerUsageResponse.Merge(m, src)
+}
+func (m *SignerUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerUsageResponse proto.InternalMessageInfo
+
+func (m *SignerUsageResponse) GetUsage() Usage {
+	if m != nil {
+		return m.Usage
+	}
+	return Usage{}
+}
+
+func (m *SignerUsageResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *SignerUsageResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// TopNamespacesRequest the request for the namespaces that occupied the most
+// shares in the last_blocks last indexed blocks. Zero values use the defaults
+// of 100 blocks and 10 namespaces.
+type TopNamespacesRequest struct {
+	LastBlocks uint64 `protobuf:"varint,1,opt,name=last_blocks,json=lastBlocks,proto3" json:"last_blocks,omitempty"`
+	Limit      uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *TopNamespacesRequest) Reset()         { *m = TopNamespacesRequest{} }
+func (m *TopNamespacesRequest) String() string { return proto.CompactTextString(m) }
+func (*TopNamespacesRequest) ProtoMessage()    {}
+func (*TopNamespacesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87df4128ddc352ad, []int{5}
+}
+func (m *TopNamespacesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopNamespacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopNamespacesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopNamespacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopNamespacesRequest.Merge(m, src)
+}
+func (m *TopNamespacesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopNamespacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopNamespacesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopNamespacesRequest proto.InternalMessageInfo
+
+func (m *TopNamespacesRequest) GetLastBlocks() uint64 {
+	if m != nil {
+		return m.LastBlocks
+	}
+	return 0
+}
+
+func (m *TopNamespacesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// NamespaceUsage the usage of a namespace.
+type NamespaceUsage struct {
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Usage     Usage  `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *NamespaceUsage) Reset()         { *m = NamespaceUsage{} }
+func (m *NamespaceUsage) String() string { return proto.CompactTextString(m) }
+func (*NamespaceUsage) ProtoMessage()    {}
+func (*NamespaceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87df4128ddc352ad, []int{6}
+}
+func (m *NamespaceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceUsage.Merge(m, src)
+}
+func (m *NamespaceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceUsage proto.InternalMessageInfo
+
+func (m *NamespaceUsage) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *NamespaceUsage) GetUsage() Usage {
+	if m != nil {
+		return m.Usage
+	}
+	return Usage{}
+}
+
+// TopNamespacesResponse the namespaces ordered by the shares they occupied,
+// most first.
+type TopNamespacesResponse struct {
+	Namespaces []NamespaceUsage `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces"`
+	FromHeight int64            `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64            `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *TopNamespacesResponse) Reset()         { *m = TopNamespacesResponse{} }
+func (m *TopNamespacesResponse) String() string { return proto.CompactTextString(m) }
+func (*TopNamespacesResponse) ProtoMessage()    {}
+func (*TopNamespacesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87df4128ddc352ad, []int{7}
+}
+func (m *TopNamespacesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopNamespacesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopNamespacesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopNamespacesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopNamespacesResponse.Merge(m, src)
+}
+func (m *TopNamespacesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopNamespacesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopNamespacesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopNamespacesResponse proto.InternalMessageInfo
+
+func (m *TopNamespacesResponse) GetNamespaces() []NamespaceUsage {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *TopNamespacesResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *TopNamespacesResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Usage)(nil), "celestia.core.v1.blob_usage.Usage")
+	proto.RegisterType((*NamespaceUsageRequest)(nil), "celestia.core.v1.blob_usage.NamespaceUsageRequest")
+	proto.RegisterType((*NamespaceUsageResponse)(nil), "celestia.core.v1.blob_usage.NamespaceUsageResponse")
+	proto.RegisterType((*SignerUsageRequest)(nil), "celestia.core.v1.blob_usage.SignerUsageRequest")
+	proto.RegisterType((*SignerUsageResponse)(nil), "celestia.core.v1.blob_usage.SignerUsageResponse")
+	proto.RegisterType((*TopNamespacesRequest)(nil), "celestia.core.v1.blob_usage.TopNamespacesRequest")
+	proto.RegisterType((*NamespaceUsage)(nil), "celestia.core.v1.blob_usage.NamespaceUsage")
+	proto.RegisterType((*TopNamespacesResponse)(nil), "celestia.core.v1.blob_usage.TopNamespacesResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/blob_usage/blob_usage.proto", fileDescriptor_87df4128ddc352ad)
+}
+
+var fileDescriptor_87df4128ddc352ad = []byte{
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8e, 0x9b, 0xa4, 0x6a, 0x4e, 0xfe, 0xfe, 0x8b, 0xa1, 0xad, 0x42, 0x5a, 0x9c, 0xca, 0x0b,
+	0x14, 0xa9, 0xad, 0xa7, 0x49, 0x11, 0x4b, 0x16, 0xe9, 0x86, 0x0d, 0x08, 0x0c, 0x6c, 0xd8, 0x44,
+	0xb6, 0x99, 0x38, 0xa6, 0x8e, 0x8f, 0xf1, 0x4c, 0x2a, 0x55, 0x88, 0x0d, 0x0f, 0x80, 0x90, 0x2a,
+	0xf1, 0x08, 0x88, 0xcb, 0x8b, 0x74, 0x59, 0x89, 0x0d, 0x12, 0x12, 0xa0, 0x96, 0x07, 0x41, 0x33,
+	0xe3, 0xdc, 0x5a, 0x64, 0x28, 0xdd, 0xb0, 0x88, 0x3c, 0x73, 0xbe, 0x39, 0xe7, 0xfb, 0xce, 0x65,
+	0x26, 0xb0, 0xe9, 0xb3, 0x88, 0x71, 0x11, 0xba, 0xd4, 0xc7, 0x94, 0xd1, 0xfd, 0x16, 0xf5, 0x22,
+	0xf4, 0xba, 0x43, 0xee, 0x06, 0x6c, 0x6a, 0x69, 0x27, 0x29, 0x0a, 0x24, 0xab, 0xa3, 0xd3, 0xb6,
+	0x3c, 0x6d, 0xef, 0xb7, 0xec, 0xc9, 0x91, 0xfa, 0x52, 0x80, 0x01, 0xaa, 0x73, 0x54, 0xae, 0xb4,
+	0x4b, 0x7d, 0x2d, 0x40, 0x0c, 0x22, 0x46, 0xdd, 0x24, 0xa4, 0x6e, 0x1c, 0xa3, 0x70, 0x45, 0x88,
+	0x31, 0xcf, 0x50, 0xd3, 0x47, 0x3e, 0x40, 0x4e, 0x3d, 0x97, 0x4b, 0x72, 0x8f, 0x09, 0xb7, 0x45,
+	0x7d, 0x0c, 0x63, 0x8d, 0x5b, 0x5f, 0x0c, 0x28, 0x3f, 0x92, 0xd1, 0xc9, 0x2a, 0x54, 0x92, 0x9e,
+	0xd7, 0xf5, 0x71, 0x18, 0x8b, 0x9a, 0xb1, 0x6e, 0x34, 0x4b, 0xce, 0x42, 0xd2, 0xf3, 0x76, 0xe5,
+	0x9e, 0x5c, 0x03, 0x50, 0x42, 0x34, 0x3a, 0xa7, 0xd0, 0x8a, 0xb4, 0xcc, 0xc2, 0xde, 0x81, 0x60,
+	0xbc, 0x56, 0x9c, 0xc0, 0x1d, 0x69, 0x20, 0x2b, 0x30, 0xcf, 0xfb, 0x6e, 0xca, 0x78, 0xad, 0xa4,
+	0xa0, 0x6c, 0x47, 0xba, 0x50, 0xea, 0x31, 0xc6, 0x6b, 0xe5, 0xf5, 0x62, 0xb3, 0xda, 0xbe, 0x6a,
+	0x6b, 0xad, 0xb6, 0xd4, 0x6a, 0x67, 0x5a, 0xed, 0x5d, 0x0c, 0xe3, 0xce, 0xf6, 0xd1, 0xd7, 0x46,
+	0xe1, 0xc3, 0xb7, 0x46, 0x33, 0x08, 0x45, 0x7f, 0xe8, 0xd9, 0x3e, 0x0e, 0x68, 0x96, 0x98, 0xfe,
+	0x6c, 0xf1, 0x27, 0x7b, 0x54, 0x1c, 0x24, 0x8c, 0x2b, 0x07, 0xee, 0xa8, 0xc0, 0x16, 0x87, 0xe5,
+	0xbb, 0xee, 0x80, 0xf1, 0xc4, 0xf5, 0x99, 0xca, 0xd2, 0x61, 0xcf, 0x86, 0x8c, 0x0b, 0xb2, 0x06,
+	0x95, 0x78, 0x04, 0xa8, 0x64, 0xff, 0x73, 0x26, 0x06, 0xd2, 0x80, 0x6a, 0x2f, 0xc5, 0x41, 0xb7,
+	0xcf, 0xc2, 0xa0, 0xaf, 0xd3, 0x2d, 0x3a, 0x20, 0x4d, 0xb7, 0x95, 0x45, 0xd6, 0x4a, 0xe0, 0x08,
+	0x2e, 0x2a, 0x78, 0x41, 0xa0, 0x06, 0xad, 0x37, 0x06, 0xac, 0x9c, 0x65, 0xe5, 0x09, 0xc6, 0x9c,
+	0x91, 0x5b, 0x50, 0x56, 0xad, 0x54, 0x94, 0xd5, 0xb6, 0x65, 0xe7, 0xb4, 0xdb, 0x56, 0xae, 0x9d,
+	0x92, 0x4c, 0xdd, 0xd1, 0x6e, 0x97, 0x14, 0xf6, 0x14, 0xc8, 0x83, 0x30, 0x88, 0x59, 0x3a, 0x53,
+	0x0a, 0xd9, 0x1c, 0x65, 0x55, 0xa2, 0x2a, 0x4e, 0xb6, 0xbb, 0x24, 0xd7, 0xa1, 0x01, 0x57, 0x66,
+	0xc8, 0xfe, 0x89, 0x0a, 0xdc, 0x81, 0xa5, 0x87, 0x98, 0x8c, 0x9b, 0xc3, 0x47, 0x35, 0x68, 0x40,
+	0x35, 0x72, 0xb9, 0xe8, 0x7a, 0x11, 0xfa, 0x7b, 0x3c, 0x9b, 0x7e, 0x90, 0xa6, 0x8e, 0xb2, 0x90,
+	0x25, 0x28, 0x47, 0xe1, 0x20, 0xd4, 0x84, 0x8b, 0x8e, 0xde, 0x58, 0x31, 0xfc, 0x3f, 0xdb, 0xe8,
+	0xdf, 0xcc, 0xd5, 0x38, 0xf9, 0xb9, 0xbf, 0x4a, 0xde, 0x7a, 0x67, 0xc0, 0xf2, 0x19, 0xfd, 0x59,
+	0x59, 0xef, 0x03, 0x8c, 0x69, 0xa4, 0x7e, 0x79, 0x9f, 0x36, 0x72, 0xc3, 0xcf, 0x0a, 0xcf, 0x78,
+	0xa6, 0x82, 0x5c, 0xae, 0xd2, 0xed, 0x57, 0x25, 0xa8, 0x74, 0x22, 0xf4, 0x74, 0x59, 0xde, 0x1b,
+	0xe7, 0x2a, 0xd5, 0xbe, 0x80, 0xba, 0xac, 0x4d, 0xf5, 0x9d, 0x0b, 0xf9, 0xe8, 0xd2, 0x58, 0xf6,
+	0xcb, 0x4f, 0x3f, 0x0e, 0xe7, 0x9a, 0xe4, 0x3a, 0xcd, 0x7b, 0x89, 0x27, 0x4d, 0x7a, 0x6b, 0x40,
+	0x75, 0x6a, 0x72, 0x09, 0xcd, 0x25, 0x3d, 0x7f, 0xa1, 0xea, 0xdb, 0x7f, 0xee, 0x90, 0x49, 0xbc,
+	0xa1, 0x24, 0xda, 0x64, 0x33, 0x57, 0xa2, 0xbe, 0x97, 0xf4, 0xb9, 0xfe, 0xbe, 0x20, 0x1f, 0x0d,
+	0x58, 0x9c, 0x99, 0x06, 0xd2, 0xca, 0x65, 0xfe, 0xd5, 0xe4, 0xd7, 0xdb, 0x17, 0x71, 0xc9, 0xe4,
+	0xee, 0x28, 0xb9, 0x5b, 0x64, 0x23, 0x57, 0xae, 0xc0, 0xa4, 0x3b, 0x19, 0xa7, 0xce, 0xbd, 0xa3,
+	0x13, 0xd3, 0x38, 0x3e, 0x31, 0x8d, 0xef, 0x27, 0xa6, 0xf1, 0xfa, 0xd4, 0x2c, 0x1c, 0x9f, 0x9a,
+	0x85, 0xcf, 0xa7, 0x66, 0xe1, 0xf1, 0xcd, 0xe9, 0x47, 0x3d, 0x0b, 0x88, 0x69, 0x30, 0x5e, 0x6f,
+	0xb9, 0x49, 0x42, 0xe5, 0x2f, 0x48, 0x13, 0x5f, 0x31, 0x28, 0x02, 0x6f, 0x5e, 0xfd, 0x83, 0xed,
+	0xfc, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xba, 0x62, 0x16, 0x62, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlobUsageClient is the client API for BlobUsage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlobUsageClient interface {
+	// NamespaceUsage returns the blobs posted to a namespace between two
+	// heights.
+	NamespaceUsage(ctx context.Context, in *NamespaceUsageRequest, opts ...grpc.CallOption) (*NamespaceUsageResponse, error)
+	// SignerUsage returns the blobs paid for by a signer between two heights.
+	SignerUsage(ctx context.Context, in *SignerUsageRequest, opts ...grpc.CallOption) (*SignerUsageResponse, error)
+	// TopNamespaces returns the namespaces that occupied the most shares in the
+	// last blocks.
+	TopNamespaces(ctx context.Context, in *TopNamespacesRequest, opts ...grpc.CallOption) (*TopNamespacesResponse, error)
+}
+
+type blobUsageClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlobUsageClient(cc grpc1.ClientConn) BlobUsageClient {
+	return &blobUsageClient{cc}
+}
+
+func (c *blobUsageClient) NamespaceUsage(ctx context.Context, in *NamespaceUsageRequest, opts ...grpc.CallOption) (*NamespaceUsageResponse, error) {
+	out := new(NamespaceUsageResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blob_usage.BlobUsage/NamespaceUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobUsageClient) SignerUsage(ctx context.Context, in *SignerUsageRequest, opts ...grpc.CallOption) (*SignerUsageResponse, error) {
+	out := new(SignerUsageResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blob_usage.BlobUsage/SignerUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobUsageClient) TopNamespaces(ctx context.Context, in *TopNamespacesRequest, opts ...grpc.CallOption) (*TopNamespacesResponse, error) {
+	out := new(TopNamespacesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blob_usage.BlobUsage/TopNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobUsageServer is the server API for BlobUsage service.
+type BlobUsageServer interface {
+	// NamespaceUsage returns the blobs posted to a namespace between two
+	// heights.
+	NamespaceUsage(context.Context, *NamespaceUsageRequest) (*NamespaceUsageResponse, error)
+	// SignerUsage returns the blobs paid for by a signer between two heights.
+	SignerUsage(context.Context, *SignerUsageRequest) (*SignerUsageResponse, error)
+	// TopNamespaces returns the namespaces that occupied the most shares in the
+	// last blocks.
+	TopNamespaces(context.Context, *TopNamespacesRequest) (*TopNamespacesResponse, error)
+}
+
+// UnimplementedBlobUsageServer can be embedded to have forward compatible implementations.
+type UnimplementedBlobUsageServer struct {
+}
+
+func (*UnimplementedBlobUsageServer) NamespaceUsage(ctx context.Context, req *NamespaceUsageRequest) (*NamespaceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceUsage not implemented")
+}
+func (*UnimplementedBlobUsageServer) SignerUsage(ctx context.Context, req *SignerUsageRequest) (*SignerUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerUsage not implemented")
+}
+func (*UnimplementedBlobUsageServer) TopNamespaces(ctx context.Context, req *TopNamespacesRequest) (*TopNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopNamespaces not implemented")
+}
+
+func RegisterBlobUsageServer(s grpc1.Server, srv BlobUsageServer) {
+	s.RegisterService(&_BlobUsage_serviceDesc, srv)
+}
+
+func _BlobUsage_NamespaceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobUsageServer).NamespaceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blob_usage.BlobUsage/NamespaceUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobUsageServer).NamespaceUsage(ctx, req.(*NamespaceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobUsage_SignerUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobUsageServer).SignerUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blob_usage.BlobUsage/SignerUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobUsageServer).SignerUsage(ctx, req.(*SignerUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobUsage_TopNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobUsageServer).TopNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blob_usage.BlobUsage/TopNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobUsageServer).TopNamespaces(ctx, req.(*TopNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var BlobUsage_serviceDesc = _BlobUsage_serviceDesc
+var _BlobUsage_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.blob_usage.BlobUsage",
+	HandlerType: (*BlobUsageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NamespaceUsage",
+			Handler:    _BlobUsage_NamespaceUsage_Handler,
+		},
+		{
+			MethodName: "SignerUsage",
+			Handler:    _BlobUsage_SignerUsage_Handler,
+		},
+		{
+			MethodName: "TopNamespaces",
+			Handler:    _BlobUsage_TopNamespaces_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/blob_usage/blob_usage.proto",
+}
+
+func (m *Usage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Usage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Usage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlobUsage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Shares != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.Shares))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlobBytes != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.BlobBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlobCount != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.BlobCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PfbCount != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.PfbCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintBlobUsage(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBlobUsage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintBlobUsage(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBlobUsage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TopNamespacesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopNamespacesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopNamespacesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastBlocks != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.LastBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBlobUsage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintBlobUsage(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopNamespacesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopNamespacesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopNamespacesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintBlobUsage(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlobUsage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlobUsage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlobUsage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Usage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PfbCount != 0 {
+		n += 1 + sovBlobUsage(uint64(m.PfbCount))
+	}
+	if m.BlobCount != 0 {
+		n += 1 + sovBlobUsage(uint64(m.BlobCount))
+	}
+	if m.BlobBytes != 0 {
+		n += 1 + sovBlobUsage(uint64(m.BlobBytes))
+	}
+	if m.Shares != 0 {
+		n += 1 + sovBlobUsage(uint64(m.Shares))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovBlobUsage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovBlobUsage(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *NamespaceUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovBlobUsage(uint64(l))
+	if m.FromHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *SignerUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovBlobUsage(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *SignerUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovBlobUsage(uint64(l))
+	if m.FromHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *TopNamespacesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastBlocks != 0 {
+		n += 1 + sovBlobUsage(uint64(m.LastBlocks))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovBlobUsage(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *NamespaceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovBlobUsage(uint64(l))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovBlobUsage(uint64(l))
+	return n
+}
+
+func (m *TopNamespacesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovBlobUsage(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBlobUsage(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func sovBlobUsage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlobUsage(x uint64) (n int) {
+	return sovBlobUsage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Usage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Usage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Usage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfbCount", wireType)
+			}
+			m.PfbCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PfbCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobCount", wireType)
+			}
+			m.BlobCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBytes", wireType)
+			}
+			m.BlobBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			m.Shares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopNamespacesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopNamespacesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopNamespacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlocks", wireType)
+			}
+			m.LastBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopNamespacesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopNamespacesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopNamespacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, NamespaceUsage{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlobUsage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlobUsage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobUsage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlobUsage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlobUsage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlobUsage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlobUsage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlobUsage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlobUsage = fmt.Errorf("proto: unexpected end of group")
+)
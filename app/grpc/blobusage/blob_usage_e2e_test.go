@@ -0,0 +1,89 @@
+package blobusage_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobusage"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvtypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobUsageE2E(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestBlobUsageE2E in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	appOpts := testnode.DefaultAppOptions()
+	appOpts.Set(app.FlagBlobUsageIndexEnabled, true)
+	appOpts.Set(flags.FlagHome, t.TempDir())
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	cfg := testnode.DefaultConfig().WithTimeoutCommit(100 * time.Millisecond).WithAppOptions(appOpts).WithAppCreator(appCreator)
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := user.SetupTxClient(ctx, cctx.Keyring, cctx.GRPCClient, enc)
+	require.NoError(t, err)
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(2000))
+	require.NoError(t, err)
+	resp, err := txClient.SubmitPayForBlob(ctx, []*share.Blob{blob}, user.SetGasLimitAndGasPrice(blobtypes.DefaultEstimateGas([]uint32{2000}), appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	usageClient := blobusage.NewBlobUsageClient(cctx.GRPCClient)
+	nsResp, err := usageClient.NamespaceUsage(ctx, &blobusage.NamespaceUsageRequest{Namespace: blob.Namespace().Bytes()})
+	require.NoError(t, err)
+	require.LessOrEqual(t, nsResp.FromHeight, resp.Height)
+	require.GreaterOrEqual(t, nsResp.ToHeight, resp.Height)
+	require.EqualValues(t, 1, nsResp.Usage.PfbCount)
+	require.EqualValues(t, 2000, nsResp.Usage.BlobBytes)
+	require.EqualValues(t, share.SparseSharesNeeded(2000), nsResp.Usage.Shares)
+	require.True(t, nsResp.Usage.Fees.IsAllPositive())
+
+	signerResp, err := usageClient.SignerUsage(ctx, &blobusage.SignerUsageRequest{
+		Signer:     txClient.DefaultAddress().String(),
+		FromHeight: resp.Height,
+		ToHeight:   resp.Height,
+	})
+	require.NoError(t, err)
+	require.Equal(t, nsResp.Usage, signerResp.Usage)
+
+	topResp, err := usageClient.TopNamespaces(ctx, &blobusage.TopNamespacesRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, topResp.Namespaces)
+	require.Equal(t, blob.Namespace().Bytes(), topResp.Namespaces[0].Namespace)
+}
+
+// appCreator is the testnode app creator, except that it passes the app
+// options through so that the index is enabled.
+func appCreator(_ log.Logger, _ dbm.DB, _ io.Writer, appOpts srvtypes.AppOptions) srvtypes.Application {
+	baseAppOptions := server.DefaultBaseappOptions(appOpts)
+	baseAppOptions = append(baseAppOptions, baseapp.SetMinGasPrices(fmt.Sprintf("%v%v", appconsts.DefaultMinGasPrice, appconsts.BondDenom)))
+	return app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil, // trace store
+		appOpts.Get(testnode.TimeoutCommitFlag).(time.Duration),
+		appOpts,
+		baseAppOptions...,
+	)
+}
@@ -0,0 +1,423 @@
+package blobusage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// DBName is the name of the database of the index in the node's data
+// directory.
+const DBName = "blob_usage_index"
+
+var (
+	// latestHeightKey stores the last indexed height.
+	latestHeightKey = []byte("latest")
+	// earliestHeightKey stores the first indexed height.
+	earliestHeightKey = []byte("earliest")
+	// namespacePrefix prefixes the usage of a namespace at a height, keyed by
+	// namespace then height.
+	namespacePrefix = []byte("n/")
+	// signerPrefix prefixes the usage of a signer at a height, keyed by signer
+	// then height.
+	signerPrefix = []byte("s/")
+	// heightNamespacePrefix prefixes the usage of a namespace at a height,
+	// keyed by height then namespace.
+	heightNamespacePrefix = []byte("hn/")
+	// heightSignerPrefix prefixes the signers that paid for blobs at a height.
+	heightSignerPrefix = []byte("hs/")
+)
+
+var eventTypePayForBlobs = proto.MessageName(&blobtypes.EventPayForBlobs{})
+
+var _ storetypes.ABCIListener = &Index{}
+
+// Index records the blobs paid for by the successful PayForBlobs of committed
+// blocks per namespace and per signer. It is populated from the
+// EventPayForBlobs events of finalized blocks as an ABCI listener and kept in
+// a local database of the node.
+type Index struct {
+	db dbm.DB
+}
+
+// NewIndex returns an Index backed by db.
+func NewIndex(db dbm.DB) *Index {
+	return &Index{db: db}
+}
+
+// Close closes the database of the index.
+func (idx *Index) Close() error {
+	return idx.db.Close()
+}
+
+// ListenFinalizeBlock indexes the PayForBlobs of the finalized block. Indexing
+// a height that isn't above the last indexed one, e.g. after a rollback,
+// replaces the records from that height onward.
+func (idx *Index) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	namespaces, signers, err := usageOfBlock(res.TxResults)
+	if err != nil {
+		return err
+	}
+	return idx.index(req.Height, namespaces, signers)
+}
+
+// ListenCommit implements the ABCIListener interface.
+func (idx *Index) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+func (idx *Index) index(height int64, namespaces, signers map[string]*Usage) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	latest, err := idx.latestHeight()
+	if err != nil {
+		return err
+	}
+	if latest >= height {
+		if err := idx.deleteFrom(batch, height); err != nil {
+			return err
+		}
+	}
+	earliest, err := idx.earliestHeight()
+	if err != nil {
+		return err
+	}
+	if earliest == 0 || earliest > height {
+		if err := batch.Set(earliestHeightKey, encodeHeight(height)); err != nil {
+			return err
+		}
+	}
+
+	for namespace, usage := range namespaces {
+		bz, err := usage.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(namespaceKey([]byte(namespace), height), bz); err != nil {
+			return err
+		}
+		if err := batch.Set(heightNamespaceKey(height, []byte(namespace)), bz); err != nil {
+			return err
+		}
+	}
+	for signer, usage := range signers {
+		bz, err := usage.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(signerKey([]byte(signer), height), bz); err != nil {
+			return err
+		}
+		if err := batch.Set(heightSignerKey(height, []byte(signer)), []byte{}); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(latestHeightKey, encodeHeight(height)); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// deleteFrom deletes the records of the heights from height onward.
+func (idx *Index) deleteFrom(batch dbm.Batch, height int64) error {
+	it, err := idx.db.Iterator(append(bytes.Clone(heightNamespacePrefix), encodeHeight(height)...), storetypes.PrefixEndBytes(heightNamespacePrefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		h, namespace := decodeHeightKey(it.Key(), heightNamespacePrefix)
+		if err := batch.Delete(namespaceKey(namespace, h)); err != nil {
+			return err
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+
+	signerIt, err := idx.db.Iterator(append(bytes.Clone(heightSignerPrefix), encodeHeight(height)...), storetypes.PrefixEndBytes(heightSignerPrefix))
+	if err != nil {
+		return err
+	}
+	defer signerIt.Close()
+	for ; signerIt.Valid(); signerIt.Next() {
+		h, signer := decodeHeightKey(signerIt.Key(), heightSignerPrefix)
+		if err := batch.Delete(signerKey(signer, h)); err != nil {
+			return err
+		}
+		if err := batch.Delete(signerIt.Key()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// latestHeight returns the last indexed height or zero if no height was
+// indexed.
+func (idx *Index) latestHeight() (int64, error) {
+	return idx.getHeight(latestHeightKey)
+}
+
+// earliestHeight returns the first indexed height or zero if no height was
+// indexed.
+func (idx *Index) earliestHeight() (int64, error) {
+	return idx.getHeight(earliestHeightKey)
+}
+
+func (idx *Index) getHeight(key []byte) (int64, error) {
+	bz, err := idx.db.Get(key)
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// heightRange bounds the requested range of heights by the indexed heights. A
+// zero from or to height is unbounded. It returns false if no height of the
+// range is indexed.
+func (idx *Index) heightRange(fromHeight, toHeight int64) (int64, int64, bool, error) {
+	earliest, err := idx.earliestHeight()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	latest, err := idx.latestHeight()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if latest == 0 {
+		return 0, 0, false, nil
+	}
+	if fromHeight == 0 || fromHeight < earliest {
+		fromHeight = earliest
+	}
+	if toHeight == 0 || toHeight > latest {
+		toHeight = latest
+	}
+	return fromHeight, toHeight, fromHeight <= toHeight, nil
+}
+
+// sumUsage returns the total usage of the records of a namespace or signer,
+// i.e. the keys under prefix, between two heights inclusive.
+func (idx *Index) sumUsage(prefix []byte, fromHeight, toHeight int64) (Usage, error) {
+	var total Usage
+	it, err := idx.db.Iterator(append(bytes.Clone(prefix), encodeHeight(fromHeight)...), append(bytes.Clone(prefix), encodeHeight(toHeight+1)...))
+	if err != nil {
+		return total, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var usage Usage
+		if err := usage.Unmarshal(it.Value()); err != nil {
+			return total, err
+		}
+		total.add(usage)
+	}
+	return total, nil
+}
+
+// namespaceUsages returns the usage of each namespace between two heights
+// inclusive.
+func (idx *Index) namespaceUsages(fromHeight, toHeight int64) (map[string]*Usage, error) {
+	it, err := idx.db.Iterator(
+		append(bytes.Clone(heightNamespacePrefix), encodeHeight(fromHeight)...),
+		append(bytes.Clone(heightNamespacePrefix), encodeHeight(toHeight+1)...),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	usages := make(map[string]*Usage)
+	for ; it.Valid(); it.Next() {
+		var usage Usage
+		if err := usage.Unmarshal(it.Value()); err != nil {
+			return nil, err
+		}
+		_, namespace := decodeHeightKey(it.Key(), heightNamespacePrefix)
+		total, ok := usages[string(namespace)]
+		if !ok {
+			total = &Usage{}
+			usages[string(namespace)] = total
+		}
+		total.add(usage)
+	}
+	return usages, nil
+}
+
+// usageOfBlock returns the usage of each namespace and each signer by the
+// successful PayForBlobs of a block.
+func usageOfBlock(txResults []*abci.ExecTxResult) (map[string]*Usage, map[string]*Usage, error) {
+	namespaces := make(map[string]*Usage)
+	signers := make(map[string]*Usage)
+	for _, result := range txResults {
+		if result == nil || result.Code != abci.CodeTypeOK {
+			continue
+		}
+		var fee sdk.Coins
+		var pfbs []*blobtypes.EventPayForBlobs
+		for _, event := range result.Events {
+			switch event.Type {
+			case sdk.EventTypeTx:
+				for _, attr := range event.Attributes {
+					if attr.Key != sdk.AttributeKeyFee || attr.Value == "" {
+						continue
+					}
+					coins, err := sdk.ParseCoinsNormalized(attr.Value)
+					if err != nil {
+						return nil, nil, fmt.Errorf("parsing fee %q: %w", attr.Value, err)
+					}
+					fee = coins
+				}
+			case eventTypePayForBlobs:
+				msg, err := sdk.ParseTypedEvent(event)
+				if err != nil {
+					return nil, nil, err
+				}
+				if pfb, ok := msg.(*blobtypes.EventPayForBlobs); ok {
+					pfbs = append(pfbs, pfb)
+				}
+			}
+		}
+		for _, pfb := range pfbs {
+			if err := addPFB(namespaces, signers, pfb, fee); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return namespaces, signers, nil
+}
+
+// addPFB adds the blobs of a PayForBlobs to the usages of their namespaces and
+// signer.
+func addPFB(namespaces, signers map[string]*Usage, pfb *blobtypes.EventPayForBlobs, fee sdk.Coins) error {
+	if len(pfb.Namespaces) != len(pfb.BlobSizes) {
+		return fmt.Errorf("event of PayForBlobs by %s has %d namespaces and %d blob sizes", pfb.Signer, len(pfb.Namespaces), len(pfb.BlobSizes))
+	}
+	signer, err := sdk.AccAddressFromBech32(pfb.Signer)
+	if err != nil {
+		return err
+	}
+
+	shares := make([]uint64, len(pfb.BlobSizes))
+	var totalShares uint64
+	for i, size := range pfb.BlobSizes {
+		shares[i] = uint64(share.SparseSharesNeeded(size))
+		totalShares += shares[i]
+	}
+
+	// the usage of a namespace is only counted once per PayForBlobs even if
+	// several of its blobs share the namespace.
+	pfbNamespaces := make(map[string]*Usage)
+	for i, namespace := range pfb.Namespaces {
+		usage, ok := pfbNamespaces[string(namespace)]
+		if !ok {
+			usage = &Usage{PfbCount: 1}
+			pfbNamespaces[string(namespace)] = usage
+		}
+		usage.BlobCount++
+		usage.BlobBytes += uint64(pfb.BlobSizes[i])
+		usage.Shares += shares[i]
+		usage.Fees = usage.Fees.Add(shareOfFee(fee, shares[i], totalShares)...)
+	}
+	for namespace, usage := range pfbNamespaces {
+		total, ok := namespaces[namespace]
+		if !ok {
+			total = &Usage{}
+			namespaces[namespace] = total
+		}
+		total.add(*usage)
+	}
+
+	total, ok := signers[string(signer)]
+	if !ok {
+		total = &Usage{}
+		signers[string(signer)] = total
+	}
+	total.add(Usage{
+		PfbCount:  1,
+		BlobCount: uint64(len(pfb.BlobSizes)),
+		BlobBytes: sumBlobSizes(pfb.BlobSizes),
+		Shares:    totalShares,
+		Fees:      fee,
+	})
+	return nil
+}
+
+// shareOfFee returns the part of the fee proportional to shares out of
+// totalShares, rounded down.
+func shareOfFee(fee sdk.Coins, shares, totalShares uint64) sdk.Coins {
+	if totalShares == 0 {
+		return nil
+	}
+	part := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := coin.Amount.Mul(math.NewIntFromUint64(shares)).Quo(math.NewIntFromUint64(totalShares))
+		part = part.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return part
+}
+
+func sumBlobSizes(sizes []uint32) uint64 {
+	var sum uint64
+	for _, size := range sizes {
+		sum += uint64(size)
+	}
+	return sum
+}
+
+func (u *Usage) add(other Usage) {
+	u.PfbCount += other.PfbCount
+	u.BlobCount += other.BlobCount
+	u.BlobBytes += other.BlobBytes
+	u.Shares += other.Shares
+	u.Fees = u.Fees.Add(other.Fees...)
+}
+
+func encodeHeight(height int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
+
+func namespaceKey(namespace []byte, height int64) []byte {
+	return append(namespaceRecordsPrefix(namespace), encodeHeight(height)...)
+}
+
+// namespaceRecordsPrefix is the prefix of the records of a namespace.
+func namespaceRecordsPrefix(namespace []byte) []byte {
+	return append(bytes.Clone(namespacePrefix), namespace...)
+}
+
+func signerKey(signer []byte, height int64) []byte {
+	return append(signerRecordsPrefix(signer), encodeHeight(height)...)
+}
+
+// signerRecordsPrefix is the prefix of the records of a signer. The address is
+// length prefixed because addresses don't have a fixed length.
+func signerRecordsPrefix(signer []byte) []byte {
+	prefix := append(bytes.Clone(signerPrefix), byte(len(signer)))
+	return append(prefix, signer...)
+}
+
+func heightNamespaceKey(height int64, namespace []byte) []byte {
+	return append(append(bytes.Clone(heightNamespacePrefix), encodeHeight(height)...), namespace...)
+}
+
+func heightSignerKey(height int64, signer []byte) []byte {
+	return append(append(bytes.Clone(heightSignerPrefix), encodeHeight(height)...), signer...)
+}
+
+// decodeHeightKey splits a key keyed by height under prefix into the height
+// and the rest of the key.
+func decodeHeightKey(key, prefix []byte) (int64, []byte) {
+	key = key[len(prefix):]
+	return int64(binary.BigEndian.Uint64(key[:8])), bytes.Clone(key[8:])
+}
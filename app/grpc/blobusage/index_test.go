@@ -0,0 +1,129 @@
+package blobusage
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIndex(t *testing.T) {
+	index := NewIndex(dbm.NewMemDB())
+	server := NewBlobUsageServer(index)
+	ctx := context.Background()
+
+	alice := sdk.AccAddress(append(make([]byte, 19), 1))
+	bob := sdk.AccAddress(append(make([]byte, 19), 2))
+	nsA := share.MustNewV0Namespace([]byte("a")).Bytes()
+	nsB := share.MustNewV0Namespace([]byte("b")).Bytes()
+	oneShare := uint32(100)
+	twoShares := uint32(share.AvailableBytesFromSparseShares(2))
+
+	// height 1: alice posts one share to A and two shares to B
+	// height 2: bob posts two shares to A, and a failed PFB by alice is skipped
+	// height 3: alice posts one share to B
+	listen(t, index, 1, pfbResult(t, alice, 300, [][]byte{nsA, nsB}, []uint32{oneShare, twoShares}))
+	failed := pfbResult(t, alice, 1000, [][]byte{nsA}, []uint32{oneShare})
+	failed.Code = 1
+	listen(t, index, 2, pfbResult(t, bob, 200, [][]byte{nsA}, []uint32{twoShares}), failed)
+	listen(t, index, 3, pfbResult(t, alice, 50, [][]byte{nsB}, []uint32{oneShare}))
+
+	nsResp, err := server.NamespaceUsage(ctx, &NamespaceUsageRequest{Namespace: nsA})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, nsResp.FromHeight)
+	require.EqualValues(t, 3, nsResp.ToHeight)
+	require.EqualValues(t, 2, nsResp.Usage.PfbCount)
+	require.EqualValues(t, 2, nsResp.Usage.BlobCount)
+	require.EqualValues(t, oneShare+twoShares, nsResp.Usage.BlobBytes)
+	require.EqualValues(t, 3, nsResp.Usage.Shares)
+	// a third of the first fee and all of the second
+	require.Equal(t, utia(100+200), nsResp.Usage.Fees)
+
+	nsResp, err = server.NamespaceUsage(ctx, &NamespaceUsageRequest{Namespace: nsB, FromHeight: 2, ToHeight: 3})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, nsResp.Usage.PfbCount)
+	require.EqualValues(t, 1, nsResp.Usage.Shares)
+	require.Equal(t, utia(50), nsResp.Usage.Fees)
+
+	signerResp, err := server.SignerUsage(ctx, &SignerUsageRequest{Signer: alice.String()})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, signerResp.Usage.PfbCount)
+	require.EqualValues(t, 3, signerResp.Usage.BlobCount)
+	require.EqualValues(t, 4, signerResp.Usage.Shares)
+	require.Equal(t, utia(350), signerResp.Usage.Fees)
+
+	topResp, err := server.TopNamespaces(ctx, &TopNamespacesRequest{LastBlocks: 3})
+	require.NoError(t, err)
+	require.Len(t, topResp.Namespaces, 2)
+	// both namespaces occupied three shares so they are ordered by namespace
+	require.Equal(t, nsA, topResp.Namespaces[0].Namespace)
+	require.EqualValues(t, 3, topResp.Namespaces[0].Usage.Shares)
+
+	topResp, err = server.TopNamespaces(ctx, &TopNamespacesRequest{LastBlocks: 2, Limit: 1})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, topResp.FromHeight)
+	require.Len(t, topResp.Namespaces, 1)
+	require.Equal(t, nsA, topResp.Namespaces[0].Namespace)
+	require.EqualValues(t, 2, topResp.Namespaces[0].Usage.Shares)
+
+	// indexing height 2 again, e.g. after a rollback, replaces heights 2 and 3
+	listen(t, index, 2)
+	signerResp, err = server.SignerUsage(ctx, &SignerUsageRequest{Signer: bob.String()})
+	require.NoError(t, err)
+	require.Zero(t, signerResp.Usage.PfbCount)
+	require.EqualValues(t, 2, signerResp.ToHeight)
+	nsResp, err = server.NamespaceUsage(ctx, &NamespaceUsageRequest{Namespace: nsB})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, nsResp.Usage.Shares)
+}
+
+func TestBlobUsageServerErrors(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewBlobUsageServer(nil).NamespaceUsage(ctx, &NamespaceUsageRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	server := NewBlobUsageServer(NewIndex(dbm.NewMemDB()))
+	_, err = server.NamespaceUsage(ctx, &NamespaceUsageRequest{Namespace: []byte("invalid")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.SignerUsage(ctx, &SignerUsageRequest{Signer: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.NamespaceUsage(ctx, &NamespaceUsageRequest{Namespace: share.TxNamespace.Bytes(), FromHeight: 3, ToHeight: 2})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.TopNamespaces(ctx, &TopNamespacesRequest{LastBlocks: maxTopNamespacesBlocks + 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// an empty index returns empty usages
+	resp, err := server.NamespaceUsage(ctx, &NamespaceUsageRequest{Namespace: share.TxNamespace.Bytes()})
+	require.NoError(t, err)
+	require.Zero(t, resp.ToHeight)
+}
+
+func listen(t *testing.T, index *Index, height int64, results ...*abci.ExecTxResult) {
+	err := index.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{TxResults: results})
+	require.NoError(t, err)
+}
+
+// pfbResult returns the result of a PayForBlobs with the events emitted by the
+// ante handler and the blob module.
+func pfbResult(t *testing.T, signer sdk.AccAddress, fee int64, namespaces [][]byte, blobSizes []uint32) *abci.ExecTxResult {
+	event, err := sdk.TypedEventToEvent(blobtypes.NewPayForBlobsEvent(signer.String(), blobSizes, namespaces))
+	require.NoError(t, err)
+	feeEvent := sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, utia(fee).String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, signer.String()),
+	)
+	return &abci.ExecTxResult{Events: []abci.Event{abci.Event(feeEvent), abci.Event(event)}}
+}
+
+func utia(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(amount)))
+}
@@ -0,0 +1,157 @@
+package blobusage
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultTopNamespacesBlocks is the number of last blocks considered by
+	// TopNamespaces if none is requested.
+	defaultTopNamespacesBlocks = 100
+	// maxTopNamespacesBlocks bounds the number of blocks scanned by a single
+	// TopNamespaces query.
+	maxTopNamespacesBlocks = 10_000
+	// defaultTopNamespacesLimit is the number of namespaces returned by
+	// TopNamespaces if no limit is requested.
+	defaultTopNamespacesLimit = 10
+)
+
+// RegisterBlobUsageService registers the blob usage service on the gRPC
+// router. A nil index registers a service that reports the index as disabled.
+func RegisterBlobUsageService(qrt gogogrpc.Server, index *Index) {
+	RegisterBlobUsageServer(qrt, NewBlobUsageServer(index))
+}
+
+// RegisterGRPCGatewayRoutes mounts the blob usage service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterBlobUsageHandlerClient(context.Background(), mux, NewBlobUsageClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ BlobUsageServer = &blobUsageServer{}
+
+type blobUsageServer struct {
+	index *Index
+}
+
+func NewBlobUsageServer(index *Index) BlobUsageServer {
+	return &blobUsageServer{index: index}
+}
+
+// NamespaceUsage sums the usage of the namespace over the indexed heights of
+// the requested range.
+func (s *blobUsageServer) NamespaceUsage(_ context.Context, request *NamespaceUsageRequest) (*NamespaceUsageResponse, error) {
+	if s.index == nil {
+		return nil, errIndexDisabled
+	}
+	if _, err := share.NewNamespaceFromBytes(request.Namespace); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %v", err)
+	}
+	if err := validateHeights(request.FromHeight, request.ToHeight); err != nil {
+		return nil, err
+	}
+	fromHeight, toHeight, ok, err := s.index.heightRange(request.FromHeight, request.ToHeight)
+	if err != nil || !ok {
+		return &NamespaceUsageResponse{}, err
+	}
+	usage, err := s.index.sumUsage(namespaceRecordsPrefix(request.Namespace), fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &NamespaceUsageResponse{Usage: usage, FromHeight: fromHeight, ToHeight: toHeight}, nil
+}
+
+// SignerUsage sums the usage of the signer over the indexed heights of the
+// requested range.
+func (s *blobUsageServer) SignerUsage(_ context.Context, request *SignerUsageRequest) (*SignerUsageResponse, error) {
+	if s.index == nil {
+		return nil, errIndexDisabled
+	}
+	signer, err := sdk.AccAddressFromBech32(request.Signer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid signer: %v", err)
+	}
+	if err := validateHeights(request.FromHeight, request.ToHeight); err != nil {
+		return nil, err
+	}
+	fromHeight, toHeight, ok, err := s.index.heightRange(request.FromHeight, request.ToHeight)
+	if err != nil || !ok {
+		return &SignerUsageResponse{}, err
+	}
+	usage, err := s.index.sumUsage(signerRecordsPrefix(signer), fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &SignerUsageResponse{Usage: usage, FromHeight: fromHeight, ToHeight: toHeight}, nil
+}
+
+// TopNamespaces ranks the namespaces of the last indexed blocks by the shares
+// they occupied. Ties are broken by namespace.
+func (s *blobUsageServer) TopNamespaces(_ context.Context, request *TopNamespacesRequest) (*TopNamespacesResponse, error) {
+	if s.index == nil {
+		return nil, errIndexDisabled
+	}
+	lastBlocks := request.LastBlocks
+	if lastBlocks == 0 {
+		lastBlocks = defaultTopNamespacesBlocks
+	}
+	if lastBlocks > maxTopNamespacesBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "last blocks %d exceeds the max of %d", lastBlocks, maxTopNamespacesBlocks)
+	}
+	limit := int(request.Limit)
+	if limit == 0 {
+		limit = defaultTopNamespacesLimit
+	}
+
+	latest, err := s.index.latestHeight()
+	if err != nil || latest == 0 {
+		return &TopNamespacesResponse{}, err
+	}
+	fromHeight, toHeight, ok, err := s.index.heightRange(max(latest-int64(lastBlocks)+1, 1), latest)
+	if err != nil || !ok {
+		return &TopNamespacesResponse{}, err
+	}
+	usages, err := s.index.namespaceUsages(fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]NamespaceUsage, 0, len(usages))
+	for namespace, usage := range usages {
+		namespaces = append(namespaces, NamespaceUsage{Namespace: []byte(namespace), Usage: *usage})
+	}
+	sort.Slice(namespaces, func(i, j int) bool {
+		if namespaces[i].Usage.Shares != namespaces[j].Usage.Shares {
+			return namespaces[i].Usage.Shares > namespaces[j].Usage.Shares
+		}
+		return bytes.Compare(namespaces[i].Namespace, namespaces[j].Namespace) < 0
+	})
+	if len(namespaces) > limit {
+		namespaces = namespaces[:limit]
+	}
+	return &TopNamespacesResponse{Namespaces: namespaces, FromHeight: fromHeight, ToHeight: toHeight}, nil
+}
+
+var errIndexDisabled = status.Error(codes.Unavailable, "the blob usage index is disabled on this node")
+
+func validateHeights(fromHeight, toHeight int64) error {
+	if fromHeight < 0 || toHeight < 0 {
+		return status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+	if toHeight != 0 && fromHeight > toHeight {
+		return status.Errorf(codes.InvalidArgument, "from height %d is above to height %d", fromHeight, toHeight)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package gasestimation
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	// pubKeySize is the size of a compressed secp256k1 or secp256r1 public
+	// key.
+	pubKeySize = 33
+	// signatureSize is the size of a secp256k1 or secp256r1 signature.
+	signatureSize = 64
+
+	// PubKeyTypeSecp256k1 is the secp256k1 signer public key type.
+	PubKeyTypeSecp256k1 = "secp256k1"
+	// PubKeyTypeSecp256r1 is the secp256r1 signer public key type.
+	PubKeyTypeSecp256r1 = "secp256r1"
+)
+
+// pubKeyTypeURLs are the type URLs of the supported signer public key types.
+var pubKeyTypeURLs = map[string]string{
+	PubKeyTypeSecp256k1: "/cosmos.crypto.secp256k1.PubKey",
+	PubKeyTypeSecp256r1: "/cosmos.crypto.secp256r1.PubKey",
+}
+
+// GasParams are the parameters that determine the gas consumed by a
+// PayForBlobs besides the state it accesses.
+type GasParams struct {
+	GasPerBlobByte         uint32
+	TxSizeCostPerByte      uint64
+	SigVerifyCostSecp256k1 uint64
+	SigVerifyCostSecp256r1 uint64
+}
+
+// gasParamsFn is the signature of a function that returns the current gas
+// params.
+type gasParamsFn func() (GasParams, error)
+
+// stateAccessGasFn is the signature of a function that simulates a PayForBlobs
+// paying for the blobs on a branch of the latest state and returns the gas
+// consumed by its state accesses, e.g. loading and updating the signer's
+// account, deducting and splitting the fee, using the feegrant and reading the
+// reservations of the namespaces.
+type stateAccessGasFn func(blobs []*BlobInfo, pubKeyType string, feeGranted bool) (uint64, error)
+
+// EstimateBlobCost estimates the gas used by a PayForBlobs paying for the
+// described blobs. Instead of simulating a signed transaction, the gas is
+// computed from the shares occupied by the blobs, the size of an equivalent
+// transaction with placeholder values for the signer, fee and signature, the
+// signature verification cost of the signer's public key type and the gas of
+// the state accesses measured by simulating a PayForBlobs, with and without a
+// feegrant if the fee is granted. The fee is returned for each priority using the
+// current gas price estimation.
+func (s *gasEstimatorServer) EstimateBlobCost(ctx context.Context, request *EstimateBlobCostRequest) (*EstimateBlobCostResponse, error) {
+	if len(request.Blobs) == 0 {
+		return nil, blobtypes.ErrNoBlobs
+	}
+	pubKeyType := request.SignerPubKeyType
+	if pubKeyType == "" {
+		pubKeyType = PubKeyTypeSecp256k1
+	}
+	if _, ok := pubKeyTypeURLs[pubKeyType]; !ok {
+		return nil, fmt.Errorf("unsupported signer public key type %q", pubKeyType)
+	}
+	if s.gasParamsFn == nil || s.stateAccessGasFn == nil {
+		return nil, fmt.Errorf("gas params are not available")
+	}
+	params, err := s.gasParamsFn()
+	if err != nil {
+		return nil, err
+	}
+
+	blobSizes := make([]uint32, len(request.Blobs))
+	for i, blob := range request.Blobs {
+		if blob.DataSize == 0 {
+			return nil, blobtypes.ErrZeroBlobSize
+		}
+		ns, err := share.NewNamespaceFromBytes(blob.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if err := blobtypes.ValidateBlobNamespace(ns); err != nil {
+			return nil, err
+		}
+		if blob.ShareVersion != uint32(share.ShareVersionZero) && blob.ShareVersion != uint32(share.ShareVersionOne) {
+			return nil, fmt.Errorf("unsupported share version %d", blob.ShareVersion)
+		}
+		blobSizes[i] = blob.DataSize
+	}
+
+	txSize, err := estimatePFBTxSize(request.Blobs, pubKeyType, request.FeeGranted)
+	if err != nil {
+		return nil, err
+	}
+
+	stateAccessGas, err := s.stateAccessGasFn(request.Blobs, pubKeyType, false)
+	if err != nil {
+		return nil, fmt.Errorf("simulating state accesses: %w", err)
+	}
+
+	resp := &EstimateBlobCostResponse{
+		BlobGas:        blobtypes.GasToConsume(blobSizes, params.GasPerBlobByte),
+		TxSizeGas:      uint64(txSize) * params.TxSizeCostPerByte,
+		SigVerifyGas:   params.SigVerifyCostSecp256k1,
+		StateAccessGas: stateAccessGas,
+	}
+	if pubKeyType == PubKeyTypeSecp256r1 {
+		resp.SigVerifyGas = params.SigVerifyCostSecp256r1
+	}
+	if request.FeeGranted {
+		// the feegrant gas is the additional gas of the state accesses of a
+		// PayForBlobs whose fee is paid through a feegrant
+		grantedGas, err := s.stateAccessGasFn(request.Blobs, pubKeyType, true)
+		if err != nil {
+			return nil, fmt.Errorf("simulating state accesses: %w", err)
+		}
+		if grantedGas > stateAccessGas {
+			resp.FeegrantGas = grantedGas - stateAccessGas
+		}
+	}
+	gasUsed := resp.BlobGas + resp.TxSizeGas + resp.SigVerifyGas + resp.FeegrantGas + resp.StateAccessGas
+	resp.EstimatedGasUsed = uint64(math.Round(float64(gasUsed) * gasMultiplier))
+
+	for _, priority := range []TxPriority{TxPriority_TX_PRIORITY_LOW, TxPriority_TX_PRIORITY_MEDIUM, TxPriority_TX_PRIORITY_HIGH} {
+		gasPrice, err := s.estimateGasPrice(ctx, priority)
+		if err != nil {
+			return nil, err
+		}
+		resp.Fees = append(resp.Fees, &PriorityFee{
+			TxPriority: priority,
+			GasPrice:   gasPrice,
+			Fee:        uint64(math.Ceil(gasPrice * float64(resp.EstimatedGasUsed))),
+		})
+	}
+	return resp, nil
+}
+
+// estimatePFBTxSize returns the size of a signed PayForBlobs transaction,
+// excluding the blobs, paying for the blobs. The signer, fee, gas limit and
+// sequence are set to placeholders at least as large as the real values.
+func estimatePFBTxSize(blobs []*BlobInfo, pubKeyType string, feeGranted bool) (int, error) {
+	placeholderAddress := sdk.AccAddress(make([]byte, 20)).String()
+	msg := &blobtypes.MsgPayForBlobs{
+		Signer:           placeholderAddress,
+		Namespaces:       make([][]byte, len(blobs)),
+		BlobSizes:        make([]uint32, len(blobs)),
+		ShareCommitments: make([][]byte, len(blobs)),
+		ShareVersions:    make([]uint32, len(blobs)),
+	}
+	for i, blob := range blobs {
+		msg.Namespaces[i] = blob.Namespace
+		msg.BlobSizes[i] = blob.DataSize
+		msg.ShareCommitments[i] = make([]byte, appconsts.HashLength())
+		msg.ShareVersions[i] = blob.ShareVersion
+	}
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return 0, err
+	}
+	body := &txtypes.TxBody{Messages: []*codectypes.Any{msgAny}}
+	bodyBytes, err := body.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	// a compressed public key is wrapped in a message with a single bytes
+	// field.
+	pubKey := append([]byte{0x0a, pubKeySize}, make([]byte, pubKeySize)...)
+	authInfo := &txtypes.AuthInfo{
+		SignerInfos: []*txtypes.SignerInfo{{
+			PublicKey: &codectypes.Any{TypeUrl: pubKeyTypeURLs[pubKeyType], Value: pubKey},
+			ModeInfo: &txtypes.ModeInfo{
+				Sum: &txtypes.ModeInfo_Single_{Single: &txtypes.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT}},
+			},
+			Sequence: math.MaxUint64,
+		}},
+		Fee: &txtypes.Fee{
+			Amount:   sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdkmath.NewInt(math.MaxInt64))),
+			GasLimit: math.MaxUint64,
+		},
+	}
+	if feeGranted {
+		authInfo.Fee.Granter = placeholderAddress
+	}
+	authInfoBytes, err := authInfo.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	raw := &txtypes.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{make([]byte, signatureSize)},
+	}
+	return raw.Size(), nil
+}
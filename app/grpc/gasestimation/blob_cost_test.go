@@ -0,0 +1,66 @@
+package gasestimation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateBlobCost(t *testing.T) {
+	server := &gasEstimatorServer{
+		mempoolClient: newMockMempoolClient([]types.Tx{}),
+		minGasPriceFn: func() (float64, error) {
+			return 0.01, nil
+		},
+		govMaxSquareBytesFn: func() (uint64, error) {
+			return 1000000, nil
+		},
+		gasParamsFn: func() (GasParams, error) {
+			return GasParams{GasPerBlobByte: 8, TxSizeCostPerByte: 10, SigVerifyCostSecp256k1: 1000, SigVerifyCostSecp256r1: 2000}, nil
+		},
+		stateAccessGasFn: func(_ []*BlobInfo, _ string, feeGranted bool) (uint64, error) {
+			if feeGranted {
+				return 50_000, nil
+			}
+			return 40_000, nil
+		},
+	}
+	blobs := []*BlobInfo{{DataSize: 1000, Namespace: share.RandomBlobNamespace().Bytes()}}
+
+	resp, err := server.EstimateBlobCost(context.Background(), &EstimateBlobCostRequest{Blobs: blobs})
+	require.NoError(t, err)
+	require.EqualValues(t, share.SparseSharesNeeded(1000)*share.ShareSize*8, resp.BlobGas)
+	require.EqualValues(t, 1000, resp.SigVerifyGas)
+	require.EqualValues(t, 40_000, resp.StateAccessGas)
+	require.Zero(t, resp.FeegrantGas)
+	require.Greater(t, resp.EstimatedGasUsed, resp.BlobGas+resp.TxSizeGas+resp.SigVerifyGas+resp.StateAccessGas)
+	require.Len(t, resp.Fees, 3)
+	for _, fee := range resp.Fees {
+		require.Equal(t, 0.01, fee.GasPrice)
+		require.InDelta(t, float64(resp.EstimatedGasUsed)/100, float64(fee.Fee), 1)
+	}
+
+	granted, err := server.EstimateBlobCost(context.Background(), &EstimateBlobCostRequest{
+		Blobs:            blobs,
+		SignerPubKeyType: PubKeyTypeSecp256r1,
+		FeeGranted:       true,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2000, granted.SigVerifyGas)
+	require.EqualValues(t, 40_000, granted.StateAccessGas)
+	require.EqualValues(t, 10_000, granted.FeegrantGas)
+	// the fee granter is part of the tx
+	require.Greater(t, granted.TxSizeGas, resp.TxSizeGas)
+
+	_, err = server.EstimateBlobCost(context.Background(), &EstimateBlobCostRequest{
+		Blobs: []*BlobInfo{{DataSize: 1000, Namespace: share.TxNamespace.Bytes()}},
+	})
+	require.Error(t, err)
+	_, err = server.EstimateBlobCost(context.Background(), &EstimateBlobCostRequest{
+		Blobs: []*BlobInfo{{DataSize: 0, Namespace: share.RandomBlobNamespace().Bytes()}},
+	})
+	require.Error(t, err)
+}
@@ -32,10 +32,10 @@ type govMaxSquareBytesFn func() (uint64, error)
 type minGasPriceFn func() (float64, error)
 
 // RegisterGasEstimationService registers the gas estimation service on the gRPC router.
-func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn) {
+func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn, gasParamsFn gasParamsFn, stateAccessGasFn stateAccessGasFn, gasPriceHistory *GasPriceHistory) {
 	RegisterGasEstimatorServer(
 		qrt,
-		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, simulateFn, minGasPriceFn),
+		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, simulateFn, minGasPriceFn, gasParamsFn, stateAccessGasFn, gasPriceHistory),
 	)
 }
 
@@ -47,15 +47,21 @@ type gasEstimatorServer struct {
 	txDecoder           sdk.TxDecoder
 	govMaxSquareBytesFn govMaxSquareBytesFn
 	minGasPriceFn       minGasPriceFn
+	gasParamsFn         gasParamsFn
+	stateAccessGasFn    stateAccessGasFn
+	gasPriceHistory     *GasPriceHistory
 }
 
-func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn) GasEstimatorServer {
+func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn, gasParamsFn gasParamsFn, stateAccessGasFn stateAccessGasFn, gasPriceHistory *GasPriceHistory) GasEstimatorServer {
 	return &gasEstimatorServer{
 		mempoolClient:       mempoolClient,
 		simulateFn:          simulateFn,
 		txDecoder:           txDecoder,
 		govMaxSquareBytesFn: govMaxSquareBytesFn,
 		minGasPriceFn:       minGasPriceFn,
+		gasParamsFn:         gasParamsFn,
+		stateAccessGasFn:    stateAccessGasFn,
+		gasPriceHistory:     gasPriceHistory,
 	}
 }
 
@@ -143,6 +149,9 @@ func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPr
 	if err != nil {
 		return 0, err
 	}
+	if len(gasPrices) == 0 {
+		return math.Max(appconsts.DefaultMinGasPrice, minGasPrice), nil
+	}
 	estimatedGasPrice, err := estimateGasPriceForTransactions(gasPrices, priority)
 	if err != nil {
 		return 0, err
@@ -215,31 +224,26 @@ func estimateGasPriceForTransactions(gasPrices []float64, priority TxPriority) (
 // SortAndExtractGasPrices takes a list of transaction results
 // and returns their corresponding gas prices.
 // The total size of the returned transactions won't exceed the maxBytes parameter.
+// Transactions without a gas limit are skipped.
 func SortAndExtractGasPrices(txDecoder sdk.TxDecoder, txs []types.Tx, maxBytes int64) ([]float64, error) {
 	type gasPriceAndSize struct {
 		gasPrice float64
 		size     int64
 	}
-	gasPriceAndSizes := make([]gasPriceAndSize, len(txs))
-	for index, rawTx := range txs {
-		txBytes := rawTx
-		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
-		if isBlob {
-			if err != nil {
-				panic(err)
-			}
-			txBytes = bTx.Tx
+	gasPriceAndSizes := make([]gasPriceAndSize, 0, len(txs))
+	for _, rawTx := range txs {
+		gasPrice, err := decodeGasPrice(txDecoder, rawTx)
+		if errors.Is(err, errNoGasLimit) {
+			// the gas price of a transaction without a gas limit is undefined
+			continue
 		}
-		sdkTx, err := txDecoder(txBytes)
 		if err != nil {
 			return nil, err
 		}
-		feeTx := sdkTx.(sdk.FeeTx)
-		gasPrice := float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas())
-		gasPriceAndSizes[index] = gasPriceAndSize{
+		gasPriceAndSizes = append(gasPriceAndSizes, gasPriceAndSize{
 			size:     int64(len(rawTx)),
 			gasPrice: gasPrice,
-		}
+		})
 	}
 
 	// sort the gas prices in descending order
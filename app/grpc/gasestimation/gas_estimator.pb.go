@@ -273,423 +273,2266 @@ func (m *EstimateGasPriceAndUsageResponse) GetEstimatedGasUsed() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxPriority", TxPriority_name, TxPriority_value)
-	proto.RegisterType((*EstimateGasPriceRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceRequest")
-	proto.RegisterType((*EstimateGasPriceResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceResponse")
-	proto.RegisterType((*EstimateGasPriceAndUsageRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageRequest")
-	proto.RegisterType((*EstimateGasPriceAndUsageResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageResponse")
+// EstimateGasPriceFromHistoryRequest the request to estimate gas prices from
+// the gas prices of recently committed blocks.
+type EstimateGasPriceFromHistoryRequest struct {
+	// percentiles are the percentiles, in the range [0, 100], of the gas prices
+	// to return. Defaults to the 10th, 50th and 90th percentile if empty.
+	Percentiles []float64 `protobuf:"fixed64,1,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+	// inclusion_blocks is the number of blocks within which a transaction
+	// paying the inclusion gas price is expected to be included. Defaults to 1.
+	InclusionBlocks uint64 `protobuf:"varint,2,opt,name=inclusion_blocks,json=inclusionBlocks,proto3" json:"inclusion_blocks,omitempty"`
+}
+
+func (m *EstimateGasPriceFromHistoryRequest) Reset()         { *m = EstimateGasPriceFromHistoryRequest{} }
+func (m *EstimateGasPriceFromHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasPriceFromHistoryRequest) ProtoMessage()    {}
+func (*EstimateGasPriceFromHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{4}
+}
+func (m *EstimateGasPriceFromHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("celestia/core/v1/gas_estimation/gas_estimator.proto", fileDescriptor_67d02876d749b9cc)
+func (m *EstimateGasPriceFromHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateGasPriceFromHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_67d02876d749b9cc = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0x2c,
-	0x8e, 0x07, 0x89, 0xe4, 0x26, 0x96, 0x64, 0xe6, 0xe7, 0x21, 0x73, 0xf3, 0x8b, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xe4, 0x61, 0x9a, 0xf4, 0x40, 0x9a, 0xf4, 0xca, 0x0c, 0xf5, 0x50, 0x35,
-	0x29, 0xa5, 0x73, 0x89, 0xbb, 0x42, 0x78, 0xa9, 0xee, 0x89, 0xc5, 0x01, 0x45, 0x99, 0xc9, 0xa9,
-	0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x3e, 0x5c, 0xdc, 0x25, 0x15, 0xf1, 0x05, 0x45,
-	0x99, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0xda, 0x7a, 0x04,
-	0x4c, 0xd4, 0x0b, 0xa9, 0x08, 0x80, 0x6a, 0x09, 0xe2, 0x2a, 0x81, 0xb3, 0x95, 0xbc, 0xb8, 0x24,
-	0x30, 0x2d, 0x2a, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0xd2, 0xe3, 0x12, 0x86, 0x1a, 0x90, 0x9a,
-	0x12, 0x0f, 0x32, 0xae, 0x00, 0x24, 0x0d, 0xb6, 0x91, 0x31, 0x48, 0x10, 0x2e, 0x05, 0xd3, 0xa7,
-	0xd4, 0xc5, 0xc8, 0x25, 0x8f, 0x6e, 0x98, 0x63, 0x5e, 0x4a, 0x68, 0x71, 0x62, 0x3a, 0x6d, 0x5c,
-	0x2f, 0x24, 0xc9, 0xc5, 0x51, 0x52, 0x11, 0x9f, 0x54, 0x59, 0x92, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0,
-	0xa8, 0xc1, 0x13, 0xc4, 0x5e, 0x52, 0xe1, 0x04, 0xe2, 0x2a, 0x35, 0x30, 0x72, 0x29, 0xe0, 0x76,
-	0x0c, 0x79, 0x3e, 0x14, 0xd2, 0xe1, 0x12, 0x42, 0x55, 0x5f, 0x5a, 0x9c, 0x9a, 0x02, 0xb6, 0x99,
-	0x25, 0x48, 0x00, 0x59, 0x79, 0x68, 0x71, 0x6a, 0x8a, 0x56, 0x0e, 0x17, 0x17, 0xc2, 0xdd, 0x42,
-	0xd2, 0x5c, 0xe2, 0x21, 0x11, 0xf1, 0x01, 0x41, 0x9e, 0xfe, 0x41, 0x9e, 0x21, 0x91, 0xf1, 0xa1,
-	0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42, 0xc2, 0x5c, 0xfc,
-	0xc8, 0x92, 0x3e, 0xfe, 0xe1, 0x02, 0x8c, 0x42, 0x62, 0x5c, 0x42, 0xc8, 0x82, 0xbe, 0xae, 0x2e,
-	0x9e, 0xa1, 0xbe, 0x02, 0x4c, 0x42, 0x22, 0x5c, 0x02, 0xc8, 0xe2, 0x1e, 0x9e, 0xee, 0x1e, 0x02,
-	0xcc, 0x46, 0xfb, 0x98, 0xb8, 0x78, 0xdc, 0x13, 0x8b, 0x5d, 0x61, 0x49, 0x4d, 0xa8, 0x93, 0x91,
-	0x4b, 0x00, 0x3d, 0x04, 0x84, 0x2c, 0x08, 0x06, 0x35, 0x8e, 0x74, 0x27, 0x65, 0x49, 0x86, 0x4e,
-	0x48, 0x30, 0x2b, 0x31, 0x08, 0x2d, 0x64, 0xc4, 0x4c, 0x67, 0xb0, 0xd8, 0x10, 0x72, 0x20, 0xd9,
-	0x64, 0xb4, 0x54, 0x25, 0xe5, 0x48, 0x81, 0x09, 0x30, 0x37, 0x3a, 0x85, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x55, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xcc, 0xa2, 0xfc, 0xa2, 0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0x1f, 0x84,
-	0xd3, 0x8b, 0x0a, 0x92, 0x41, 0x19, 0x1e, 0x61, 0x71, 0x12, 0x1b, 0x38, 0xc7, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x47, 0x63, 0x14, 0xb9, 0x28, 0x04, 0x00, 0x00,
+func (m *EstimateGasPriceFromHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasPriceFromHistoryRequest.Merge(m, src)
+}
+func (m *EstimateGasPriceFromHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateGasPriceFromHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasPriceFromHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_EstimateGasPriceFromHistoryRequest proto.InternalMessageInfo
 
-// GasEstimatorClient is the client API for GasEstimator service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GasEstimatorClient interface {
-	// estimateGasPrice takes a transaction priority and estimates the gas price
-	// based on the gas prices of the transactions in the last five blocks. If no
-	// transaction is found in the last five blocks, return the network min gas
-	// price. It's up to the light client to set the gas price in this case to the
-	// minimum gas price set by that node.
-	EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error)
-	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
-	// bytes and estimates the gas price and the gas used for that transaction.
-	// The gas price estimation is based on the gas prices of the transactions in
-	// the last five blocks. If no transaction is found in the last five blocks,
-	// return the network min gas price. It's up to the light client to set the
-	// gas price in this case to the minimum gas price set by that node. The gas
-	// used is estimated using the state machine simulation.
-	EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error)
+func (m *EstimateGasPriceFromHistoryRequest) GetPercentiles() []float64 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
 }
 
-type gasEstimatorClient struct {
-	cc grpc1.ClientConn
+func (m *EstimateGasPriceFromHistoryRequest) GetInclusionBlocks() uint64 {
+	if m != nil {
+		return m.InclusionBlocks
+	}
+	return 0
 }
 
-func NewGasEstimatorClient(cc grpc1.ClientConn) GasEstimatorClient {
-	return &gasEstimatorClient{cc}
+// PercentileGasPrice is the gas price at a percentile of the gas prices of
+// recently committed blocks.
+type PercentileGasPrice struct {
+	Percentile float64 `protobuf:"fixed64,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	GasPrice   float64 `protobuf:"fixed64,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 }
 
-func (c *gasEstimatorClient) EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error) {
-	out := new(EstimateGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PercentileGasPrice) Reset()         { *m = PercentileGasPrice{} }
+func (m *PercentileGasPrice) String() string { return proto.CompactTextString(m) }
+func (*PercentileGasPrice) ProtoMessage()    {}
+func (*PercentileGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{5}
+}
+func (m *PercentileGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PercentileGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PercentileGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PercentileGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentileGasPrice.Merge(m, src)
+}
+func (m *PercentileGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *PercentileGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentileGasPrice.DiscardUnknown(m)
 }
 
-func (c *gasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error) {
-	out := new(EstimateGasPriceAndUsageResponse)
-	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceAndUsage", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PercentileGasPrice proto.InternalMessageInfo
+
+func (m *PercentileGasPrice) GetPercentile() float64 {
+	if m != nil {
+		return m.Percentile
 	}
-	return out, nil
+	return 0
 }
 
-// GasEstimatorServer is the server API for GasEstimator service.
-type GasEstimatorServer interface {
-	// estimateGasPrice takes a transaction priority and estimates the gas price
-	// based on the gas prices of the transactions in the last five blocks. If no
-	// transaction is found in the last five blocks, return the network min gas
-	// price. It's up to the light client to set the gas price in this case to the
-	// minimum gas price set by that node.
-	EstimateGasPrice(context.Context, *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error)
-	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
-	// bytes and estimates the gas price and the gas used for that transaction.
-	// The gas price estimation is based on the gas prices of the transactions in
-	// the last five blocks. If no transaction is found in the last five blocks,
-	// return the network min gas price. It's up to the light client to set the
-	// gas price in this case to the minimum gas price set by that node. The gas
-	// used is estimated using the state machine simulation.
-	EstimateGasPriceAndUsage(context.Context, *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error)
+func (m *PercentileGasPrice) GetGasPrice() float64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
 }
 
-// UnimplementedGasEstimatorServer can be embedded to have forward compatible implementations.
-type UnimplementedGasEstimatorServer struct {
+// EstimateGasPriceFromHistoryResponse the response of the gas price
+// estimation from the gas prices of recently committed blocks.
+type EstimateGasPriceFromHistoryResponse struct {
+	PercentileGasPrices []*PercentileGasPrice `protobuf:"bytes,1,rep,name=percentile_gas_prices,json=percentileGasPrices,proto3" json:"percentile_gas_prices,omitempty"`
+	// inclusion_gas_price is the gas price that would have been included within
+	// inclusion_blocks blocks in most of the recent history.
+	InclusionGasPrice float64 `protobuf:"fixed64,2,opt,name=inclusion_gas_price,json=inclusionGasPrice,proto3" json:"inclusion_gas_price,omitempty"`
+	// confidence is an estimate, in the range [0, 1], of the probability that a
+	// transaction paying the inclusion gas price is included within
+	// inclusion_blocks blocks. It is lower if the node has recorded fewer blocks
+	// than the size of the window.
+	Confidence float64 `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// from_height and to_height are the range of recorded blocks the estimation
+	// is based on.
+	FromHeight int64 `protobuf:"varint,4,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,5,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// num_txs is the number of transactions the estimation is based on.
+	NumTxs uint64 `protobuf:"varint,6,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+}
+
+func (m *EstimateGasPriceFromHistoryResponse) Reset()         { *m = EstimateGasPriceFromHistoryResponse{} }
+func (m *EstimateGasPriceFromHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasPriceFromHistoryResponse) ProtoMessage()    {}
+func (*EstimateGasPriceFromHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{6}
+}
+func (m *EstimateGasPriceFromHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedGasEstimatorServer) EstimateGasPrice(ctx context.Context, req *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPrice not implemented")
+func (m *EstimateGasPriceFromHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateGasPriceFromHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedGasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, req *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceAndUsage not implemented")
+func (m *EstimateGasPriceFromHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasPriceFromHistoryResponse.Merge(m, src)
 }
-
-func RegisterGasEstimatorServer(s grpc1.Server, srv GasEstimatorServer) {
-	s.RegisterService(&_GasEstimator_serviceDesc, srv)
+func (m *EstimateGasPriceFromHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateGasPriceFromHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasPriceFromHistoryResponse.DiscardUnknown(m)
 }
 
-func _GasEstimator_EstimateGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateGasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GasEstimatorServer).EstimateGasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GasEstimatorServer).EstimateGasPrice(ctx, req.(*EstimateGasPriceRequest))
+var xxx_messageInfo_EstimateGasPriceFromHistoryResponse proto.InternalMessageInfo
+
+func (m *EstimateGasPriceFromHistoryResponse) GetPercentileGasPrices() []*PercentileGasPrice {
+	if m != nil {
+		return m.PercentileGasPrices
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _GasEstimator_EstimateGasPriceAndUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateGasPriceAndUsageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GasEstimatorServer).EstimateGasPriceAndUsage(ctx, in)
+func (m *EstimateGasPriceFromHistoryResponse) GetInclusionGasPrice() float64 {
+	if m != nil {
+		return m.InclusionGasPrice
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceAndUsage",
+	return 0
+}
+
+func (m *EstimateGasPriceFromHistoryResponse) GetConfidence() float64 {
+	if m != nil {
+		return m.Confidence
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GasEstimatorServer).EstimateGasPriceAndUsage(ctx, req.(*EstimateGasPriceAndUsageRequest))
+	return 0
+}
+
+func (m *EstimateGasPriceFromHistoryResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var GasEstimator_serviceDesc = _GasEstimator_serviceDesc
-var _GasEstimator_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.core.v1.gas_estimation.GasEstimator",
-	HandlerType: (*GasEstimatorServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EstimateGasPrice",
-			Handler:    _GasEstimator_EstimateGasPrice_Handler,
-		},
-		{
-			MethodName: "EstimateGasPriceAndUsage",
-			Handler:    _GasEstimator_EstimateGasPriceAndUsage_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/core/v1/gas_estimation/gas_estimator.proto",
+func (m *EstimateGasPriceFromHistoryResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
 }
 
-func (m *EstimateGasPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EstimateGasPriceFromHistoryResponse) GetNumTxs() uint64 {
+	if m != nil {
+		return m.NumTxs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EstimateGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BlobInfo describes a blob paid for by a PayForBlobs transaction.
+type BlobInfo struct {
+	// data_size is the size of the blob's data in bytes.
+	DataSize uint32 `protobuf:"varint,1,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	// namespace is the full namespace of the blob, i.e. the version followed by
+	// the ID.
+	Namespace    []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ShareVersion uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
 }
 
-func (m *EstimateGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
-		i--
-		dAtA[i] = 0x8
+func (m *BlobInfo) Reset()         { *m = BlobInfo{} }
+func (m *BlobInfo) String() string { return proto.CompactTextString(m) }
+func (*BlobInfo) ProtoMessage()    {}
+func (*BlobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{7}
+}
+func (m *BlobInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BlobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobInfo.Merge(m, src)
+}
+func (m *BlobInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobInfo.DiscardUnknown(m)
 }
 
-func (m *EstimateGasPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlobInfo proto.InternalMessageInfo
+
+func (m *BlobInfo) GetDataSize() uint32 {
+	if m != nil {
+		return m.DataSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EstimateGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlobInfo) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
 }
 
-func (m *EstimateGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EstimatedGasPrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
-		i--
-		dAtA[i] = 0x9
+func (m *BlobInfo) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EstimateGasPriceAndUsageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EstimateBlobCostRequest the request to estimate the gas used and fee of a
+// PayForBlobs transaction.
+type EstimateBlobCostRequest struct {
+	Blobs []*BlobInfo `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	// signer_pub_key_type is the type of the public key of the signer, either
+	// "secp256k1" or "secp256r1". Defaults to "secp256k1".
+	SignerPubKeyType string `protobuf:"bytes,2,opt,name=signer_pub_key_type,json=signerPubKeyType,proto3" json:"signer_pub_key_type,omitempty"`
+	// fee_granted is true if the fee is paid through a feegrant.
+	FeeGranted bool `protobuf:"varint,3,opt,name=fee_granted,json=feeGranted,proto3" json:"fee_granted,omitempty"`
 }
 
-func (m *EstimateGasPriceAndUsageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateBlobCostRequest) Reset()         { *m = EstimateBlobCostRequest{} }
+func (m *EstimateBlobCostRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBlobCostRequest) ProtoMessage()    {}
+func (*EstimateBlobCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{8}
+}
+func (m *EstimateBlobCostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBlobCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBlobCostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateBlobCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBlobCostRequest.Merge(m, src)
+}
+func (m *EstimateBlobCostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBlobCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBlobCostRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateGasPriceAndUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EstimateBlobCostRequest proto.InternalMessageInfo
+
+func (m *EstimateBlobCostRequest) GetBlobs() []*BlobInfo {
+	if m != nil {
+		return m.Blobs
 	}
-	if m.TxPriority != 0 {
-		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *EstimateBlobCostRequest) GetSignerPubKeyType() string {
+	if m != nil {
+		return m.SignerPubKeyType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EstimateGasPriceAndUsageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EstimateBlobCostRequest) GetFeeGranted() bool {
+	if m != nil {
+		return m.FeeGranted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EstimateGasPriceAndUsageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PriorityFee is the gas price and the resulting fee at a priority level.
+type PriorityFee struct {
+	TxPriority TxPriority `protobuf:"varint,1,opt,name=tx_priority,json=txPriority,proto3,enum=celestia.core.v1.gas_estimation.TxPriority" json:"tx_priority,omitempty"`
+	GasPrice   float64    `protobuf:"fixed64,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// fee is the fee in utia.
+	Fee uint64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EstimatedGasUsed != 0 {
-		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedGasUsed))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EstimatedGasPrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
-		i--
-		dAtA[i] = 0x9
+func (m *PriorityFee) Reset()         { *m = PriorityFee{} }
+func (m *PriorityFee) String() string { return proto.CompactTextString(m) }
+func (*PriorityFee) ProtoMessage()    {}
+func (*PriorityFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{9}
+}
+func (m *PriorityFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriorityFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriorityFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PriorityFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriorityFee.Merge(m, src)
+}
+func (m *PriorityFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriorityFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriorityFee.DiscardUnknown(m)
 }
 
-func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGasEstimator(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_PriorityFee proto.InternalMessageInfo
+
+func (m *PriorityFee) GetTxPriority() TxPriority {
+	if m != nil {
+		return m.TxPriority
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return TxPriority_TX_PRIORITY_UNSPECIFIED
 }
-func (m *EstimateGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PriorityFee) GetGasPrice() float64 {
+	if m != nil {
+		return m.GasPrice
 	}
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	return 0
+}
+
+func (m *PriorityFee) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
 	}
-	return n
+	return 0
 }
 
-func (m *EstimateGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// EstimateBlobCostResponse the response of the gas used and fee estimation of
+// a PayForBlobs transaction.
+type EstimateBlobCostResponse struct {
+	// estimated_gas_used is the sum of the gas components below multiplied by a
+	// safety margin.
+	EstimatedGasUsed uint64 `protobuf:"varint,1,opt,name=estimated_gas_used,json=estimatedGasUsed,proto3" json:"estimated_gas_used,omitempty"`
+	// blob_gas is the gas charged for the shares occupied by the blobs.
+	BlobGas uint64 `protobuf:"varint,2,opt,name=blob_gas,json=blobGas,proto3" json:"blob_gas,omitempty"`
+	// tx_size_gas is the gas charged for the size of the transaction.
+	TxSizeGas uint64 `protobuf:"varint,3,opt,name=tx_size_gas,json=txSizeGas,proto3" json:"tx_size_gas,omitempty"`
+	// sig_verify_gas is the gas charged for verifying the signature.
+	SigVerifyGas uint64 `protobuf:"varint,4,opt,name=sig_verify_gas,json=sigVerifyGas,proto3" json:"sig_verify_gas,omitempty"`
+	// feegrant_gas is the gas charged for using a feegrant.
+	FeegrantGas uint64 `protobuf:"varint,5,opt,name=feegrant_gas,json=feegrantGas,proto3" json:"feegrant_gas,omitempty"`
+	// state_access_gas is the gas charged for reading and writing the state,
+	// e.g. the signer's account and balance.
+	StateAccessGas uint64         `protobuf:"varint,6,opt,name=state_access_gas,json=stateAccessGas,proto3" json:"state_access_gas,omitempty"`
+	Fees           []*PriorityFee `protobuf:"bytes,7,rep,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (m *EstimateBlobCostResponse) Reset()         { *m = EstimateBlobCostResponse{} }
+func (m *EstimateBlobCostResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBlobCostResponse) ProtoMessage()    {}
+func (*EstimateBlobCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{10}
+}
+func (m *EstimateBlobCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBlobCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBlobCostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
+}
+func (m *EstimateBlobCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBlobCostResponse.Merge(m, src)
+}
+func (m *EstimateBlobCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBlobCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBlobCostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBlobCostResponse proto.InternalMessageInfo
+
+func (m *EstimateBlobCostResponse) GetEstimatedGasUsed() uint64 {
+	if m != nil {
+		return m.EstimatedGasUsed
 	}
-	return n
+	return 0
 }
 
-func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EstimateBlobCostResponse) GetBlobGas() uint64 {
+	if m != nil {
+		return m.BlobGas
 	}
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	return 0
+}
+
+func (m *EstimateBlobCostResponse) GetTxSizeGas() uint64 {
+	if m != nil {
+		return m.TxSizeGas
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovGasEstimator(uint64(l))
+	return 0
+}
+
+func (m *EstimateBlobCostResponse) GetSigVerifyGas() uint64 {
+	if m != nil {
+		return m.SigVerifyGas
 	}
-	return n
+	return 0
 }
 
-func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EstimateBlobCostResponse) GetFeegrantGas() uint64 {
+	if m != nil {
+		return m.FeegrantGas
 	}
-	var l int
-	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
+	return 0
+}
+
+func (m *EstimateBlobCostResponse) GetStateAccessGas() uint64 {
+	if m != nil {
+		return m.StateAccessGas
 	}
-	if m.EstimatedGasUsed != 0 {
-		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	return 0
+}
+
+func (m *EstimateBlobCostResponse) GetFees() []*PriorityFee {
+	if m != nil {
+		return m.Fees
 	}
-	return n
+	return nil
 }
 
-func sovGasEstimator(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func init() {
+	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxPriority", TxPriority_name, TxPriority_value)
+	proto.RegisterType((*EstimateGasPriceRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceRequest")
+	proto.RegisterType((*EstimateGasPriceResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceResponse")
+	proto.RegisterType((*EstimateGasPriceAndUsageRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageRequest")
+	proto.RegisterType((*EstimateGasPriceAndUsageResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageResponse")
+	proto.RegisterType((*EstimateGasPriceFromHistoryRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceFromHistoryRequest")
+	proto.RegisterType((*PercentileGasPrice)(nil), "celestia.core.v1.gas_estimation.PercentileGasPrice")
+	proto.RegisterType((*EstimateGasPriceFromHistoryResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceFromHistoryResponse")
+	proto.RegisterType((*BlobInfo)(nil), "celestia.core.v1.gas_estimation.BlobInfo")
+	proto.RegisterType((*EstimateBlobCostRequest)(nil), "celestia.core.v1.gas_estimation.EstimateBlobCostRequest")
+	proto.RegisterType((*PriorityFee)(nil), "celestia.core.v1.gas_estimation.PriorityFee")
+	proto.RegisterType((*EstimateBlobCostResponse)(nil), "celestia.core.v1.gas_estimation.EstimateBlobCostResponse")
 }
-func sozGasEstimator(x uint64) (n int) {
-	return sovGasEstimator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/gas_estimation/gas_estimator.proto", fileDescriptor_67d02876d749b9cc)
 }
-func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGasEstimator
+
+var fileDescriptor_67d02876d749b9cc = []byte{
+	// 968 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xb7, 0x6c, 0x27, 0x71, 0x9e, 0x9d, 0x54, 0xdd, 0x00, 0x31, 0x29, 0xe3, 0x18, 0x95, 0x83,
+	0x0b, 0xad, 0x3d, 0x4d, 0x2e, 0xc0, 0x85, 0xe6, 0x8f, 0xe3, 0x18, 0x5a, 0x6a, 0x54, 0xa7, 0xfc,
+	0xb9, 0x68, 0x64, 0xf9, 0x59, 0x59, 0x6a, 0x6b, 0x55, 0xed, 0x3a, 0x63, 0xf7, 0xc4, 0x15, 0x4e,
+	0x7c, 0x05, 0xae, 0xbd, 0x33, 0xc3, 0x47, 0xe0, 0xd8, 0x23, 0x47, 0x26, 0xf9, 0x10, 0x5c, 0x99,
+	0x5d, 0x59, 0x96, 0x6a, 0xb7, 0xf1, 0xa4, 0x85, 0x83, 0x67, 0xb4, 0xbf, 0xf7, 0xff, 0xfd, 0xde,
+	0xbe, 0x35, 0xec, 0x3a, 0xd8, 0x47, 0x2e, 0xa8, 0x5d, 0x73, 0x58, 0x80, 0xb5, 0xb3, 0xbb, 0x35,
+	0xd7, 0xe6, 0x96, 0x44, 0x06, 0xb6, 0xa0, 0xcc, 0x4b, 0x1e, 0x59, 0x50, 0xf5, 0x03, 0x26, 0x18,
+	0xd9, 0x8e, 0x8c, 0xaa, 0xd2, 0xa8, 0x7a, 0x76, 0xb7, 0xfa, 0xb2, 0x91, 0xe1, 0xc2, 0x66, 0x3d,
+	0x3c, 0x61, 0xc3, 0xe6, 0xad, 0x80, 0x3a, 0x68, 0xe2, 0xd3, 0x21, 0x72, 0x41, 0xee, 0x43, 0x5e,
+	0x8c, 0x2c, 0x3f, 0xa0, 0x2c, 0xa0, 0x62, 0x5c, 0xd4, 0xca, 0x5a, 0x65, 0x7d, 0xe7, 0x93, 0xea,
+	0x02, 0x8f, 0xd5, 0xf6, 0xa8, 0x35, 0x31, 0x31, 0x41, 0x4c, 0xbf, 0x8d, 0x2f, 0xa1, 0x38, 0x1f,
+	0x88, 0xfb, 0xcc, 0xe3, 0x48, 0xaa, 0xb0, 0x31, 0x71, 0x80, 0x5d, 0x4b, 0xba, 0xf3, 0xa5, 0x58,
+	0x45, 0xd4, 0xcc, 0xeb, 0x53, 0x51, 0x64, 0x67, 0xfc, 0xa2, 0xc1, 0xf6, 0xac, 0xb3, 0x3d, 0xaf,
+	0x7b, 0xc2, 0x6d, 0xf7, 0xff, 0xc9, 0x9e, 0xbc, 0x0f, 0x39, 0x31, 0xb2, 0x3a, 0x63, 0x81, 0xbc,
+	0x98, 0x2e, 0x6b, 0x95, 0x82, 0xb9, 0x22, 0x46, 0xfb, 0xf2, 0x68, 0xfc, 0xa4, 0x41, 0xf9, 0xf5,
+	0xc9, 0xbc, 0x59, 0x85, 0xe4, 0x36, 0x90, 0x97, 0xf5, 0x87, 0x1c, 0xbb, 0x2a, 0x72, 0xd6, 0xd4,
+	0x93, 0xea, 0x27, 0x1c, 0xbb, 0xc6, 0x53, 0x30, 0x66, 0x33, 0x38, 0x0a, 0xd8, 0xe0, 0x98, 0x72,
+	0xc1, 0x82, 0x71, 0xd4, 0x91, 0x32, 0xe4, 0x7d, 0x0c, 0x1c, 0xf4, 0x04, 0xed, 0x23, 0x2f, 0x6a,
+	0xe5, 0x4c, 0x45, 0x33, 0x93, 0x10, 0xb9, 0x05, 0x3a, 0xf5, 0x9c, 0xfe, 0x90, 0x53, 0xe6, 0x59,
+	0x9d, 0x3e, 0x73, 0x9e, 0xf0, 0x49, 0xcc, 0x6b, 0x53, 0x7c, 0x5f, 0xc1, 0xc6, 0x37, 0x40, 0x5a,
+	0x53, 0xcb, 0x69, 0xda, 0x25, 0x80, 0xd8, 0xdf, 0xa4, 0xba, 0x04, 0x42, 0x6e, 0xc0, 0x6a, 0x5c,
+	0x7c, 0x5a, 0x89, 0x73, 0x6e, 0xc4, 0xea, 0xef, 0x69, 0xb8, 0x79, 0x69, 0x19, 0x93, 0x5e, 0xba,
+	0xf0, 0x6e, 0xec, 0x32, 0x6e, 0x66, 0x58, 0x51, 0x7e, 0x67, 0x77, 0x21, 0xc7, 0xf3, 0x89, 0x9b,
+	0x1b, 0xfe, 0x1c, 0xc6, 0x25, 0x69, 0x71, 0x3b, 0x66, 0xf3, 0xbe, 0x3e, 0x15, 0x25, 0xab, 0x77,
+	0x98, 0xd7, 0xa3, 0x5d, 0xf4, 0x1c, 0x2c, 0x66, 0xc2, 0xea, 0x63, 0x84, 0x6c, 0x43, 0xbe, 0x17,
+	0xb0, 0x81, 0x75, 0x8a, 0xd4, 0x3d, 0x15, 0xc5, 0x6c, 0x59, 0xab, 0x64, 0x4c, 0x90, 0xd0, 0xb1,
+	0x42, 0x64, 0x7b, 0x04, 0x8b, 0xc4, 0x4b, 0x4a, 0x9c, 0x13, 0x6c, 0x22, 0xdc, 0x84, 0x15, 0x6f,
+	0x38, 0xb0, 0xc4, 0x88, 0x17, 0x97, 0x15, 0x27, 0xcb, 0xde, 0x70, 0xd0, 0x1e, 0x71, 0xe3, 0x47,
+	0xc8, 0xed, 0xf7, 0x59, 0xa7, 0xe9, 0xf5, 0x98, 0xf4, 0xd0, 0xb5, 0x85, 0x6d, 0x71, 0xfa, 0x2c,
+	0xec, 0xff, 0x9a, 0x99, 0x93, 0xc0, 0x23, 0xfa, 0x0c, 0xc9, 0x07, 0xb0, 0xea, 0xd9, 0x03, 0xe4,
+	0xbe, 0x3d, 0xa9, 0xa2, 0x60, 0xc6, 0x00, 0xb9, 0x09, 0x6b, 0xfc, 0xd4, 0x0e, 0xd0, 0x3a, 0xc3,
+	0x40, 0x96, 0xa5, 0x0a, 0x58, 0x33, 0x0b, 0x0a, 0x7c, 0x1c, 0x62, 0xc6, 0x73, 0x2d, 0xde, 0x17,
+	0x32, 0xe8, 0x01, 0xe3, 0x22, 0x9a, 0xaf, 0x2f, 0x60, 0xa9, 0xd3, 0x67, 0x9d, 0x88, 0x87, 0x5b,
+	0x0b, 0x79, 0x88, 0xb2, 0x36, 0x43, 0x3b, 0x72, 0x07, 0x36, 0x38, 0x75, 0x3d, 0x0c, 0x2c, 0x7f,
+	0xd8, 0xb1, 0x9e, 0xe0, 0xd8, 0x12, 0x63, 0x3f, 0xcc, 0x74, 0xd5, 0xd4, 0x43, 0x51, 0x6b, 0xd8,
+	0xf9, 0x0a, 0xc7, 0xed, 0xb1, 0x1f, 0xb6, 0x13, 0xd1, 0x72, 0x03, 0xdb, 0x13, 0xd8, 0x55, 0xe9,
+	0xe6, 0x4c, 0xe8, 0x21, 0x36, 0x42, 0x44, 0xae, 0x89, 0x7c, 0x74, 0x83, 0x8f, 0x10, 0xff, 0xe3,
+	0x95, 0x70, 0xd9, 0x2c, 0x13, 0x1d, 0x32, 0x3d, 0x0c, 0x67, 0x20, 0x6b, 0xca, 0x4f, 0xe3, 0x8f,
+	0x74, 0xbc, 0x00, 0xe3, 0xce, 0x4d, 0x46, 0xfa, 0xd5, 0xd7, 0x5d, 0x7b, 0xf5, 0x75, 0x97, 0xcb,
+	0x48, 0x36, 0x4c, 0x2a, 0x4e, 0xae, 0xe7, 0x8a, 0x3c, 0x37, 0x6c, 0x4e, 0x4a, 0xaa, 0x44, 0xc9,
+	0xbe, 0x92, 0x86, 0xf1, 0x57, 0xc5, 0x48, 0xf2, 0x2f, 0xe5, 0x1f, 0xc1, 0x3a, 0xa7, 0xae, 0xa4,
+	0x98, 0xf6, 0xc6, 0x4a, 0x25, 0xab, 0x54, 0x0a, 0x9c, 0xba, 0x8f, 0x15, 0x28, 0xb5, 0x3e, 0x84,
+	0x42, 0x0f, 0x51, 0x35, 0x56, 0xe9, 0x2c, 0x29, 0x9d, 0x7c, 0x84, 0x49, 0x95, 0x0a, 0xe8, 0x5c,
+	0xd8, 0x02, 0x2d, 0xdb, 0x71, 0x90, 0x73, 0xa5, 0x16, 0x8e, 0xe5, 0xba, 0xc2, 0xf7, 0x14, 0x2c,
+	0x35, 0xef, 0x41, 0xb6, 0x87, 0xc8, 0x8b, 0x2b, 0x6a, 0x2a, 0x6e, 0x2f, 0xbe, 0x9d, 0x31, 0x63,
+	0xa6, 0xb2, 0xfc, 0xb8, 0x0f, 0xd0, 0x4e, 0xf6, 0x7d, 0xb3, 0xfd, 0x9d, 0xd5, 0x32, 0x9b, 0x0f,
+	0xcd, 0x66, 0xfb, 0x7b, 0xeb, 0xe4, 0xeb, 0x47, 0xad, 0xfa, 0x41, 0xf3, 0xa8, 0x59, 0x3f, 0xd4,
+	0x53, 0x64, 0x03, 0xae, 0x25, 0x85, 0xf7, 0x1f, 0x7e, 0xab, 0x6b, 0xe4, 0x3d, 0x20, 0x49, 0xf0,
+	0x41, 0xfd, 0xb0, 0x79, 0xf2, 0x40, 0x4f, 0x93, 0x77, 0x40, 0x4f, 0xe2, 0xc7, 0xcd, 0xc6, 0xb1,
+	0x9e, 0xd9, 0xf9, 0x27, 0x0b, 0x85, 0x86, 0xcd, 0xeb, 0xd1, 0x4b, 0x4a, 0x7e, 0xd6, 0x40, 0x9f,
+	0xdd, 0x4b, 0xe4, 0xd3, 0x85, 0x75, 0xbc, 0xe6, 0x59, 0xdd, 0xfa, 0xec, 0x0d, 0x2c, 0xc3, 0x31,
+	0x31, 0x52, 0xe4, 0x37, 0x6d, 0xfe, 0x19, 0x8d, 0x1e, 0x1b, 0x72, 0xef, 0xca, 0x9e, 0x67, 0x1e,
+	0xcd, 0xad, 0xbd, 0xb7, 0xf0, 0x30, 0xcd, 0xf1, 0xb9, 0x06, 0x37, 0x2e, 0xd9, 0xe3, 0xe4, 0xe0,
+	0xca, 0x41, 0xe6, 0x1f, 0xb3, 0xad, 0xc3, 0xb7, 0x73, 0x32, 0x4d, 0x36, 0x49, 0x6e, 0x74, 0x2d,
+	0xaf, 0x40, 0xee, 0xcc, 0x0e, 0xbc, 0x02, 0xb9, 0xb3, 0x3b, 0xc0, 0x48, 0xed, 0xb7, 0xff, 0x3c,
+	0x2f, 0x69, 0x2f, 0xce, 0x4b, 0xda, 0xdf, 0xe7, 0x25, 0xed, 0xd7, 0x8b, 0x52, 0xea, 0xc5, 0x45,
+	0x29, 0xf5, 0xd7, 0x45, 0x29, 0xf5, 0xc3, 0xe7, 0x2e, 0x15, 0xa7, 0xc3, 0x4e, 0xd5, 0x61, 0x83,
+	0x5a, 0x14, 0x80, 0x05, 0xee, 0xf4, 0xfb, 0x8e, 0xed, 0xfb, 0x35, 0xf9, 0x73, 0x03, 0xdf, 0x91,
+	0x7f, 0x04, 0xe3, 0x80, 0x9d, 0x65, 0xf5, 0x4f, 0x70, 0xf7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x3d, 0x07, 0xd7, 0x65, 0x40, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GasEstimatorClient is the client API for GasEstimator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GasEstimatorClient interface {
+	// estimateGasPrice takes a transaction priority and estimates the gas price
+	// based on the gas prices of the transactions in the last five blocks. If no
+	// transaction is found in the last five blocks, return the network min gas
+	// price. It's up to the light client to set the gas price in this case to the
+	// minimum gas price set by that node.
+	EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error)
+	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
+	// bytes and estimates the gas price and the gas used for that transaction.
+	// The gas price estimation is based on the gas prices of the transactions in
+	// the last five blocks. If no transaction is found in the last five blocks,
+	// return the network min gas price. It's up to the light client to set the
+	// gas price in this case to the minimum gas price set by that node. The gas
+	// used is estimated using the state machine simulation.
+	EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error)
+	// EstimateGasPriceFromHistory estimates gas prices from the gas prices of the
+	// transactions in a rolling window of recently committed blocks kept by the
+	// node. It returns the requested percentiles of those gas prices and the gas
+	// price needed to be included within a number of blocks, together with the
+	// confidence in that estimate. The window is stored in the node's data
+	// directory, so it survives restarts.
+	EstimateGasPriceFromHistory(ctx context.Context, in *EstimateGasPriceFromHistoryRequest, opts ...grpc.CallOption) (*EstimateGasPriceFromHistoryResponse, error)
+	// EstimateBlobCost estimates the gas used by a PayForBlobs transaction paying
+	// for blobs of the given sizes, namespaces and share versions without
+	// requiring a signed transaction, and the resulting fee at each priority.
+	EstimateBlobCost(ctx context.Context, in *EstimateBlobCostRequest, opts ...grpc.CallOption) (*EstimateBlobCostResponse, error)
+}
+
+type gasEstimatorClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewGasEstimatorClient(cc grpc1.ClientConn) GasEstimatorClient {
+	return &gasEstimatorClient{cc}
+}
+
+func (c *gasEstimatorClient) EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error) {
+	out := new(EstimateGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error) {
+	out := new(EstimateGasPriceAndUsageResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceAndUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) EstimateGasPriceFromHistory(ctx context.Context, in *EstimateGasPriceFromHistoryRequest, opts ...grpc.CallOption) (*EstimateGasPriceFromHistoryResponse, error) {
+	out := new(EstimateGasPriceFromHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceFromHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) EstimateBlobCost(ctx context.Context, in *EstimateBlobCostRequest, opts ...grpc.CallOption) (*EstimateBlobCostResponse, error) {
+	out := new(EstimateBlobCostResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateBlobCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GasEstimatorServer is the server API for GasEstimator service.
+type GasEstimatorServer interface {
+	// estimateGasPrice takes a transaction priority and estimates the gas price
+	// based on the gas prices of the transactions in the last five blocks. If no
+	// transaction is found in the last five blocks, return the network min gas
+	// price. It's up to the light client to set the gas price in this case to the
+	// minimum gas price set by that node.
+	EstimateGasPrice(context.Context, *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error)
+	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
+	// bytes and estimates the gas price and the gas used for that transaction.
+	// The gas price estimation is based on the gas prices of the transactions in
+	// the last five blocks. If no transaction is found in the last five blocks,
+	// return the network min gas price. It's up to the light client to set the
+	// gas price in this case to the minimum gas price set by that node. The gas
+	// used is estimated using the state machine simulation.
+	EstimateGasPriceAndUsage(context.Context, *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error)
+	// EstimateGasPriceFromHistory estimates gas prices from the gas prices of the
+	// transactions in a rolling window of recently committed blocks kept by the
+	// node. It returns the requested percentiles of those gas prices and the gas
+	// price needed to be included within a number of blocks, together with the
+	// confidence in that estimate. The window is stored in the node's data
+	// directory, so it survives restarts.
+	EstimateGasPriceFromHistory(context.Context, *EstimateGasPriceFromHistoryRequest) (*EstimateGasPriceFromHistoryResponse, error)
+	// EstimateBlobCost estimates the gas used by a PayForBlobs transaction paying
+	// for blobs of the given sizes, namespaces and share versions without
+	// requiring a signed transaction, and the resulting fee at each priority.
+	EstimateBlobCost(context.Context, *EstimateBlobCostRequest) (*EstimateBlobCostResponse, error)
+}
+
+// UnimplementedGasEstimatorServer can be embedded to have forward compatible implementations.
+type UnimplementedGasEstimatorServer struct {
+}
+
+func (*UnimplementedGasEstimatorServer) EstimateGasPrice(ctx context.Context, req *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPrice not implemented")
+}
+func (*UnimplementedGasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, req *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceAndUsage not implemented")
+}
+func (*UnimplementedGasEstimatorServer) EstimateGasPriceFromHistory(ctx context.Context, req *EstimateGasPriceFromHistoryRequest) (*EstimateGasPriceFromHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceFromHistory not implemented")
+}
+func (*UnimplementedGasEstimatorServer) EstimateBlobCost(ctx context.Context, req *EstimateBlobCostRequest) (*EstimateBlobCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBlobCost not implemented")
+}
+
+func RegisterGasEstimatorServer(s grpc1.Server, srv GasEstimatorServer) {
+	s.RegisterService(&_GasEstimator_serviceDesc, srv)
+}
+
+func _GasEstimator_EstimateGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateGasPrice(ctx, req.(*EstimateGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_EstimateGasPriceAndUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasPriceAndUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateGasPriceAndUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceAndUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateGasPriceAndUsage(ctx, req.(*EstimateGasPriceAndUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_EstimateGasPriceFromHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasPriceFromHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateGasPriceFromHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceFromHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateGasPriceFromHistory(ctx, req.(*EstimateGasPriceFromHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_EstimateBlobCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBlobCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateBlobCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateBlobCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateBlobCost(ctx, req.(*EstimateBlobCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var GasEstimator_serviceDesc = _GasEstimator_serviceDesc
+var _GasEstimator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.gas_estimation.GasEstimator",
+	HandlerType: (*GasEstimatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EstimateGasPrice",
+			Handler:    _GasEstimator_EstimateGasPrice_Handler,
+		},
+		{
+			MethodName: "EstimateGasPriceAndUsage",
+			Handler:    _GasEstimator_EstimateGasPriceAndUsage_Handler,
+		},
+		{
+			MethodName: "EstimateGasPriceFromHistory",
+			Handler:    _GasEstimator_EstimateGasPriceFromHistory_Handler,
+		},
+		{
+			MethodName: "EstimateBlobCost",
+			Handler:    _GasEstimator_EstimateBlobCost_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/gas_estimation/gas_estimator.proto",
+}
+
+func (m *EstimateGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceAndUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceAndUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceAndUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxPriority != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceAndUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceAndUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedGasUsed != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EstimatedGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceFromHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceFromHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceFromHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InclusionBlocks != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.InclusionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Percentiles) > 0 {
+		for iNdEx := len(m.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(m.Percentiles[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+		}
+		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.Percentiles)*8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PercentileGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PercentileGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PercentileGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Percentile != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Percentile))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceFromHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceFromHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceFromHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumTxs != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Confidence != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Confidence))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.InclusionGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.InclusionGasPrice))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.PercentileGasPrices) > 0 {
+		for iNdEx := len(m.PercentileGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PercentileGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShareVersion != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DataSize != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.DataSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBlobCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBlobCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBlobCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeGranted {
+		i--
+		if m.FeeGranted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignerPubKeyType) > 0 {
+		i -= len(m.SignerPubKeyType)
+		copy(dAtA[i:], m.SignerPubKeyType)
+		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.SignerPubKeyType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriorityFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriorityFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriorityFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fee != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.TxPriority != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBlobCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBlobCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBlobCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.StateAccessGas != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.StateAccessGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FeegrantGas != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.FeegrantGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SigVerifyGas != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.SigVerifyGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxSizeGas != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxSizeGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlobGas != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.BlobGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EstimatedGasUsed != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedGasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasEstimator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EstimateGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	if m.EstimatedGasUsed != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceFromHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		n += 1 + sovGasEstimator(uint64(len(m.Percentiles)*8)) + len(m.Percentiles)*8
+	}
+	if m.InclusionBlocks != 0 {
+		n += 1 + sovGasEstimator(uint64(m.InclusionBlocks))
+	}
+	return n
+}
+
+func (m *PercentileGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 9
+	}
+	if m.GasPrice != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *EstimateGasPriceFromHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PercentileGasPrices) > 0 {
+		for _, e := range m.PercentileGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	if m.InclusionGasPrice != 0 {
+		n += 9
+	}
+	if m.Confidence != 0 {
+		n += 9
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovGasEstimator(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovGasEstimator(uint64(m.ToHeight))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovGasEstimator(uint64(m.NumTxs))
+	}
+	return n
+}
+
+func (m *BlobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSize != 0 {
+		n += 1 + sovGasEstimator(uint64(m.DataSize))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovGasEstimator(uint64(m.ShareVersion))
+	}
+	return n
+}
+
+func (m *EstimateBlobCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	l = len(m.SignerPubKeyType)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if m.FeeGranted {
+		n += 2
+	}
+	return n
+}
+
+func (m *PriorityFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	if m.GasPrice != 0 {
+		n += 9
+	}
+	if m.Fee != 0 {
+		n += 1 + sovGasEstimator(uint64(m.Fee))
+	}
+	return n
+}
+
+func (m *EstimateBlobCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasUsed != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	}
+	if m.BlobGas != 0 {
+		n += 1 + sovGasEstimator(uint64(m.BlobGas))
+	}
+	if m.TxSizeGas != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxSizeGas))
+	}
+	if m.SigVerifyGas != 0 {
+		n += 1 + sovGasEstimator(uint64(m.SigVerifyGas))
+	}
+	if m.FeegrantGas != 0 {
+		n += 1 + sovGasEstimator(uint64(m.FeegrantGas))
+	}
+	if m.StateAccessGas != 0 {
+		n += 1 + sovGasEstimator(uint64(m.StateAccessGas))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGasEstimator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGasEstimator(x uint64) (n int) {
+	return sovGasEstimator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= TxPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= TxPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasUsed", wireType)
+			}
+			m.EstimatedGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceFromHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceFromHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceFromHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Percentiles = append(m.Percentiles, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Percentiles = append(m.Percentiles, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionBlocks", wireType)
+			}
+			m.InclusionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PercentileGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentileGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentileGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Percentile = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasPrice = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceFromHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceFromHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceFromHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentileGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PercentileGasPrices = append(m.PercentileGasPrices, &PercentileGasPrice{})
+			if err := m.PercentileGasPrices[len(m.PercentileGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.InclusionGasPrice = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Confidence = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
+			}
+			m.DataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if byteLen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
 			}
-			m.TxPriority = 0
+			m.ShareVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGasEstimator
@@ -699,7 +2542,7 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxPriority |= TxPriority(b&0x7F) << shift
+				m.ShareVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -725,7 +2568,7 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateBlobCostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -748,23 +2591,98 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateBlobCostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateBlobCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+			m.Blobs = append(m.Blobs, &BlobInfo{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerPubKeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerPubKeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeGranted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -786,7 +2704,7 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
+func (m *PriorityFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -809,10 +2727,10 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriorityFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriorityFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -835,10 +2753,21 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
-			var byteLen int
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasPrice = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGasEstimator
@@ -848,26 +2777,11 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGasEstimator
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGasEstimator
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -889,7 +2803,7 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateBlobCostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -912,24 +2826,13 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateBlobCostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateBlobCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.EstimatedGasPrice = float64(math.Float64frombits(v))
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasUsed", wireType)
 			}
@@ -948,6 +2851,135 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobGas", wireType)
+			}
+			m.BlobGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeGas", wireType)
+			}
+			m.TxSizeGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyGas", wireType)
+			}
+			m.SigVerifyGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeegrantGas", wireType)
+			}
+			m.FeegrantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeegrantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateAccessGas", wireType)
+			}
+			m.StateAccessGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateAccessGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, &PriorityFee{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/x/feegrant"
 	"github.com/celestiaorg/celestia-app/v6/app"
 	"github.com/celestiaorg/celestia-app/v6/app/encoding"
 	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
@@ -16,6 +17,13 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
 	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
 	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,6 +95,15 @@ func TestGasEstimatorE2E(t *testing.T) {
 	gasPriceResp, err = estimatorClient.EstimateGasPrice(ctx, &gasestimation.EstimateGasPriceRequest{})
 	require.NoError(t, err)
 	require.Equal(t, gasPriceResp.EstimatedGasPrice, appconsts.DefaultMinGasPrice)
+
+	// the committed transaction is part of the gas price history
+	historyResp, err := estimatorClient.EstimateGasPriceFromHistory(ctx, &gasestimation.EstimateGasPriceFromHistoryRequest{
+		Percentiles: []float64{100},
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, historyResp.NumTxs, uint64(1))
+	require.InDelta(t, highGasPrice, historyResp.PercentileGasPrices[0].GasPrice, 1e-4)
+	require.Greater(t, historyResp.Confidence, 0.0)
 }
 
 func TestGasEstimatorE2EWithNetworkMinGasPrice(t *testing.T) {
@@ -129,3 +146,125 @@ func TestGasEstimatorE2EWithNetworkMinGasPrice(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, gasPriceResp.EstimatedGasPrice, networkMinGasPrice)
 }
+
+func TestEstimateBlobCostE2E(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestEstimateBlobCostE2E in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	cctx, _, _ := testnode.NewNetwork(t, testnode.DefaultConfig().WithTimeoutCommit(100*time.Millisecond))
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := user.SetupTxClient(ctx, cctx.Keyring, cctx.GRPCClient, enc)
+	require.NoError(t, err)
+	estimatorClient := gasestimation.NewGasEstimatorClient(cctx.GRPCClient)
+
+	newBlob := func(size int) *share.Blob {
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(size))
+		require.NoError(t, err)
+		return blob
+	}
+	blobInfos := func(blobs []*share.Blob) []*gasestimation.BlobInfo {
+		infos := make([]*gasestimation.BlobInfo, len(blobs))
+		for i, blob := range blobs {
+			infos[i] = &gasestimation.BlobInfo{
+				DataSize:     uint32(len(blob.Data())),
+				Namespace:    blob.Namespace().Bytes(),
+				ShareVersion: uint32(blob.ShareVersion()),
+			}
+		}
+		return infos
+	}
+
+	for _, blobSizes := range [][]int{{100}, {10_000}, {1000, 200_000}} {
+		blobs := make([]*share.Blob, len(blobSizes))
+		for i, size := range blobSizes {
+			blobs[i] = newBlob(size)
+		}
+
+		costResp, err := estimatorClient.EstimateBlobCost(ctx, &gasestimation.EstimateBlobCostRequest{Blobs: blobInfos(blobs)})
+		require.NoError(t, err)
+		require.Len(t, costResp.Fees, 3)
+
+		// the estimation without a signed tx covers the first tx of a
+		// signer, which stores its public key, so it is above the simulated
+		// gas of a signed tx of an account that signed before.
+		txBytes, _, err := txClient.Signer().CreatePayForBlobs(txClient.DefaultAccountName(), blobs, user.SetGasLimitAndGasPrice(10_000_000, appconsts.DefaultMinGasPrice))
+		require.NoError(t, err)
+		usageResp, err := estimatorClient.EstimateGasPriceAndUsage(ctx, &gasestimation.EstimateGasPriceAndUsageRequest{TxBytes: txBytes})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, costResp.EstimatedGasUsed, usageResp.EstimatedGasUsed)
+	}
+
+	// simulate the first PayForBlobs signed by new accounts for each public
+	// key type, with and without a feegrant from the default account, which
+	// the estimation is slightly above
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	privKeys := map[string]cryptotypes.PrivKey{
+		gasestimation.PubKeyTypeSecp256k1: secp256k1.GenPrivKey(),
+		gasestimation.PubKeyTypeSecp256r1: secp256r1Key,
+	}
+	for pubKeyType, privKey := range privKeys {
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+		grant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, txClient.DefaultAddress(), addr)
+		require.NoError(t, err)
+		send := banktypes.NewMsgSend(txClient.DefaultAddress(), addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1_000_000)))
+		_, err = txClient.SubmitTx(ctx, []sdk.Msg{send, grant}, user.SetGasLimitAndGasPrice(200_000, appconsts.DefaultMinGasPrice))
+		require.NoError(t, err)
+
+		// simulatedGas is the simulated gas of the PayForBlobs without and
+		// with a feegrant
+		simulatedGas := make(map[bool]uint64, 2)
+		var feegrantGas uint64
+		for _, feeGranted := range []bool{false, true} {
+			blobs := []*share.Blob{newBlob(1000), newBlob(200_000)}
+			costResp, err := estimatorClient.EstimateBlobCost(ctx, &gasestimation.EstimateBlobCostRequest{
+				Blobs:            blobInfos(blobs),
+				SignerPubKeyType: pubKeyType,
+				FeeGranted:       feeGranted,
+			})
+			require.NoError(t, err)
+
+			builder := enc.TxConfig.NewTxBuilder()
+			msg, err := blobtypes.NewMsgPayForBlobs(addr.String(), appconsts.Version, blobs...)
+			require.NoError(t, err)
+			require.NoError(t, builder.SetMsgs(msg))
+			builder.SetGasLimit(10_000_000)
+			builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 100_000)))
+			if feeGranted {
+				builder.SetFeeGranter(txClient.DefaultAddress())
+			}
+			// signatures aren't verified in simulations
+			require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+				PubKey: privKey.PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: make([]byte, 64)},
+			}))
+			txBytes, err := enc.TxConfig.TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			blobTx, err := blobtx.MarshalBlobTx(txBytes, blobs...)
+			require.NoError(t, err)
+			usageResp, err := estimatorClient.EstimateGasPriceAndUsage(ctx, &gasestimation.EstimateGasPriceAndUsageRequest{TxBytes: blobTx})
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, costResp.EstimatedGasUsed, usageResp.EstimatedGasUsed, "%s feegrant %v", pubKeyType, feeGranted)
+			require.LessOrEqual(t, costResp.EstimatedGasUsed, usageResp.EstimatedGasUsed*11/10, "%s feegrant %v", pubKeyType, feeGranted)
+			simulatedGas[feeGranted] = usageResp.EstimatedGasUsed
+			feegrantGas += costResp.FeegrantGas
+		}
+		// the feegrant gas measured by the estimation is part of the overhead
+		// of a feegrant, which also includes the size of the fee granter
+		feegrantOverhead := simulatedGas[true] - simulatedGas[false]
+		require.Positive(t, feegrantGas, pubKeyType)
+		require.Less(t, feegrantGas, feegrantOverhead, pubKeyType)
+	}
+
+	_, err = estimatorClient.EstimateBlobCost(ctx, &gasestimation.EstimateBlobCostRequest{
+		Blobs:            []*gasestimation.BlobInfo{{DataSize: 100, Namespace: share.RandomBlobNamespace().Bytes()}},
+		SignerPubKeyType: "ed25519",
+	})
+	require.Error(t, err)
+}
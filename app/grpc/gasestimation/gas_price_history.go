@@ -0,0 +1,339 @@
+package gasestimation
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultGasPriceHistorySize is the number of recently committed blocks
+	// whose gas prices are kept by the node.
+	DefaultGasPriceHistorySize = 100
+	// GasPriceHistoryDBName is the name of the database of the gas price
+	// history in the node's data directory.
+	GasPriceHistoryDBName = "gas_price_history"
+	// inclusionTargetRatio is the share of the historical windows of blocks in
+	// which the inclusion gas price must have been enough to be included.
+	inclusionTargetRatio = 0.9
+)
+
+// defaultPercentiles are the percentiles returned if none are requested.
+var defaultPercentiles = []float64{10, 50, 90}
+
+// errNoGasLimit is returned for a transaction without a gas limit, whose gas
+// price is undefined.
+var errNoGasLimit = errors.New("transaction has no gas limit")
+
+// blockGasPrices are the gas prices of the transactions of a committed block.
+type blockGasPrices struct {
+	height int64
+	// gasPrices are sorted in ascending order.
+	gasPrices []float64
+	// txsBytes is the total size of the transactions of the block.
+	txsBytes int64
+}
+
+var _ storetypes.ABCIListener = &GasPriceHistory{}
+
+// GasPriceHistory is a rolling window of the gas prices of the transactions of
+// recently committed blocks. It is filled from finalized blocks as an ABCI
+// listener and stored in a local database of the node, so that it survives
+// restarts. Blocks
+// committed while the node was stopped are not recorded. GasPriceHistory is
+// thread-safe.
+type GasPriceHistory struct {
+	db        dbm.DB
+	txDecoder sdk.TxDecoder
+	size      int
+
+	mtx    sync.RWMutex
+	blocks []blockGasPrices
+}
+
+// NewGasPriceHistory returns a GasPriceHistory that keeps the gas prices of
+// the last size blocks in db, loading the blocks already stored in it.
+func NewGasPriceHistory(db dbm.DB, txDecoder sdk.TxDecoder, size int) (*GasPriceHistory, error) {
+	h := &GasPriceHistory{
+		db:        db,
+		txDecoder: txDecoder,
+		size:      max(size, 1),
+	}
+	it, err := db.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid() && len(h.blocks) < h.size; it.Next() {
+		block, err := decodeBlockGasPrices(it.Key(), it.Value())
+		if err != nil {
+			return nil, err
+		}
+		h.blocks = append(h.blocks, block)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	slices.Reverse(h.blocks)
+	return h, nil
+}
+
+// Close closes the database of the history.
+func (h *GasPriceHistory) Close() error {
+	return h.db.Close()
+}
+
+// ListenFinalizeBlock records the gas prices of the transactions of the
+// finalized block.
+func (h *GasPriceHistory) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	return h.Record(req.Height, req.Txs)
+}
+
+// ListenCommit implements the ABCIListener interface.
+func (h *GasPriceHistory) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// Record adds the gas prices of the transactions of the block at the given
+// height, evicting the oldest block once the window is full. Recording a
+// height that isn't above the last recorded one, e.g. after a rollback,
+// discards the blocks from that height onward. Transactions that can't be
+// decoded or have no gas limit are skipped. The block is recorded in memory
+// even if it can't be stored.
+func (h *GasPriceHistory) Record(height int64, txs [][]byte) error {
+	block := blockGasPrices{height: height, gasPrices: make([]float64, 0, len(txs))}
+	for _, rawTx := range txs {
+		gasPrice, err := decodeGasPrice(h.txDecoder, rawTx)
+		if err != nil {
+			continue
+		}
+		block.gasPrices = append(block.gasPrices, gasPrice)
+		block.txsBytes += int64(len(rawTx))
+	}
+	sort.Float64s(block.gasPrices)
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	for len(h.blocks) > 0 && h.blocks[len(h.blocks)-1].height >= height {
+		h.blocks = h.blocks[:len(h.blocks)-1]
+	}
+	if len(h.blocks) == h.size {
+		h.blocks = append(h.blocks[:0], h.blocks[1:]...)
+	}
+	h.blocks = append(h.blocks, block)
+	return h.store(block, h.blocks[0].height)
+}
+
+// store writes the block to the database and deletes the blocks from its
+// height onward and the blocks below the oldest height of the window.
+func (h *GasPriceHistory) store(block blockGasPrices, oldestHeight int64) error {
+	batch := h.db.NewBatch()
+	defer batch.Close()
+
+	if err := h.deleteRange(batch, encodeHeight(block.height), nil); err != nil {
+		return err
+	}
+	if err := h.deleteRange(batch, nil, encodeHeight(oldestHeight)); err != nil {
+		return err
+	}
+	if err := batch.Set(encodeHeight(block.height), encodeBlockGasPrices(block)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// deleteRange deletes the blocks whose keys are in the range [start, end).
+func (h *GasPriceHistory) deleteRange(batch dbm.Batch, start, end []byte) error {
+	it, err := h.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// Size returns the number of blocks the window holds once it is full.
+func (h *GasPriceHistory) Size() int {
+	return h.size
+}
+
+// snapshot returns the recorded blocks ordered by height. The returned blocks
+// must not be modified.
+func (h *GasPriceHistory) snapshot() []blockGasPrices {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	return append([]blockGasPrices{}, h.blocks...)
+}
+
+// EstimateGasPriceFromHistory estimates gas prices from the gas prices of the
+// recently committed blocks. The returned gas prices are at least the network
+// minimum gas price. If no block was recorded yet, the minimum gas price is
+// returned with a confidence of zero.
+func (s *gasEstimatorServer) EstimateGasPriceFromHistory(_ context.Context, request *EstimateGasPriceFromHistoryRequest) (*EstimateGasPriceFromHistoryResponse, error) {
+	if s.gasPriceHistory == nil {
+		return nil, errors.New("gas price history is not available")
+	}
+	percentiles := request.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = defaultPercentiles
+	}
+	for _, percentile := range percentiles {
+		if math.IsNaN(percentile) || percentile < 0 || percentile > 100 {
+			return nil, fmt.Errorf("percentile %v must be in the range [0, 100]", percentile)
+		}
+	}
+	inclusionBlocks := max(int(request.InclusionBlocks), 1)
+
+	minGasPrice, err := s.minGasPriceFn()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get min gas price: %w", err)
+	}
+	minGasPrice = math.Max(appconsts.DefaultMinGasPrice, minGasPrice)
+	govMaxSquareBytes, err := s.govMaxSquareBytesFn()
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := s.gasPriceHistory.snapshot()
+	resp := &EstimateGasPriceFromHistoryResponse{
+		PercentileGasPrices: make([]*PercentileGasPrice, len(percentiles)),
+		InclusionGasPrice:   minGasPrice,
+	}
+	var gasPrices []float64
+	for _, block := range blocks {
+		gasPrices = append(gasPrices, block.gasPrices...)
+	}
+	sort.Float64s(gasPrices)
+	for i, percentile := range percentiles {
+		resp.PercentileGasPrices[i] = &PercentileGasPrice{
+			Percentile: percentile,
+			GasPrice:   math.Max(Percentile(gasPrices, percentile), minGasPrice),
+		}
+	}
+	if len(blocks) == 0 {
+		return resp, nil
+	}
+
+	inclusionGasPrice, probability := estimateInclusionGasPrice(blocks, inclusionBlocks, float64(govMaxSquareBytes)*gasPriceEstimationThreshold)
+	resp.InclusionGasPrice = math.Max(inclusionGasPrice, minGasPrice)
+	resp.Confidence = probability * float64(len(blocks)) / float64(s.gasPriceHistory.Size())
+	resp.FromHeight = blocks[0].height
+	resp.ToHeight = blocks[len(blocks)-1].height
+	resp.NumTxs = uint64(len(gasPrices))
+	return resp, nil
+}
+
+// estimateInclusionGasPrice returns the lowest gas price that would have been
+// included within inclusionBlocks consecutive blocks in at least
+// inclusionTargetRatio of the windows of the history, together with the share
+// of windows in which it would have been included. A block whose transactions
+// are below fullBlockBytes wasn't full so it would have included any gas price
+// above the minimum. Otherwise, its clearing price is the lowest gas price it
+// included.
+func estimateInclusionGasPrice(blocks []blockGasPrices, inclusionBlocks int, fullBlockBytes float64) (float64, float64) {
+	clearingPrices := make([]float64, len(blocks))
+	for i, block := range blocks {
+		if float64(block.txsBytes) >= fullBlockBytes && len(block.gasPrices) > 0 {
+			clearingPrices[i] = block.gasPrices[0]
+		}
+	}
+
+	// the gas price needed to be included in a window is the lowest clearing
+	// price of its blocks.
+	windowSize := min(inclusionBlocks, len(blocks))
+	neededPrices := make([]float64, 0, len(blocks)-windowSize+1)
+	for start := 0; start+windowSize <= len(blocks); start++ {
+		needed := clearingPrices[start]
+		for _, price := range clearingPrices[start+1 : start+windowSize] {
+			needed = math.Min(needed, price)
+		}
+		neededPrices = append(neededPrices, needed)
+	}
+	sort.Float64s(neededPrices)
+
+	index := int(math.Ceil(inclusionTargetRatio*float64(len(neededPrices)))) - 1
+	gasPrice := neededPrices[max(index, 0)]
+	included := sort.Search(len(neededPrices), func(i int) bool { return neededPrices[i] > gasPrice })
+	return gasPrice, float64(included) / float64(len(neededPrices))
+}
+
+// Percentile returns the percentile, in the range [0, 100], of the provided
+// gas prices, interpolating linearly between the closest ranks. Expects a
+// sorted slice. Returns zero for an empty slice.
+func Percentile(gasPrices []float64, percentile float64) float64 {
+	if len(gasPrices) == 0 {
+		return 0
+	}
+	rank := percentile / 100 * float64(len(gasPrices)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return gasPrices[lower] + (gasPrices[upper]-gasPrices[lower])*(rank-float64(lower))
+}
+
+// decodeGasPrice returns the gas price of the raw transaction, which can be a
+// blob transaction.
+func decodeGasPrice(txDecoder sdk.TxDecoder, rawTx []byte) (float64, error) {
+	txBytes := rawTx
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	if isBlob {
+		if err != nil {
+			return 0, err
+		}
+		txBytes = bTx.Tx
+	}
+	sdkTx, err := txDecoder(txBytes)
+	if err != nil {
+		return 0, err
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return 0, errNoGasLimit
+	}
+	return float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas()), nil
+}
+
+func encodeHeight(height int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
+
+// encodeBlockGasPrices encodes the size of the transactions of the block
+// followed by their gas prices.
+func encodeBlockGasPrices(block blockGasPrices) []byte {
+	bz := binary.BigEndian.AppendUint64(make([]byte, 0, 8*(len(block.gasPrices)+1)), uint64(block.txsBytes))
+	for _, gasPrice := range block.gasPrices {
+		bz = binary.BigEndian.AppendUint64(bz, math.Float64bits(gasPrice))
+	}
+	return bz
+}
+
+func decodeBlockGasPrices(key, value []byte) (blockGasPrices, error) {
+	if len(key) != 8 || len(value) < 8 || len(value)%8 != 0 {
+		return blockGasPrices{}, fmt.Errorf("invalid gas price history entry at key %X", key)
+	}
+	block := blockGasPrices{
+		height:    int64(binary.BigEndian.Uint64(key)),
+		txsBytes:  int64(binary.BigEndian.Uint64(value)),
+		gasPrices: make([]float64, 0, len(value)/8-1),
+	}
+	for i := 8; i < len(value); i += 8 {
+		block.gasPrices = append(block.gasPrices, math.Float64frombits(binary.BigEndian.Uint64(value[i:])))
+	}
+	return block, nil
+}
@@ -0,0 +1,161 @@
+package gasestimation
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile(t *testing.T) {
+	gasPrices := []float64{1, 2, 3, 4, 5}
+	require.Equal(t, 1.0, Percentile(gasPrices, 0))
+	require.Equal(t, 3.0, Percentile(gasPrices, 50))
+	require.Equal(t, 5.0, Percentile(gasPrices, 100))
+	require.InDelta(t, 1.4, Percentile(gasPrices, 10), 1e-9)
+	require.Equal(t, 0.0, Percentile(nil, 50))
+}
+
+func TestGasPriceHistoryRecord(t *testing.T) {
+	db := dbm.NewMemDB()
+	history, err := NewGasPriceHistory(db, fakeTxDecoder, 3)
+	require.NoError(t, err)
+	// the blocks are recorded as they are finalized
+	for height := int64(1); height <= 4; height++ {
+		req := abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{fakeTx(1000, 4000), fakeTx(1000, 2000), fakeTx(0, 1000), []byte("invalid")}}
+		require.NoError(t, history.ListenFinalizeBlock(context.Background(), req, abci.ResponseFinalizeBlock{}))
+	}
+	blocks := history.snapshot()
+	require.Len(t, blocks, 3)
+	require.EqualValues(t, 2, blocks[0].height)
+	require.Equal(t, []float64{2, 4}, blocks[2].gasPrices)
+	require.EqualValues(t, 2*len(fakeTx(0, 0)), blocks[2].txsBytes)
+
+	// recording a height again discards the blocks from that height onward
+	require.NoError(t, history.Record(3, nil))
+	blocks = history.snapshot()
+	require.Len(t, blocks, 2)
+	require.EqualValues(t, 3, blocks[1].height)
+	require.Empty(t, blocks[1].gasPrices)
+
+	// the blocks of the window are loaded again from the database
+	reloaded, err := NewGasPriceHistory(db, fakeTxDecoder, 3)
+	require.NoError(t, err)
+	require.Equal(t, blocks, reloaded.snapshot())
+
+	// a smaller window only loads the latest blocks
+	reloaded, err = NewGasPriceHistory(db, fakeTxDecoder, 1)
+	require.NoError(t, err)
+	require.Equal(t, blocks[1:], reloaded.snapshot())
+}
+
+func TestEstimateInclusionGasPrice(t *testing.T) {
+	full := func(gasPrices ...float64) blockGasPrices {
+		return blockGasPrices{gasPrices: gasPrices, txsBytes: 100}
+	}
+	notFull := blockGasPrices{gasPrices: []float64{5}, txsBytes: 10}
+	blocks := []blockGasPrices{full(4, 5), full(2, 3), notFull, full(6), full(8)}
+
+	gasPrice, probability := estimateInclusionGasPrice(blocks, 1, 100)
+	require.Equal(t, 8.0, gasPrice)
+	require.Equal(t, 1.0, probability)
+
+	// within two blocks the gas price of the cheaper block of the window is
+	// enough: 2, 0, 0 and 6.
+	gasPrice, probability = estimateInclusionGasPrice(blocks, 2, 100)
+	require.Equal(t, 6.0, gasPrice)
+	require.Equal(t, 1.0, probability)
+
+	// a window larger than the history covers all blocks
+	gasPrice, probability = estimateInclusionGasPrice(blocks, 10, 100)
+	require.Equal(t, 0.0, gasPrice)
+	require.Equal(t, 1.0, probability)
+}
+
+func TestEstimateGasPriceFromHistory(t *testing.T) {
+	networkMinGasPrice := 0.01
+	history, err := NewGasPriceHistory(dbm.NewMemDB(), fakeTxDecoder, 4)
+	require.NoError(t, err)
+	server := &gasEstimatorServer{
+		minGasPriceFn: func() (float64, error) {
+			return networkMinGasPrice, nil
+		},
+		govMaxSquareBytesFn: func() (uint64, error) {
+			return 10, nil
+		},
+		gasPriceHistory: history,
+	}
+
+	// without history the min gas price is returned with no confidence
+	resp, err := server.EstimateGasPriceFromHistory(context.Background(), &EstimateGasPriceFromHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.PercentileGasPrices, len(defaultPercentiles))
+	for _, percentileGasPrice := range resp.PercentileGasPrices {
+		require.Equal(t, networkMinGasPrice, percentileGasPrice.GasPrice)
+	}
+	require.Equal(t, networkMinGasPrice, resp.InclusionGasPrice)
+	require.Zero(t, resp.Confidence)
+
+	require.NoError(t, history.Record(1, [][]byte{fakeTx(1000, 20), fakeTx(1000, 100)}))
+	require.NoError(t, history.Record(2, [][]byte{fakeTx(1000, 40)}))
+	resp, err = server.EstimateGasPriceFromHistory(context.Background(), &EstimateGasPriceFromHistoryRequest{
+		Percentiles:     []float64{0, 100},
+		InclusionBlocks: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0.02, resp.PercentileGasPrices[0].GasPrice)
+	require.Equal(t, 0.1, resp.PercentileGasPrices[1].GasPrice)
+	require.Equal(t, 0.04, resp.InclusionGasPrice)
+	require.Equal(t, 0.5, resp.Confidence)
+	require.EqualValues(t, 1, resp.FromHeight)
+	require.EqualValues(t, 2, resp.ToHeight)
+	require.EqualValues(t, 3, resp.NumTxs)
+
+	_, err = server.EstimateGasPriceFromHistory(context.Background(), &EstimateGasPriceFromHistoryRequest{Percentiles: []float64{101}})
+	require.Error(t, err)
+	_, err = server.EstimateGasPriceFromHistory(context.Background(), &EstimateGasPriceFromHistoryRequest{Percentiles: []float64{math.NaN()}})
+	require.Error(t, err)
+}
+
+func TestSortAndExtractGasPricesSkipsTxsWithoutGasLimit(t *testing.T) {
+	txs := []types.Tx{fakeTx(1000, 2000), fakeTx(0, 1000), fakeTx(1000, 4000)}
+	gasPrices, err := SortAndExtractGasPrices(fakeTxDecoder, txs, 1000)
+	require.NoError(t, err)
+	require.Equal(t, []float64{2, 4}, gasPrices)
+
+	_, err = SortAndExtractGasPrices(fakeTxDecoder, []types.Tx{[]byte("invalid")}, 1000)
+	require.Error(t, err)
+}
+
+// fakeTx encodes a gas limit and a fee in utia.
+func fakeTx(gas, fee uint64) []byte {
+	return binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, gas), fee)
+}
+
+func fakeTxDecoder(txBytes []byte) (sdk.Tx, error) {
+	if len(txBytes) != 16 {
+		return nil, errors.New("invalid fake tx")
+	}
+	return fakeFeeTx{
+		gas: binary.BigEndian.Uint64(txBytes[:8]),
+		fee: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, int64(binary.BigEndian.Uint64(txBytes[8:])))),
+	}, nil
+}
+
+type fakeFeeTx struct {
+	sdk.FeeTx
+	gas uint64
+	fee sdk.Coins
+}
+
+func (tx fakeFeeTx) GetGas() uint64 { return tx.gas }
+
+func (tx fakeFeeTx) GetFee() sdk.Coins { return tx.fee }
@@ -0,0 +1,268 @@
+package squareplacement
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	square "github.com/celestiaorg/go-square/v2"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	cmtclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errDisabled = status.Error(codes.Unavailable, "the square placement service is disabled on this node")
+
+// maxSquaresPerHeight is the max number of squares the service fills per
+// height. Filling a square runs the ante handler over the whole mempool, so
+// the number is kept low to bound the work an unauthenticated caller can cause.
+// Repeated queries for the same blob tx at the same height are answered from
+// the cache and don't count towards it.
+const maxSquaresPerHeight = 16
+
+// fillSquareFn is the signature of a function that filters and orders the txs
+// the same way PrepareProposal does and returns the kept txs together with the
+// builder of the resulting square.
+type fillSquareFn func(txs [][]byte) ([][]byte, *square.Builder, error)
+
+// RegisterSquarePlacementService registers the square placement service on the gRPC router.
+// A nil fillSquareFn registers a service that reports it as disabled.
+func RegisterSquarePlacementService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, fillSquareFn fillSquareFn, lastHeightFn func() int64) {
+	RegisterSquarePlacementServer(
+		qrt,
+		NewSquarePlacementServer(clientCtx.Client, txDecoder, fillSquareFn, lastHeightFn),
+	)
+}
+
+var _ SquarePlacementServer = &squarePlacementServer{}
+
+type squarePlacementServer struct {
+	mempoolClient cmtclient.MempoolClient
+	txDecoder     sdk.TxDecoder
+	fillSquareFn  fillSquareFn
+	lastHeightFn  func() int64
+
+	// fillMu serializes filling squares so that concurrent queries can't
+	// multiply the work.
+	fillMu sync.Mutex
+
+	// mu protects the fields below, which are reset at every height.
+	mu         sync.Mutex
+	height     int64
+	squares    int
+	placements map[[sha256.Size]byte]*EstimateBlobPlacementResponse
+}
+
+func NewSquarePlacementServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, fillSquareFn fillSquareFn, lastHeightFn func() int64) SquarePlacementServer {
+	return &squarePlacementServer{
+		mempoolClient: mempoolClient,
+		txDecoder:     txDecoder,
+		fillSquareFn:  fillSquareFn,
+		lastHeightFn:  lastHeightFn,
+		placements:    make(map[[sha256.Size]byte]*EstimateBlobPlacementResponse),
+	}
+}
+
+// EstimateBlobPlacement returns where the blobs of the candidate blob tx would
+// be placed in the next square. The placement is computed once per height and
+// blob tx, and at most maxSquaresPerHeight placements are computed per height.
+func (s *squarePlacementServer) EstimateBlobPlacement(ctx context.Context, request *EstimateBlobPlacementRequest) (*EstimateBlobPlacementResponse, error) {
+	if s.fillSquareFn == nil {
+		return nil, errDisabled
+	}
+	candidate, isBlob, err := blobtx.UnmarshalBlobTx(request.BlobTx)
+	if !isBlob {
+		return nil, status.Error(codes.InvalidArgument, "tx is not a blob tx")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blob tx: %v", err)
+	}
+	if len(request.BlobTx) > appconsts.MaxTxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "blob tx size %d exceeds the max tx size %d", len(request.BlobTx), appconsts.MaxTxSize)
+	}
+	candidateGasPrice, err := s.gasPrice(candidate.Tx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "decoding blob tx: %v", err)
+	}
+
+	key := sha256.Sum256(request.BlobTx)
+	height := s.lastHeightFn()
+	if resp, ok := s.cachedPlacement(height, key); ok {
+		return resp, nil
+	}
+
+	s.fillMu.Lock()
+	defer s.fillMu.Unlock()
+	// another query may have computed the placement while this one waited
+	if resp, ok := s.cachedPlacement(height, key); ok {
+		return resp, nil
+	}
+	if !s.reserveSquare(height) {
+		return nil, status.Errorf(codes.ResourceExhausted, "the max number of placements %d for height %d was reached", maxSquaresPerHeight, height)
+	}
+
+	resp, err := s.estimateBlobPlacement(ctx, request.BlobTx, candidate, candidateGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	s.cachePlacement(height, key, resp)
+	return resp, nil
+}
+
+// cachedPlacement returns the placement computed for the blob tx at the
+// height.
+func (s *squarePlacementServer) cachedPlacement(height int64, key [sha256.Size]byte) (*EstimateBlobPlacementResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resetAt(height)
+	resp, ok := s.placements[key]
+	return resp, ok
+}
+
+// reserveSquare reports whether another square may be filled at the height
+// and counts it if so.
+func (s *squarePlacementServer) reserveSquare(height int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resetAt(height)
+	if s.squares >= maxSquaresPerHeight {
+		return false
+	}
+	s.squares++
+	return true
+}
+
+func (s *squarePlacementServer) cachePlacement(height int64, key [sha256.Size]byte, resp *EstimateBlobPlacementResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resetAt(height)
+	s.placements[key] = resp
+}
+
+// resetAt drops the placements and the count of squares of previous heights.
+// It must be called with mu held.
+func (s *squarePlacementServer) resetAt(height int64) {
+	if height == s.height {
+		return
+	}
+	s.height = height
+	s.squares = 0
+	s.placements = make(map[[sha256.Size]byte]*EstimateBlobPlacementResponse)
+}
+
+// estimateBlobPlacement inserts the candidate blob tx into the mempool txs
+// according to its gas price, fills a square with them and looks up where the
+// blobs of the candidate ended up.
+//
+// The mempool returns its txs ordered by priority, which the ante handler sets
+// to the gas price, so the candidate is placed after the last tx that pays at
+// least its gas price. Should a tx with a lower gas price come before such a
+// tx, the candidate is placed after both, so the estimate errs towards a later
+// placement.
+func (s *squarePlacementServer) estimateBlobPlacement(ctx context.Context, rawCandidate []byte, candidate *blobtx.BlobTx, candidateGasPrice float64) (*EstimateBlobPlacementResponse, error) {
+	// Use -1 to query all the unconfirmed transactions.
+	limit := -1
+	txsResp, err := s.mempoolClient.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([][]byte, 0, len(txsResp.Txs)+1)
+	insertAt := 0
+	for _, rawTx := range txsResp.Txs {
+		// the candidate may already be in the mempool
+		if bytes.Equal(rawTx, rawCandidate) {
+			continue
+		}
+		txs = append(txs, rawTx)
+		if gasPrice, err := s.gasPrice(rawTx); err == nil && gasPrice >= candidateGasPrice {
+			insertAt = len(txs)
+		}
+	}
+	txs = slices.Insert(txs, insertAt, rawCandidate)
+
+	kept, builder, err := s.fillSquareFn(txs)
+	if err != nil {
+		return nil, fmt.Errorf("filling square: %w", err)
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, fmt.Errorf("building square: %w", err)
+	}
+
+	resp := &EstimateBlobPlacementResponse{
+		SquareSize:  uint64(dataSquare.Size()),
+		TotalShares: uint32(len(dataSquare)),
+		Excluded:    true,
+	}
+	for _, sh := range dataSquare {
+		if sh.IsPadding() {
+			resp.PaddingShares++
+		}
+	}
+
+	pfbIndex := indexOfBlobTx(kept, candidate)
+	if pfbIndex < 0 {
+		return resp, nil
+	}
+	resp.Excluded = false
+	resp.BlobShareRanges = make([]*ShareRange, len(candidate.Blobs))
+	for blobIndex := range candidate.Blobs {
+		start, err := builder.FindBlobStartingIndex(pfbIndex, blobIndex)
+		if err != nil {
+			return nil, err
+		}
+		length, err := builder.BlobShareLength(pfbIndex, blobIndex)
+		if err != nil {
+			return nil, err
+		}
+		resp.BlobShareRanges[blobIndex] = &ShareRange{
+			Start: uint32(start),
+			End:   uint32(start + length),
+		}
+	}
+	return resp, nil
+}
+
+// gasPrice returns the gas price of the tx in utia.
+func (s *squarePlacementServer) gasPrice(rawTx []byte) (float64, error) {
+	txBytes := rawTx
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	if isBlob {
+		if err != nil {
+			return 0, err
+		}
+		txBytes = bTx.Tx
+	}
+	sdkTx, err := s.txDecoder(txBytes)
+	if err != nil {
+		return 0, err
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return 0, fmt.Errorf("tx has no gas limit")
+	}
+	return float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas()), nil
+}
+
+// indexOfBlobTx returns the index of the blob tx in the txs or -1 if it isn't
+// part of the txs.
+func indexOfBlobTx(txs [][]byte, target *blobtx.BlobTx) int {
+	for i, rawTx := range txs {
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlob || err != nil {
+			continue
+		}
+		if bytes.Equal(bTx.Tx, target.Tx) {
+			return i
+		}
+	}
+	return -1
+}
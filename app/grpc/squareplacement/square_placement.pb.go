@@ -0,0 +1,968 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/square_placement/square_placement.proto
+
+package squareplacement
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EstimateBlobPlacementRequest the request to estimate the placement of a blob
+// transaction in the next square.
+type EstimateBlobPlacementRequest struct {
+	BlobTx []byte `protobuf:"bytes,1,opt,name=blob_tx,json=blobTx,proto3" json:"blob_tx,omitempty"`
+}
+
+func (m *EstimateBlobPlacementRequest) Reset()         { *m = EstimateBlobPlacementRequest{} }
+func (m *EstimateBlobPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBlobPlacementRequest) ProtoMessage()    {}
+func (*EstimateBlobPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_459ed3a4e82fcc95, []int{0}
+}
+func (m *EstimateBlobPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBlobPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBlobPlacementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateBlobPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBlobPlacementRequest.Merge(m, src)
+}
+func (m *EstimateBlobPlacementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBlobPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBlobPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBlobPlacementRequest proto.InternalMessageInfo
+
+func (m *EstimateBlobPlacementRequest) GetBlobTx() []byte {
+	if m != nil {
+		return m.BlobTx
+	}
+	return nil
+}
+
+// ShareRange a range of shares in the square. The start is inclusive and the
+// end is exclusive.
+type ShareRange struct {
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *ShareRange) Reset()         { *m = ShareRange{} }
+func (m *ShareRange) String() string { return proto.CompactTextString(m) }
+func (*ShareRange) ProtoMessage()    {}
+func (*ShareRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_459ed3a4e82fcc95, []int{1}
+}
+func (m *ShareRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareRange.Merge(m, src)
+}
+func (m *ShareRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareRange proto.InternalMessageInfo
+
+func (m *ShareRange) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ShareRange) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+// EstimateBlobPlacementResponse the response of the blob placement estimation.
+type EstimateBlobPlacementResponse struct {
+	// square_size the width of the predicted square.
+	SquareSize uint64 `protobuf:"varint,1,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// excluded is true if the blob transaction would not be included in the
+	// square, either because it is invalid against the current state or because
+	// higher priority transactions fill the square.
+	Excluded bool `protobuf:"varint,2,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	// blob_share_ranges the share range of each blob of the transaction, in the
+	// order of the blobs. Empty if the transaction is excluded.
+	BlobShareRanges []*ShareRange `protobuf:"bytes,3,rep,name=blob_share_ranges,json=blobShareRanges,proto3" json:"blob_share_ranges,omitempty"`
+	// padding_shares the number of padding shares in the predicted square.
+	PaddingShares uint32 `protobuf:"varint,4,opt,name=padding_shares,json=paddingShares,proto3" json:"padding_shares,omitempty"`
+	// total_shares the number of shares in the predicted square.
+	TotalShares uint32 `protobuf:"varint,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+}
+
+func (m *EstimateBlobPlacementResponse) Reset()         { *m = EstimateBlobPlacementResponse{} }
+func (m *EstimateBlobPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBlobPlacementResponse) ProtoMessage()    {}
+func (*EstimateBlobPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_459ed3a4e82fcc95, []int{2}
+}
+func (m *EstimateBlobPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBlobPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBlobPlacementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateBlobPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBlobPlacementResponse.Merge(m, src)
+}
+func (m *EstimateBlobPlacementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBlobPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBlobPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBlobPlacementResponse proto.InternalMessageInfo
+
+func (m *EstimateBlobPlacementResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *EstimateBlobPlacementResponse) GetExcluded() bool {
+	if m != nil {
+		return m.Excluded
+	}
+	return false
+}
+
+func (m *EstimateBlobPlacementResponse) GetBlobShareRanges() []*ShareRange {
+	if m != nil {
+		return m.BlobShareRanges
+	}
+	return nil
+}
+
+func (m *EstimateBlobPlacementResponse) GetPaddingShares() uint32 {
+	if m != nil {
+		return m.PaddingShares
+	}
+	return 0
+}
+
+func (m *EstimateBlobPlacementResponse) GetTotalShares() uint32 {
+	if m != nil {
+		return m.TotalShares
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EstimateBlobPlacementRequest)(nil), "celestia.core.v1.square_placement.EstimateBlobPlacementRequest")
+	proto.RegisterType((*ShareRange)(nil), "celestia.core.v1.square_placement.ShareRange")
+	proto.RegisterType((*EstimateBlobPlacementResponse)(nil), "celestia.core.v1.square_placement.EstimateBlobPlacementResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/square_placement/square_placement.proto", fileDescriptor_459ed3a4e82fcc95)
+}
+
+var fileDescriptor_459ed3a4e82fcc95 = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcd, 0xaa, 0xd3, 0x40,
+	0x18, 0x4d, 0xfa, 0x67, 0xf9, 0xda, 0x5a, 0x1d, 0x14, 0x43, 0xd1, 0xd8, 0x06, 0x84, 0x6e, 0x9a,
+	0xd0, 0x2a, 0xe8, 0x42, 0x50, 0x0a, 0xee, 0x25, 0x15, 0x41, 0x37, 0x65, 0x92, 0x7c, 0xa4, 0x81,
+	0x34, 0x93, 0xce, 0x4c, 0x4a, 0xe9, 0xce, 0x37, 0xf0, 0x01, 0x7c, 0x08, 0x1f, 0xc3, 0x65, 0x97,
+	0x2e, 0xa5, 0x7d, 0x11, 0xc9, 0xa4, 0x49, 0xc1, 0x9f, 0x7b, 0x2f, 0x77, 0x11, 0x98, 0x73, 0x72,
+	0xe6, 0x9c, 0xf3, 0xcd, 0x0c, 0xbc, 0xf2, 0x31, 0x46, 0x21, 0x23, 0xea, 0xf8, 0x8c, 0xa3, 0xb3,
+	0x9d, 0x3a, 0x62, 0x93, 0x51, 0x8e, 0xcb, 0x34, 0xa6, 0x3e, 0xae, 0x31, 0x91, 0x7f, 0x11, 0x76,
+	0xca, 0x99, 0x64, 0x64, 0x54, 0xee, 0xb4, 0xf3, 0x9d, 0xf6, 0x76, 0x6a, 0xff, 0x29, 0xb4, 0x5e,
+	0xc2, 0xe3, 0x77, 0x42, 0x46, 0x6b, 0x2a, 0x71, 0x1e, 0x33, 0xef, 0x7d, 0xf9, 0xc3, 0xc5, 0x4d,
+	0x86, 0x42, 0x92, 0x47, 0x70, 0xc7, 0x8b, 0x99, 0xb7, 0x94, 0x3b, 0x43, 0x1f, 0xea, 0xe3, 0xae,
+	0xdb, 0xca, 0xe1, 0x87, 0x9d, 0xf5, 0x02, 0x60, 0xb1, 0xa2, 0x1c, 0x5d, 0x9a, 0x84, 0x48, 0x1e,
+	0x40, 0x53, 0x48, 0xca, 0xa5, 0x12, 0xf5, 0xdc, 0x02, 0x90, 0x7b, 0x50, 0xc7, 0x24, 0x30, 0x6a,
+	0x8a, 0xcb, 0x97, 0xd6, 0x97, 0x1a, 0x3c, 0xf9, 0x4f, 0x9e, 0x48, 0x59, 0x22, 0x90, 0x3c, 0x85,
+	0xce, 0xb9, 0xa4, 0x88, 0xf6, 0xa8, 0xfc, 0x1a, 0x2e, 0x14, 0xd4, 0x22, 0xda, 0x23, 0x19, 0x40,
+	0x1b, 0x77, 0x7e, 0x9c, 0x05, 0x58, 0x38, 0xb7, 0xdd, 0x0a, 0x93, 0x4f, 0x70, 0x5f, 0xb5, 0x15,
+	0x79, 0xb3, 0x25, 0xcf, 0xab, 0x09, 0xa3, 0x3e, 0xac, 0x8f, 0x3b, 0xb3, 0x89, 0x7d, 0xed, 0x61,
+	0xd8, 0x97, 0x81, 0xdc, 0x7e, 0xee, 0x73, 0xc1, 0x82, 0x3c, 0x83, 0xbb, 0x29, 0x0d, 0x82, 0x28,
+	0x09, 0x0b, 0x77, 0x61, 0x34, 0xd4, 0x58, 0xbd, 0x33, 0xab, 0xb4, 0x82, 0x8c, 0xa0, 0x2b, 0x99,
+	0xa4, 0x71, 0x29, 0x6a, 0x2a, 0x51, 0x47, 0x71, 0x85, 0x64, 0xf6, 0x5d, 0x87, 0xfe, 0x42, 0x45,
+	0x57, 0xd3, 0x93, 0x6f, 0x3a, 0x3c, 0xfc, 0xe7, 0xb9, 0x90, 0x37, 0x37, 0xe8, 0x7d, 0xd5, 0x0d,
+	0x0e, 0xde, 0xde, 0xde, 0xa0, 0xb8, 0x12, 0x4b, 0x9b, 0x7f, 0xfc, 0x71, 0x34, 0xf5, 0xc3, 0xd1,
+	0xd4, 0x7f, 0x1d, 0x4d, 0xfd, 0xeb, 0xc9, 0xd4, 0x0e, 0x27, 0x53, 0xfb, 0x79, 0x32, 0xb5, 0xcf,
+	0xaf, 0xc3, 0x48, 0xae, 0x32, 0xcf, 0xf6, 0xd9, 0xda, 0x29, 0x73, 0x18, 0x0f, 0xab, 0xf5, 0x84,
+	0xa6, 0xa9, 0x93, 0x7f, 0x21, 0x4f, 0xfd, 0xf3, 0x3b, 0xad, 0x72, 0xbd, 0x96, 0x7a, 0xa7, 0xcf,
+	0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x9a, 0xc7, 0xf5, 0xe3, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SquarePlacementClient is the client API for SquarePlacement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SquarePlacementClient interface {
+	// EstimateBlobPlacement takes a signed blob transaction and runs it together
+	// with the transactions currently in the mempool through the same square
+	// construction that is used to prepare a proposal, on a branch of the latest
+	// committed state. It returns the predicted square size, the shares the
+	// blobs would occupy and whether the transaction would be excluded. The
+	// prediction assumes the mempool doesn't change before the next proposal.
+	// The prediction for a transaction is computed once per height and reused
+	// for repeated queries. The number of predictions computed per height is
+	// limited, further queries fail with RESOURCE_EXHAUSTED until the next
+	// height.
+	EstimateBlobPlacement(ctx context.Context, in *EstimateBlobPlacementRequest, opts ...grpc.CallOption) (*EstimateBlobPlacementResponse, error)
+}
+
+type squarePlacementClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewSquarePlacementClient(cc grpc1.ClientConn) SquarePlacementClient {
+	return &squarePlacementClient{cc}
+}
+
+func (c *squarePlacementClient) EstimateBlobPlacement(ctx context.Context, in *EstimateBlobPlacementRequest, opts ...grpc.CallOption) (*EstimateBlobPlacementResponse, error) {
+	out := new(EstimateBlobPlacementResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.square_placement.SquarePlacement/EstimateBlobPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SquarePlacementServer is the server API for SquarePlacement service.
+type SquarePlacementServer interface {
+	// EstimateBlobPlacement takes a signed blob transaction and runs it together
+	// with the transactions currently in the mempool through the same square
+	// construction that is used to prepare a proposal, on a branch of the latest
+	// committed state. It returns the predicted square size, the shares the
+	// blobs would occupy and whether the transaction would be excluded. The
+	// prediction assumes the mempool doesn't change before the next proposal.
+	// The prediction for a transaction is computed once per height and reused
+	// for repeated queries. The number of predictions computed per height is
+	// limited, further queries fail with RESOURCE_EXHAUSTED until the next
+	// height.
+	EstimateBlobPlacement(context.Context, *EstimateBlobPlacementRequest) (*EstimateBlobPlacementResponse, error)
+}
+
+// UnimplementedSquarePlacementServer can be embedded to have forward compatible implementations.
+type UnimplementedSquarePlacementServer struct {
+}
+
+func (*UnimplementedSquarePlacementServer) EstimateBlobPlacement(ctx context.Context, req *EstimateBlobPlacementRequest) (*EstimateBlobPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBlobPlacement not implemented")
+}
+
+func RegisterSquarePlacementServer(s grpc1.Server, srv SquarePlacementServer) {
+	s.RegisterService(&_SquarePlacement_serviceDesc, srv)
+}
+
+func _SquarePlacement_EstimateBlobPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBlobPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SquarePlacementServer).EstimateBlobPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.square_placement.SquarePlacement/EstimateBlobPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SquarePlacementServer).EstimateBlobPlacement(ctx, req.(*EstimateBlobPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var SquarePlacement_serviceDesc = _SquarePlacement_serviceDesc
+var _SquarePlacement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.square_placement.SquarePlacement",
+	HandlerType: (*SquarePlacementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EstimateBlobPlacement",
+			Handler:    _SquarePlacement_EstimateBlobPlacement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/square_placement/square_placement.proto",
+}
+
+func (m *EstimateBlobPlacementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBlobPlacementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBlobPlacementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlobTx) > 0 {
+		i -= len(m.BlobTx)
+		copy(dAtA[i:], m.BlobTx)
+		i = encodeVarintSquarePlacement(dAtA, i, uint64(len(m.BlobTx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintSquarePlacement(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintSquarePlacement(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBlobPlacementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBlobPlacementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBlobPlacementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalShares != 0 {
+		i = encodeVarintSquarePlacement(dAtA, i, uint64(m.TotalShares))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PaddingShares != 0 {
+		i = encodeVarintSquarePlacement(dAtA, i, uint64(m.PaddingShares))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlobShareRanges) > 0 {
+		for iNdEx := len(m.BlobShareRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlobShareRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSquarePlacement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Excluded {
+		i--
+		if m.Excluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintSquarePlacement(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSquarePlacement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSquarePlacement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EstimateBlobPlacementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlobTx)
+	if l > 0 {
+		n += 1 + l + sovSquarePlacement(uint64(l))
+	}
+	return n
+}
+
+func (m *ShareRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovSquarePlacement(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovSquarePlacement(uint64(m.End))
+	}
+	return n
+}
+
+func (m *EstimateBlobPlacementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SquareSize != 0 {
+		n += 1 + sovSquarePlacement(uint64(m.SquareSize))
+	}
+	if m.Excluded {
+		n += 2
+	}
+	if len(m.BlobShareRanges) > 0 {
+		for _, e := range m.BlobShareRanges {
+			l = e.Size()
+			n += 1 + l + sovSquarePlacement(uint64(l))
+		}
+	}
+	if m.PaddingShares != 0 {
+		n += 1 + sovSquarePlacement(uint64(m.PaddingShares))
+	}
+	if m.TotalShares != 0 {
+		n += 1 + sovSquarePlacement(uint64(m.TotalShares))
+	}
+	return n
+}
+
+func sovSquarePlacement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSquarePlacement(x uint64) (n int) {
+	return sovSquarePlacement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EstimateBlobPlacementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSquarePlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateBlobPlacementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateBlobPlacementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSquarePlacement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePlacement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobTx = append(m.BlobTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlobTx == nil {
+				m.BlobTx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSquarePlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSquarePlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSquarePlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSquarePlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSquarePlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateBlobPlacementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSquarePlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateBlobPlacementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateBlobPlacementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Excluded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Excluded = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobShareRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSquarePlacement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePlacement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobShareRanges = append(m.BlobShareRanges, &ShareRange{})
+			if err := m.BlobShareRanges[len(m.BlobShareRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaddingShares", wireType)
+			}
+			m.PaddingShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaddingShares |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			m.TotalShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalShares |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSquarePlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSquarePlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSquarePlacement(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSquarePlacement
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSquarePlacement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSquarePlacement
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSquarePlacement
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSquarePlacement
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSquarePlacement        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSquarePlacement          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSquarePlacement = fmt.Errorf("proto: unexpected end of group")
+)
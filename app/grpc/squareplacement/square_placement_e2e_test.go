@@ -0,0 +1,107 @@
+package squareplacement_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/squareplacement"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvtypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSquarePlacementE2E(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestSquarePlacementE2E in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	appOpts := testnode.DefaultAppOptions()
+	appOpts.Set(app.FlagSquarePlacementEnabled, true)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	cfg := testnode.DefaultConfig().WithTimeoutCommit(100 * time.Millisecond).WithAppOptions(appOpts).WithAppCreator(appCreator)
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := user.SetupTxClient(ctx, cctx.Keyring, cctx.GRPCClient, enc)
+	require.NoError(t, err)
+	placementClient := squareplacement.NewSquarePlacementClient(cctx.GRPCClient)
+
+	blobSizes := []int{1000, 3000}
+	blobs := make([]*share.Blob, len(blobSizes))
+	sizes := make([]uint32, len(blobSizes))
+	for i, size := range blobSizes {
+		blobs[i], err = share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(size))
+		require.NoError(t, err)
+		sizes[i] = uint32(size)
+	}
+	gasLimit := blobtypes.DefaultEstimateGas(sizes)
+
+	t.Run("predicts the placement of a valid blob tx", func(t *testing.T) {
+		blobTx, _, err := txClient.Signer().CreatePayForBlobs(txClient.DefaultAccountName(), blobs, user.SetGasLimitAndGasPrice(gasLimit, appconsts.DefaultMinGasPrice))
+		require.NoError(t, err)
+
+		resp, err := placementClient.EstimateBlobPlacement(ctx, &squareplacement.EstimateBlobPlacementRequest{BlobTx: blobTx})
+		require.NoError(t, err)
+		require.False(t, resp.Excluded)
+		require.Len(t, resp.BlobShareRanges, len(blobs))
+		for i, shareRange := range resp.BlobShareRanges {
+			require.Equal(t, share.SparseSharesNeeded(sizes[i]), int(shareRange.End-shareRange.Start))
+			require.LessOrEqual(t, shareRange.End, resp.TotalShares)
+		}
+		// blobs are ordered by namespace so only check that they don't overlap
+		first, second := resp.BlobShareRanges[0], resp.BlobShareRanges[1]
+		require.True(t, first.End <= second.Start || second.End <= first.Start)
+		require.Equal(t, resp.SquareSize*resp.SquareSize, uint64(resp.TotalShares))
+		require.Less(t, resp.PaddingShares, resp.TotalShares)
+	})
+
+	t.Run("predicts the exclusion of an invalid blob tx", func(t *testing.T) {
+		// a gas limit too low for the blobs fails the ante handler
+		blobTx, _, err := txClient.Signer().CreatePayForBlobs(txClient.DefaultAccountName(), blobs, user.SetGasLimitAndGasPrice(1, appconsts.DefaultMinGasPrice))
+		require.NoError(t, err)
+
+		resp, err := placementClient.EstimateBlobPlacement(ctx, &squareplacement.EstimateBlobPlacementRequest{BlobTx: blobTx})
+		require.NoError(t, err)
+		require.True(t, resp.Excluded)
+		require.Empty(t, resp.BlobShareRanges)
+	})
+
+	t.Run("rejects a tx without blobs", func(t *testing.T) {
+		_, err := placementClient.EstimateBlobPlacement(ctx, &squareplacement.EstimateBlobPlacementRequest{BlobTx: []byte("not a blob tx")})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// appCreator is the testnode app creator, except that it passes the app
+// options through so that the service is enabled.
+func appCreator(_ log.Logger, _ dbm.DB, _ io.Writer, appOpts srvtypes.AppOptions) srvtypes.Application {
+	baseAppOptions := server.DefaultBaseappOptions(appOpts)
+	baseAppOptions = append(baseAppOptions, baseapp.SetMinGasPrices(fmt.Sprintf("%v%v", appconsts.DefaultMinGasPrice, appconsts.BondDenom)))
+	return app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil, // trace store
+		appOpts.Get(testnode.TimeoutCommitFlag).(time.Duration),
+		appOpts,
+		baseAppOptions...,
+	)
+}
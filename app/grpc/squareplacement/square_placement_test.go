@@ -0,0 +1,114 @@
+package squareplacement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	cmtclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEstimateBlobPlacement(t *testing.T) {
+	enc := encoding.MakeConfig()
+	blobtypes.RegisterInterfaces(enc.InterfaceRegistry)
+	kr := testfactory.TestKeyring(enc.Codec)
+	signer, err := user.NewSigner(kr, enc.TxConfig, testfactory.ChainID, user.NewAccount(testfactory.TestAccName, 1, 0))
+	require.NoError(t, err)
+	newBlobTx := func(fee uint64) []byte {
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(100))
+		require.NoError(t, err)
+		rawTx, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, []*share.Blob{blob}, user.SetGasLimit(100_000), user.SetFee(fee))
+		require.NoError(t, err)
+		require.NoError(t, signer.IncrementSequence(testfactory.TestAccName))
+		return rawTx
+	}
+
+	high, low := newBlobTx(10_000), newBlobTx(1_000)
+	var (
+		height int64 = 1
+		filled [][][]byte
+	)
+	fillSquare := func(txs [][]byte) ([][]byte, *square.Builder, error) {
+		filled = append(filled, txs)
+		builder, err := square.NewBuilder(appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, rawTx := range txs {
+			bTx, _, err := blobtx.UnmarshalBlobTx(rawTx)
+			if err != nil {
+				return nil, nil, err
+			}
+			builder.AppendBlobTx(bTx)
+		}
+		return txs, builder, nil
+	}
+	server := NewSquarePlacementServer(mempoolClient{txs: []types.Tx{high, low}}, enc.TxConfig.TxDecoder(), fillSquare, func() int64 { return height })
+
+	t.Run("places the candidate by its gas price", func(t *testing.T) {
+		candidate := newBlobTx(5_000)
+		resp, err := server.EstimateBlobPlacement(context.Background(), &EstimateBlobPlacementRequest{BlobTx: candidate})
+		require.NoError(t, err)
+		require.False(t, resp.Excluded)
+		require.Equal(t, [][]byte{high, candidate, low}, filled[len(filled)-1])
+	})
+
+	t.Run("caches the placement per height", func(t *testing.T) {
+		candidate := newBlobTx(5_000)
+		first, err := server.EstimateBlobPlacement(context.Background(), &EstimateBlobPlacementRequest{BlobTx: candidate})
+		require.NoError(t, err)
+		fills := len(filled)
+		second, err := server.EstimateBlobPlacement(context.Background(), &EstimateBlobPlacementRequest{BlobTx: candidate})
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+		require.Len(t, filled, fills)
+
+		height++
+		_, err = server.EstimateBlobPlacement(context.Background(), &EstimateBlobPlacementRequest{BlobTx: candidate})
+		require.NoError(t, err)
+		require.Len(t, filled, fills+1)
+	})
+
+	t.Run("limits the placements per height", func(t *testing.T) {
+		height++
+		for range maxSquaresPerHeight {
+			_, err := server.EstimateBlobPlacement(context.Background(), &EstimateBlobPlacementRequest{BlobTx: newBlobTx(5_000)})
+			require.NoError(t, err)
+		}
+		_, err := server.EstimateBlobPlacement(context.Background(), &EstimateBlobPlacementRequest{BlobTx: newBlobTx(5_000)})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		height++
+		_, err = server.EstimateBlobPlacement(context.Background(), &EstimateBlobPlacementRequest{BlobTx: newBlobTx(5_000)})
+		require.NoError(t, err)
+	})
+
+	t.Run("reports the service as disabled without a fill square function", func(t *testing.T) {
+		disabled := NewSquarePlacementServer(mempoolClient{}, enc.TxConfig.TxDecoder(), nil, func() int64 { return height })
+		_, err := disabled.EstimateBlobPlacement(context.Background(), &EstimateBlobPlacementRequest{BlobTx: newBlobTx(5_000)})
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+// mempoolClient is a mempool that always returns the same txs.
+type mempoolClient struct {
+	cmtclient.MempoolClient
+	txs []types.Tx
+}
+
+func (c mempoolClient) UnconfirmedTxs(context.Context, *int) (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{Count: len(c.txs), Total: len(c.txs), Txs: c.txs}, nil
+}
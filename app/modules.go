@@ -17,6 +17,8 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/x/minfee"
 	minfeetypes "github.com/celestiaorg/celestia-app/v6/x/minfee/types"
 	minttypes "github.com/celestiaorg/celestia-app/v6/x/mint/types"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
 	"github.com/celestiaorg/celestia-app/v6/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v6/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -86,6 +88,7 @@ var ModuleEncodingRegisters = []module.AppModuleBasic{
 	blob.AppModule{},
 	minfee.AppModule{},
 	mintModule{},
+	nsregistry.AppModule{},
 	signal.AppModule{},
 }
 
@@ -137,6 +140,7 @@ func (app *App) setModuleOrder() {
 		vestingtypes.ModuleName,
 		signaltypes.ModuleName,
 		minfeetypes.ModuleName,
+		nsregistrytypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 	)
@@ -169,6 +173,7 @@ func (app *App) setModuleOrder() {
 		paramstypes.ModuleName,
 		authz.ModuleName,
 		signaltypes.ModuleName,
+		nsregistrytypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 		upgradetypes.ModuleName,
@@ -204,5 +209,6 @@ func allStoreKeys() []string {
 		circuittypes.StoreKey,     // added in v4
 		hyperlanetypes.ModuleName, // added in v4
 		warptypes.ModuleName,      // added in v4
+		nsregistrytypes.StoreKey,  // added in v6
 	}
 }
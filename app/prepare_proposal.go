@@ -6,6 +6,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/app/ante"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/pkg/da"
+	square "github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -19,31 +20,13 @@ import (
 // visibility and so they can be quickly resolved.
 func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
 	defer telemetry.MeasureSince(time.Now(), "prepare_proposal")
-	// Create a context using a branch of the state.
-	handler := ante.NewAnteHandler(
-		app.AccountKeeper,
-		app.BankKeeper,
-		app.BlobKeeper,
-		app.FeeGrantKeeper,
-		app.GetTxConfig().SignModeHandler(),
-		ante.DefaultSigVerificationGasConsumer,
-		app.IBCKeeper,
-		app.MinFeeKeeper,
-		&app.CircuitKeeper,
-		app.GovParamFilters(),
-	)
-
-	fsb, err := NewFilteredSquareBuilder(
-		handler,
-		app.encodingConfig.TxConfig,
-		app.MaxEffectiveSquareSize(ctx),
-		appconsts.SubtreeRootThreshold,
-	)
+	fsb, err := app.newFilteredSquareBuilder(ctx)
 	if err != nil {
 		panic(err)
 	}
 
 	txs := fsb.Fill(ctx, req.Txs)
+	app.proposalTracer.tracePrepare(req.Height, fsb.TxTraces())
 
 	// Build the square from the set of valid and prioritised transactions.
 	dataSquare, err := fsb.Build()
@@ -75,3 +58,53 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		DataRootHash: dah.Hash(), // also known as the data root
 	}, nil
 }
+
+// newFilteredSquareBuilder returns the FilteredSquareBuilder that filters txs
+// using the same ante handler, square size and tx prioritizer as
+// PrepareProposal.
+func (app *App) newFilteredSquareBuilder(ctx sdk.Context) (*FilteredSquareBuilder, error) {
+	handler := ante.NewAnteHandler(
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.BlobKeeper,
+		app.FeeGrantKeeper,
+		app.GetTxConfig().SignModeHandler(),
+		ante.DefaultSigVerificationGasConsumer,
+		app.IBCKeeper,
+		app.MinFeeKeeper,
+		app.NsRegistryKeeper,
+		&app.CircuitKeeper,
+		app.GovParamFilters(),
+	)
+
+	fsb, err := NewFilteredSquareBuilder(
+		handler,
+		app.encodingConfig.TxConfig,
+		app.MaxEffectiveSquareSize(ctx),
+		appconsts.SubtreeRootThreshold,
+	)
+	if err != nil {
+		return nil, err
+	}
+	fsb.SetTxPrioritizer(app.txPrioritizer)
+	return fsb, nil
+}
+
+// fillSquare is used by the square placement service to fill a square with
+// the txs the same way PrepareProposal would at the next height. The state
+// changes of the ante handler are applied to a branch of the latest committed
+// state and discarded.
+func (app *App) fillSquare(txs [][]byte) ([][]byte, *square.Builder, error) {
+	ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = ctx.WithBlockHeight(app.LastBlockHeight() + 1).WithIsCheckTx(false)
+
+	fsb, err := app.newFilteredSquareBuilder(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	kept := fsb.Fill(ctx, txs)
+	return kept, fsb.Builder(), nil
+}
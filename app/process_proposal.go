@@ -30,9 +30,8 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 	// vote nil rather than crashing the node.
 	defer func() {
 		if err := recover(); err != nil {
-			logInvalidPropBlock(app.Logger(), ctx.BlockHeader(), fmt.Sprintf("caught panic: %v", err))
 			telemetry.IncrCounter(1, "process_proposal", "panics")
-			resp = reject()
+			resp = app.rejectProposal(ctx.BlockHeader(), RejectReasonPanic, fmt.Sprintf("caught panic: %v", err), nil)
 		}
 	}()
 
@@ -49,6 +48,7 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
 		app.MinFeeKeeper,
+		app.NsRegistryKeeper,
 		&app.CircuitKeeper,
 		app.GovParamFilters(),
 	)
@@ -62,15 +62,13 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 		// all txs must be less than or equal to the max tx size limit
 		currentTxSize := len(tx)
 		if currentTxSize > appconsts.MaxTxSize {
-			logInvalidPropBlockError(app.Logger(), blockHeader, fmt.Sprintf("err with tx %d", idx), errors.Wrapf(apperr.ErrTxExceedsMaxSize, "tx size %d bytes is larger than the application's configured MaxTxSize of %d bytes", currentTxSize, appconsts.MaxTxSize))
-			return reject(), nil
+			return app.rejectProposal(blockHeader, RejectReasonTxTooLarge, fmt.Sprintf("err with tx %d", idx), errors.Wrapf(apperr.ErrTxExceedsMaxSize, "tx size %d bytes is larger than the application's configured MaxTxSize of %d bytes", currentTxSize, appconsts.MaxTxSize)), nil
 		}
 
 		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
 		if isBlobTx {
 			if err != nil {
-				logInvalidPropBlockError(app.Logger(), blockHeader, fmt.Sprintf("err with blob tx %d", idx), err)
-				return reject(), nil
+				return app.rejectProposal(blockHeader, RejectReasonInvalidBlobTx, fmt.Sprintf("err with blob tx %d", idx), err), nil
 			}
 			tx = blobTx.Tx
 		}
@@ -81,8 +79,7 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 
 		if err != nil {
 			// An error here means that a tx was included in the block that is not decodable.
-			logInvalidPropBlock(app.Logger(), blockHeader, fmt.Sprintf("tx %d is not decodable", idx))
-			return reject(), nil
+			return app.rejectProposal(blockHeader, RejectReasonUndecodableTx, fmt.Sprintf("tx %d is not decodable", idx), nil), nil
 		}
 
 		// handle non-blob transactions first
@@ -92,8 +89,7 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 			_, has := hasPFB(msgs)
 			if has {
 				// A non-blob tx has a PFB, which is invalid
-				logInvalidPropBlock(app.Logger(), blockHeader, fmt.Sprintf("tx %d has PFB but is not a blob tx", idx))
-				return reject(), nil
+				return app.rejectProposal(blockHeader, RejectReasonPFBWithoutBlobs, fmt.Sprintf("tx %d has PFB but is not a blob tx", idx), nil), nil
 			}
 
 			// we need to increment the sequence for every transaction so that
@@ -101,8 +97,7 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 			// if the account in question doesn't exist.
 			ctx, err = handler(ctx, sdkTx, false)
 			if err != nil {
-				logInvalidPropBlockError(app.Logger(), blockHeader, "failure to increment sequence", err)
-				return reject(), nil
+				return app.rejectProposal(blockHeader, RejectReasonAnteFailed, "failure to increment sequence", err), nil
 			}
 
 			// we do not need to perform further checks on this transaction,
@@ -117,49 +112,42 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 		// - that the namespaces match between blob and PFB
 		// - that the share commitment is correct
 		if err := blobtypes.ValidateBlobTx(app.encodingConfig.TxConfig, blobTx, appconsts.SubtreeRootThreshold, appconsts.Version); err != nil {
-			logInvalidPropBlockError(app.Logger(), blockHeader, fmt.Sprintf("invalid blob tx %d", idx), err)
-			return reject(), nil
+			return app.rejectProposal(blockHeader, RejectReasonInvalidBlobTx, fmt.Sprintf("invalid blob tx %d", idx), err), nil
 		}
 
 		// validated the PFB signature
 		ctx, err = handler(ctx, sdkTx, false)
 		if err != nil {
-			logInvalidPropBlockError(app.Logger(), blockHeader, "invalid PFB signature", err)
-			return reject(), nil
+			return app.rejectProposal(blockHeader, RejectReasonAnteFailed, "invalid PFB signature", err), nil
 		}
 
 	}
 
 	dataSquare, err := square.Construct(req.Txs, app.MaxEffectiveSquareSize(ctx), appconsts.SubtreeRootThreshold)
 	if err != nil {
-		logInvalidPropBlockError(app.Logger(), blockHeader, "failure to compute data square from transactions:", err)
-		return reject(), nil
+		return app.rejectProposal(blockHeader, RejectReasonSquareConstruction, "failure to compute data square from transactions:", err), nil
 	}
 
 	// Assert that the square size stated by the proposer is correct
 	if uint64(dataSquare.Size()) != req.SquareSize {
-		logInvalidPropBlock(app.Logger(), blockHeader, "proposed square size differs from calculated square size")
-		return reject(), nil
+		return app.rejectProposal(blockHeader, RejectReasonSquareSizeMismatch, "proposed square size differs from calculated square size", nil), nil
 	}
 
 	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
 	if err != nil {
-		logInvalidPropBlockError(app.Logger(), blockHeader, "failure to erasure the data square", err)
-		return reject(), nil
+		return app.rejectProposal(blockHeader, RejectReasonErasureCoding, "failure to erasure the data square", err), nil
 	}
 
 	dah, err := da.NewDataAvailabilityHeader(eds)
 	if err != nil {
-		logInvalidPropBlockError(app.Logger(), blockHeader, "failure to create new data availability header", err)
-		return reject(), nil
+		return app.rejectProposal(blockHeader, RejectReasonErasureCoding, "failure to create new data availability header", err), nil
 	}
 
 	// by comparing the hashes we know the computed IndexWrappers (with the share indexes of the PFB's blobs)
 	// are identical and that square layout is consistent. This also means that the share commitment rules
 	// have been followed and thus each blobs share commitment should be valid
 	if !bytes.Equal(dah.Hash(), req.DataRootHash) {
-		logInvalidPropBlock(app.Logger(), blockHeader, fmt.Sprintf("proposed data root %X differs from calculated data root %X", req.DataRootHash, dah.Hash()))
-		return reject(), nil
+		return app.rejectProposal(blockHeader, RejectReasonDataRootMismatch, fmt.Sprintf("proposed data root %X differs from calculated data root %X", req.DataRootHash, dah.Hash()), nil), nil
 	}
 
 	return accept(), nil
@@ -174,6 +162,17 @@ func hasPFB(msgs []sdk.Msg) (*blobtypes.MsgPayForBlobs, bool) {
 	return nil, false
 }
 
+// rejectProposal logs and traces the reason the proposal is rejected.
+func (app *App) rejectProposal(h tmproto.Header, reason ProposalRejectReason, msg string, err error) *abci.ResponseProcessProposal {
+	if err != nil {
+		logInvalidPropBlockError(app.Logger(), h, msg, err)
+	} else {
+		logInvalidPropBlock(app.Logger(), h, msg)
+	}
+	app.proposalTracer.traceReject(h.Height, fmt.Sprintf("%X", h.ProposerAddress), reason, msg, err)
+	return reject()
+}
+
 func logInvalidPropBlock(l log.Logger, h tmproto.Header, reason string) {
 	l.Error(
 		rejectedPropBlockLog,
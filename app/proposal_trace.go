@@ -0,0 +1,275 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+	"github.com/spf13/cast"
+)
+
+// TxFate is what happened to a tx when a proposal was prepared.
+type TxFate string
+
+const (
+	TxFateIncluded TxFate = "included"
+	// TxFateDecodeFailed is the fate of a tx that couldn't be decoded.
+	TxFateDecodeFailed TxFate = "decode_failed"
+	// TxFateAnteFailed is the fate of a tx that failed the ante handler, e.g.
+	// because of an invalid sequence or an insufficient fee.
+	TxFateAnteFailed TxFate = "ante_failed"
+	// TxFateTooLarge is the fate of a tx that exceeds the max tx size or
+	// didn't fit in the remaining space of the square.
+	TxFateTooLarge TxFate = "too_large"
+	// TxFateOverMessageCap is the fate of a tx whose messages would exceed
+	// the max number of PFB or non-PFB messages of a block.
+	TxFateOverMessageCap TxFate = "over_message_cap"
+	// TxFateNotAdmitted is the fate of a blob tx that was not admitted by the
+	// TxPrioritizer.
+	TxFateNotAdmitted TxFate = "not_admitted"
+)
+
+// ProposalRejectReason is the reason a proposal was rejected in
+// ProcessProposal.
+type ProposalRejectReason string
+
+const (
+	RejectReasonPanic              ProposalRejectReason = "panic"
+	RejectReasonTxTooLarge         ProposalRejectReason = "tx_too_large"
+	RejectReasonInvalidBlobTx      ProposalRejectReason = "invalid_blob_tx"
+	RejectReasonUndecodableTx      ProposalRejectReason = "undecodable_tx"
+	RejectReasonPFBWithoutBlobs    ProposalRejectReason = "pfb_without_blobs"
+	RejectReasonAnteFailed         ProposalRejectReason = "ante_failed"
+	RejectReasonSquareConstruction ProposalRejectReason = "square_construction"
+	RejectReasonSquareSizeMismatch ProposalRejectReason = "square_size_mismatch"
+	RejectReasonErasureCoding      ProposalRejectReason = "erasure_coding"
+	RejectReasonDataRootMismatch   ProposalRejectReason = "data_root_mismatch"
+)
+
+const (
+	stagePrepareProposal = "prepare_proposal"
+	stageProcessProposal = "process_proposal"
+)
+
+// TxTrace records the fate of a single tx.
+type TxTrace struct {
+	Hash  string `json:"hash"`
+	Fate  TxFate `json:"fate"`
+	Error string `json:"error,omitempty"`
+}
+
+// ProposalTrace is the record of a proposal that was prepared or rejected by
+// this node.
+type ProposalTrace struct {
+	Stage    string    `json:"stage"`
+	Height   int64     `json:"height"`
+	Time     time.Time `json:"time"`
+	Proposer string    `json:"proposer,omitempty"`
+	// Txs is the fate of each tx considered for a prepared proposal.
+	Txs []TxTrace `json:"txs,omitempty"`
+	// RejectReason and Error explain why a proposal was rejected.
+	RejectReason ProposalRejectReason `json:"reject_reason,omitempty"`
+	Error        string               `json:"error,omitempty"`
+}
+
+const (
+	FlagProposalTraceFile           = "proposal-trace.file"
+	FlagProposalTraceMaxFileSizeMiB = "proposal-trace.max-file-size-mib"
+	FlagProposalTraceMaxFiles       = "proposal-trace.max-files"
+)
+
+// ProposalTraceConfigTemplate is the app.toml template of the
+// ProposalTraceConfig.
+const ProposalTraceConfigTemplate = `
+###############################################################################
+###                       Proposal Trace Configuration                      ###
+###############################################################################
+
+# The proposal trace records the fate of each tx of the proposals prepared by
+# this node and the reason of each proposal rejected by this node. Metrics are
+# always emitted when telemetry is enabled.
+[proposal-trace]
+
+# File is the JSONL file the traces are written to. A relative path is relative
+# to the node's home directory. Empty disables the file.
+file = "{{ .ProposalTrace.File }}"
+
+# MaxFileSizeMiB is the size at which the file is rotated.
+max-file-size-mib = {{ .ProposalTrace.MaxFileSizeMiB }}
+
+# MaxFiles is the number of rotated files that are kept.
+max-files = {{ .ProposalTrace.MaxFiles }}
+`
+
+// ProposalTraceConfig configures the JSONL file proposal traces are written
+// to.
+type ProposalTraceConfig struct {
+	File           string `mapstructure:"file"`
+	MaxFileSizeMiB int    `mapstructure:"max-file-size-mib"`
+	MaxFiles       int    `mapstructure:"max-files"`
+}
+
+// DefaultProposalTraceConfig returns the config with the trace file disabled.
+func DefaultProposalTraceConfig() ProposalTraceConfig {
+	return ProposalTraceConfig{
+		MaxFileSizeMiB: 100,
+		MaxFiles:       5,
+	}
+}
+
+// ProposalTraceConfigFromAppOptions reads the ProposalTraceConfig from the app
+// options, falling back to the defaults for unset options.
+func ProposalTraceConfigFromAppOptions(appOpts servertypes.AppOptions) ProposalTraceConfig {
+	cfg := DefaultProposalTraceConfig()
+	cfg.File = cast.ToString(appOpts.Get(FlagProposalTraceFile))
+	if cfg.File != "" && !filepath.IsAbs(cfg.File) {
+		cfg.File = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), cfg.File)
+	}
+	if size := cast.ToInt(appOpts.Get(FlagProposalTraceMaxFileSizeMiB)); size > 0 {
+		cfg.MaxFileSizeMiB = size
+	}
+	if files := cast.ToInt(appOpts.Get(FlagProposalTraceMaxFiles)); files > 0 {
+		cfg.MaxFiles = files
+	}
+	return cfg
+}
+
+// proposalTracer emits metrics for proposal traces and writes them to the
+// trace file if one is configured. Failing to write a trace is logged and
+// never affects the proposal.
+type proposalTracer struct {
+	logger log.Logger
+	file   *rotatingFile
+}
+
+func newProposalTracer(logger log.Logger, cfg ProposalTraceConfig) *proposalTracer {
+	t := &proposalTracer{logger: logger}
+	if cfg.File != "" {
+		t.file = newRotatingFile(cfg.File, int64(cfg.MaxFileSizeMiB)<<20, cfg.MaxFiles)
+	}
+	return t
+}
+
+func (t *proposalTracer) tracePrepare(height int64, txs []TxTrace) {
+	for _, tx := range txs {
+		telemetry.IncrCounterWithLabels([]string{stagePrepareProposal, "tx_fate"}, 1, []metrics.Label{telemetry.NewLabel("fate", string(tx.Fate))})
+	}
+	t.write(ProposalTrace{
+		Stage:  stagePrepareProposal,
+		Height: height,
+		Time:   time.Now().UTC(),
+		Txs:    txs,
+	})
+}
+
+func (t *proposalTracer) traceReject(height int64, proposer string, reason ProposalRejectReason, msg string, err error) {
+	telemetry.IncrCounterWithLabels([]string{stageProcessProposal, "rejected"}, 1, []metrics.Label{telemetry.NewLabel("reason", string(reason))})
+	trace := ProposalTrace{
+		Stage:        stageProcessProposal,
+		Height:       height,
+		Time:         time.Now().UTC(),
+		Proposer:     proposer,
+		RejectReason: reason,
+		Error:        msg,
+	}
+	if err != nil {
+		trace.Error = fmt.Sprintf("%s: %v", msg, err)
+	}
+	t.write(trace)
+}
+
+func (t *proposalTracer) write(trace ProposalTrace) {
+	if t == nil || t.file == nil {
+		return
+	}
+	bz, err := json.Marshal(trace)
+	if err != nil {
+		t.logger.Error("marshalling proposal trace", "error", err)
+		return
+	}
+	if err := t.file.writeLine(bz); err != nil {
+		t.logger.Error("writing proposal trace", "file", t.file.path, "error", err)
+	}
+}
+
+// rotatingFile is an append only file that is rotated once it exceeds
+// maxSize. Rotated files are suffixed with .1 to .maxFiles, .1 being the most
+// recent one.
+type rotatingFile struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	mtx  sync.Mutex
+	file *os.File
+	size int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxFiles int) *rotatingFile {
+	return &rotatingFile{path: path, maxSize: maxSize, maxFiles: maxFiles}
+}
+
+func (f *rotatingFile) writeLine(line []byte) error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return err
+		}
+	}
+	if f.size > 0 && f.size+int64(len(line))+1 > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := f.file.Write(append(line, '\n'))
+	f.size += int64(n)
+	return err
+}
+
+func (f *rotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+	for i := f.maxFiles - 1; i > 0; i-- {
+		if err := os.Rename(f.rotatedPath(i), f.rotatedPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(f.path, f.rotatedPath(1)); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+func (f *rotatingFile) rotatedPath(i int) string {
+	return fmt.Sprintf("%s.%d", f.path, i)
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	f := newRotatingFile(path, 100, 2)
+
+	line := []byte(strings.Repeat("a", 39))
+	for i := 0; i < 7; i++ {
+		require.NoError(t, f.writeLine(line))
+	}
+
+	// a file holds at most two lines of 40 bytes and the oldest rotated file
+	// was removed
+	wantSizes := map[string]int{path: 40, path + ".1": 80, path + ".2": 80}
+	for p, want := range wantSizes {
+		bz, err := os.ReadFile(p)
+		require.NoError(t, err)
+		require.Len(t, bz, want)
+	}
+	_, err := os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestProposalTracer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces", "trace.jsonl")
+	cfg := DefaultProposalTraceConfig()
+	cfg.File = path
+	tracer := newProposalTracer(log.NewNopLogger(), cfg)
+
+	txs := []TxTrace{
+		{Hash: "AA", Fate: TxFateIncluded},
+		{Hash: "BB", Fate: TxFateAnteFailed, Error: "account sequence mismatch"},
+	}
+	tracer.tracePrepare(10, txs)
+	tracer.traceReject(11, "CC", RejectReasonDataRootMismatch, "proposed data root differs", nil)
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var traces []ProposalTrace
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var trace ProposalTrace
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &trace))
+		traces = append(traces, trace)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, traces, 2)
+
+	require.Equal(t, stagePrepareProposal, traces[0].Stage)
+	require.EqualValues(t, 10, traces[0].Height)
+	require.Equal(t, txs, traces[0].Txs)
+
+	require.Equal(t, stageProcessProposal, traces[1].Stage)
+	require.EqualValues(t, 11, traces[1].Height)
+	require.Equal(t, "CC", traces[1].Proposer)
+	require.Equal(t, RejectReasonDataRootMismatch, traces[1].RejectReason)
+	require.Equal(t, "proposed data root differs", traces[1].Error)
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const FlagSquarePlacementEnabled = "square-placement.enabled"
+
+// SquarePlacementConfigTemplate is the app.toml template of the
+// SquarePlacementConfig.
+const SquarePlacementConfigTemplate = `
+###############################################################################
+###                      Square Placement Configuration                     ###
+###############################################################################
+
+# The square placement service estimates where the blobs of a blob tx would be
+# placed in the next square. Every estimate fills a square from the whole
+# mempool, so the service is only meant for nodes that serve trusted clients.
+[square-placement]
+
+# Enabled turns on the service.
+enabled = {{ .SquarePlacement.Enabled }}
+`
+
+// SquarePlacementConfig configures the square placement service of the node.
+type SquarePlacementConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// DefaultSquarePlacementConfig returns the config with the service disabled.
+func DefaultSquarePlacementConfig() SquarePlacementConfig {
+	return SquarePlacementConfig{}
+}
+
+// SquarePlacementConfigFromAppOptions reads the SquarePlacementConfig from the
+// app options, falling back to the defaults for unset options.
+func SquarePlacementConfigFromAppOptions(appOpts servertypes.AppOptions) SquarePlacementConfig {
+	cfg := DefaultSquarePlacementConfig()
+	cfg.Enabled = cast.ToBool(appOpts.Get(FlagSquarePlacementEnabled))
+	return cfg
+}
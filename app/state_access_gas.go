@@ -0,0 +1,148 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	minttypes "github.com/celestiaorg/celestia-app/v6/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	// stateAccessSimulationFee is the fee of the PayForBlobs simulated to
+	// measure the gas of its state accesses. It is large enough for any gas
+	// price and non-zero fee split amounts.
+	stateAccessSimulationFee = 1_000_000_000_000
+	// stateAccessSimulationGasLimit is the gas limit of the PayForBlobs
+	// simulated to measure the gas of its state accesses.
+	stateAccessSimulationGasLimit = 1_000_000_000
+)
+
+// getStateAccessGas is used by the gas estimation service to measure the gas
+// consumed by the state accesses of a PayForBlobs paying for the blobs. The
+// PayForBlobs is executed on a branch of the latest state once with and once
+// without charging gas for store accesses, and the difference between the gas
+// consumed by both is returned.
+func (app *App) getStateAccessGas(blobs []*gasestimation.BlobInfo, pubKeyType string, feeGranted bool) (uint64, error) {
+	var privKey cryptotypes.PrivKey
+	switch pubKeyType {
+	case gasestimation.PubKeyTypeSecp256k1:
+		privKey = secp256k1.GenPrivKey()
+	case gasestimation.PubKeyTypeSecp256r1:
+		key, err := secp256r1.GenPrivKey()
+		if err != nil {
+			return 0, err
+		}
+		privKey = key
+	default:
+		return 0, fmt.Errorf("unsupported signer public key type %q", pubKeyType)
+	}
+
+	gasUsed, err := app.simulatePayForBlobs(blobs, privKey.PubKey(), feeGranted, storetypes.KVGasConfig(), storetypes.TransientGasConfig())
+	if err != nil {
+		return 0, err
+	}
+	gasUsedWithoutStateAccess, err := app.simulatePayForBlobs(blobs, privKey.PubKey(), feeGranted, storetypes.GasConfig{}, storetypes.GasConfig{})
+	if err != nil {
+		return 0, err
+	}
+	return gasUsed - gasUsedWithoutStateAccess, nil
+}
+
+// simulatePayForBlobs executes the ante handler and the message of a
+// PayForBlobs paying for the blobs on a branch of the latest state that is
+// discarded afterwards and returns the gas consumed. The signer is a new
+// account whose public key isn't stored yet, so the gas of storing it on the
+// signer's first tx is included. If the fee is granted, it is paid by another
+// new account through a feegrant.
+func (app *App) simulatePayForBlobs(blobs []*gasestimation.BlobInfo, pubKey cryptotypes.PubKey, feeGranted bool, kvGasConfig, transientGasConfig storetypes.GasConfig) (uint64, error) {
+	ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+	if err != nil {
+		return 0, err
+	}
+	// the accounts are set up without consuming gas. The PayForBlobs is
+	// executed like a simulated tx, which consumes at least as much gas as a
+	// tx in a block.
+	ctx = ctx.WithExecMode(sdk.ExecModeSimulate).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	signer := sdk.AccAddress(pubKey.Address())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, signer))
+
+	// the balance of the payer and the spend limit of the feegrant exceed the
+	// fee so that they are updated rather than deleted when the fee is paid
+	fee := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdkmath.NewInt(stateAccessSimulationFee)))
+	funds := fee.Add(fee...)
+	payer := signer
+	if feeGranted {
+		payer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		expiration := ctx.BlockTime().Add(365 * 24 * time.Hour)
+		allowance := &feegrant.BasicAllowance{SpendLimit: funds, Expiration: &expiration}
+		if err := app.FeeGrantKeeper.GrantAllowance(ctx, payer, signer, allowance); err != nil {
+			return 0, err
+		}
+	}
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds); err != nil {
+		return 0, err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, payer, funds); err != nil {
+		return 0, err
+	}
+
+	msg := &blobtypes.MsgPayForBlobs{
+		Signer:           signer.String(),
+		Namespaces:       make([][]byte, len(blobs)),
+		BlobSizes:        make([]uint32, len(blobs)),
+		ShareCommitments: make([][]byte, len(blobs)),
+		ShareVersions:    make([]uint32, len(blobs)),
+	}
+	for i, blob := range blobs {
+		msg.Namespaces[i] = blob.Namespace
+		msg.BlobSizes[i] = blob.DataSize
+		msg.ShareCommitments[i] = make([]byte, appconsts.HashLength())
+		msg.ShareVersions[i] = blob.ShareVersion
+	}
+	builder := app.encodingConfig.TxConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msg); err != nil {
+		return 0, err
+	}
+	builder.SetGasLimit(stateAccessSimulationGasLimit)
+	builder.SetFeeAmount(fee)
+	if feeGranted {
+		builder.SetFeeGranter(payer)
+	}
+	// signatures aren't verified in simulations
+	if err := builder.SetSignatures(signing.SignatureV2{
+		PubKey: pubKey,
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: make([]byte, 64)},
+	}); err != nil {
+		return 0, err
+	}
+	txBytes, err := app.encodingConfig.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return 0, err
+	}
+
+	ctx = ctx.WithTxBytes(txBytes).WithKVGasConfig(kvGasConfig).WithTransientKVGasConfig(transientGasConfig)
+	ctx, err = app.AnteHandler()(ctx, builder.GetTx(), true)
+	if err != nil {
+		return 0, err
+	}
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return 0, fmt.Errorf("no handler for %s", sdk.MsgTypeURL(msg))
+	}
+	if _, err := handler(ctx, msg); err != nil {
+		return 0, err
+	}
+	return ctx.GasMeter().GasConsumed(), nil
+}
@@ -0,0 +1,88 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/ante"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proto/tendermint/version"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeSplitUpgrade verifies that the ante handler only splits the fee of a
+// PayForBlobs once the chain has upgraded to the fee split app version.
+func TestFeeSplitUpgrade(t *testing.T) {
+	accounts := testfactory.GenerateAccounts(1)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	ctx := testApp.NewContextLegacy(true, cmtproto.Header{
+		Version: version.Consensus{App: appconsts.Version},
+		ChainID: testutil.ChainID,
+		Height:  testApp.LastBlockHeight() + 1,
+	}).WithIsCheckTx(false)
+	params := testApp.BlobKeeper.GetParams(ctx)
+	params.FeeBurnFraction = math.LegacyMustNewDecFromStr("0.5")
+	testApp.BlobKeeper.SetParams(ctx, params)
+
+	signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, user.NewAccount(accounts[0], infos[0].AccountNum, infos[0].Sequence))
+	require.NoError(t, err)
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(100))
+	require.NoError(t, err)
+	rawTx, _, err := signer.CreatePayForBlobs(accounts[0], []*share.Blob{blob}, user.SetGasLimit(200_000), user.SetFee(10_000))
+	require.NoError(t, err)
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	require.NoError(t, err)
+	require.True(t, isBlob)
+	sdkTx, err := enc.TxConfig.TxDecoder()(bTx.Tx)
+	require.NoError(t, err)
+
+	handler := ante.NewAnteHandler(
+		testApp.AccountKeeper,
+		testApp.BankKeeper,
+		testApp.BlobKeeper,
+		testApp.FeeGrantKeeper,
+		testApp.GetTxConfig().SignModeHandler(),
+		ante.DefaultSigVerificationGasConsumer,
+		testApp.IBCKeeper,
+		testApp.MinFeeKeeper,
+		testApp.NsRegistryKeeper,
+		&testApp.CircuitKeeper,
+		testApp.GovParamFilters(),
+	)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = handler(cacheCtx, sdkTx, false)
+	require.NoError(t, err)
+	require.Equal(t, blobtypes.FeeSplitTotals{}, testApp.BlobKeeper.GetFeeSplitTotals(cacheCtx))
+
+	require.NoError(t, testApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{
+		Name:   fmt.Sprintf("v%d", blobtypes.FeeSplitAppVersion),
+		Height: ctx.BlockHeight(),
+	}))
+	header := ctx.BlockHeader()
+	header.Version.App = blobtypes.FeeSplitAppVersion
+	ctx = ctx.WithBlockHeader(header)
+
+	supplyBefore := testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom)
+	_, err = handler(ctx, sdkTx, false)
+	require.NoError(t, err)
+
+	burned := sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 5_000))
+	require.Equal(t, blobtypes.FeeSplitTotals{Burned: burned}, testApp.BlobKeeper.GetFeeSplitTotals(ctx))
+	require.Equal(t, supplyBefore.Sub(burned[0]), testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom))
+}
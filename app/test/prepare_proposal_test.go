@@ -2,11 +2,13 @@ package app_test
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/ante"
 	"github.com/celestiaorg/celestia-app/v6/app/encoding"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/pkg/user"
@@ -477,3 +479,100 @@ func repeat[T any](n int, val T) []T {
 	}
 	return result
 }
+
+func TestPrepareProposalWithFeePerShareOrder(t *testing.T) {
+	accounts := testfactory.GenerateAccounts(3)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	cfg := app.DefaultTxPrioritizationConfig()
+	cfg.BlobOrder = app.BlobOrderFeePerShare
+	prioritizer, err := app.NewTxPrioritizer(cfg, enc.TxConfig.TxDecoder())
+	require.NoError(t, err)
+	testApp.SetTxPrioritizer(prioritizer)
+
+	// blob txs are passed in ascending order of their fee
+	txs := make([][]byte, len(accounts))
+	for i, account := range accounts {
+		signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, user.NewAccount(account, infos[i].AccountNum, infos[i].Sequence))
+		require.NoError(t, err)
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(100))
+		require.NoError(t, err)
+		txs[i], _, err = signer.CreatePayForBlobs(account, []*share.Blob{blob}, user.SetGasLimit(100_000), user.SetFee(uint64(10_000*(i+1))))
+		require.NoError(t, err)
+	}
+
+	height := testApp.LastBlockHeight() + 1
+	blockTime := time.Now()
+	prepareResponse, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    txs,
+		Height: height,
+		Time:   blockTime,
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{txs[2], txs[1], txs[0]}, prepareResponse.Txs)
+
+	processResponse, err := testApp.ProcessProposal(&abci.RequestProcessProposal{
+		Header: &cmtproto.Header{
+			Version:  version.Consensus{App: appconsts.Version},
+			ChainID:  testutil.ChainID,
+			Height:   height,
+			Time:     blockTime,
+			DataHash: prepareResponse.DataRootHash,
+		},
+		Height:       height,
+		Time:         blockTime,
+		Txs:          prepareResponse.Txs,
+		SquareSize:   prepareResponse.SquareSize,
+		DataRootHash: prepareResponse.DataRootHash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processResponse.Status)
+}
+
+func TestFilteredSquareBuilderTxTraces(t *testing.T) {
+	accounts := testfactory.GenerateAccounts(2)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	validTx := testutil.SendTxWithManualSequence(t, enc.TxConfig, kr, accounts[0], accounts[1], 1000, testutil.ChainID, infos[0].Sequence, infos[0].AccountNum, user.SetGasLimitAndGasPrice(100_000, appconsts.DefaultMinGasPrice))
+	wrongSequenceTx := testutil.SendTxWithManualSequence(t, enc.TxConfig, kr, accounts[1], accounts[0], 1000, testutil.ChainID, infos[1].Sequence+1, infos[1].AccountNum, user.SetGasLimitAndGasPrice(100_000, appconsts.DefaultMinGasPrice))
+	tooLargeTx := random.Bytes(appconsts.MaxTxSize + 1)
+
+	handler := ante.NewAnteHandler(
+		testApp.AccountKeeper,
+		testApp.BankKeeper,
+		testApp.BlobKeeper,
+		testApp.FeeGrantKeeper,
+		testApp.GetTxConfig().SignModeHandler(),
+		ante.DefaultSigVerificationGasConsumer,
+		testApp.IBCKeeper,
+		testApp.MinFeeKeeper,
+		testApp.NsRegistryKeeper,
+		&testApp.CircuitKeeper,
+		testApp.GovParamFilters(),
+	)
+	ctx := testApp.NewContextLegacy(true, cmtproto.Header{ChainID: testutil.ChainID, Height: testApp.LastBlockHeight() + 1}).WithIsCheckTx(false)
+	fsb, err := app.NewFilteredSquareBuilder(handler, enc.TxConfig, testApp.MaxEffectiveSquareSize(ctx), appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+
+	kept := fsb.Fill(ctx, [][]byte{validTx, wrongSequenceTx, tooLargeTx})
+	require.Equal(t, [][]byte{validTx}, kept)
+
+	traces := fsb.TxTraces()
+	require.Len(t, traces, 3)
+	require.Equal(t, app.TxFateTooLarge, traces[0].Fate)
+	require.Equal(t, coretypes.Tx(tooLargeTx).Hash(), mustDecodeHex(t, traces[0].Hash))
+	require.Equal(t, app.TxFateIncluded, traces[1].Fate)
+	require.Equal(t, coretypes.Tx(validTx).Hash(), mustDecodeHex(t, traces[1].Hash))
+	require.Equal(t, app.TxFateAnteFailed, traces[2].Fate)
+	require.Contains(t, traces[2].Error, "account sequence mismatch")
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
@@ -0,0 +1,282 @@
+package app
+
+import (
+	"container/heap"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// BlobOrderMempool considers blob txs in the order they are reaped from
+	// the mempool.
+	BlobOrderMempool = "mempool"
+	// BlobOrderFeePerShare considers blob txs with the highest fee paid per
+	// blob share first. Txs of the same signer keep their relative order so
+	// that their sequences remain valid.
+	BlobOrderFeePerShare = "fee-per-share"
+)
+
+const (
+	FlagTxPrioritizationBlobOrder            = "tx-prioritization.blob-order"
+	FlagTxPrioritizationMaxNamespaceFraction = "tx-prioritization.max-namespace-fraction"
+)
+
+// TxPrioritizationConfigTemplate is the app.toml template of the
+// TxPrioritizationConfig.
+const TxPrioritizationConfigTemplate = `
+###############################################################################
+###                      Tx Prioritization Configuration                    ###
+###############################################################################
+
+# The tx prioritization policy determines which txs this node includes in the
+# blocks it proposes. It has no effect on which proposals are valid.
+[tx-prioritization]
+
+# BlobOrder is the order in which blob txs are considered for a proposal:
+# "mempool" keeps the order of the mempool, "fee-per-share" ranks blob txs by
+# the fee they pay per blob share.
+blob-order = "{{ .TxPrioritization.BlobOrder }}"
+
+# MaxNamespaceFraction is the max fraction of the shares of a max sized square
+# that the data of the blobs of a single namespace may occupy. 0 disables the
+# cap.
+max-namespace-fraction = {{ .TxPrioritization.MaxNamespaceFraction }}
+`
+
+// TxPrioritizationConfig configures the tx prioritization policy used when
+// preparing proposals.
+type TxPrioritizationConfig struct {
+	BlobOrder            string  `mapstructure:"blob-order"`
+	MaxNamespaceFraction float64 `mapstructure:"max-namespace-fraction"`
+}
+
+// DefaultTxPrioritizationConfig returns the config that considers txs in the
+// mempool's order without any caps.
+func DefaultTxPrioritizationConfig() TxPrioritizationConfig {
+	return TxPrioritizationConfig{
+		BlobOrder: BlobOrderMempool,
+	}
+}
+
+// TxPrioritizationConfigFromAppOptions reads the TxPrioritizationConfig from
+// the app options, falling back to the defaults for unset options.
+func TxPrioritizationConfigFromAppOptions(appOpts servertypes.AppOptions) TxPrioritizationConfig {
+	cfg := DefaultTxPrioritizationConfig()
+	if blobOrder := cast.ToString(appOpts.Get(FlagTxPrioritizationBlobOrder)); blobOrder != "" {
+		cfg.BlobOrder = blobOrder
+	}
+	cfg.MaxNamespaceFraction = cast.ToFloat64(appOpts.Get(FlagTxPrioritizationMaxNamespaceFraction))
+	return cfg
+}
+
+// ValidateBasic returns an error if the config is invalid.
+func (cfg TxPrioritizationConfig) ValidateBasic() error {
+	switch cfg.BlobOrder {
+	case BlobOrderMempool, BlobOrderFeePerShare:
+	default:
+		return fmt.Errorf("unknown blob order %q", cfg.BlobOrder)
+	}
+	if cfg.MaxNamespaceFraction < 0 || cfg.MaxNamespaceFraction > 1 {
+		return fmt.Errorf("max namespace fraction %v must be between 0 and 1", cfg.MaxNamespaceFraction)
+	}
+	return nil
+}
+
+// SquareUsage is the usage of the square by the blob txs that have been added
+// to it.
+type SquareUsage struct {
+	// MaxShares is the number of shares of a max sized square.
+	MaxShares int
+	// BlobTxShares is the number of shares the square builder reserved for
+	// the blob txs. It includes the blob shares, the worst case padding before
+	// each blob and the compact shares of the PFB txs.
+	BlobTxShares int
+	// NamespaceShares is the number of shares occupied by blob data per
+	// namespace, keyed by the namespace bytes. It excludes padding.
+	NamespaceShares map[string]int
+}
+
+func newSquareUsage(maxSquareSize int) *SquareUsage {
+	return &SquareUsage{
+		MaxShares:       maxSquareSize * maxSquareSize,
+		NamespaceShares: make(map[string]int),
+	}
+}
+
+// add adds a blob tx for which the square builder reserved the given number
+// of shares.
+func (u *SquareUsage) add(blobTx *tx.BlobTx, shares int) {
+	u.BlobTxShares += shares
+	for _, blob := range blobTx.Blobs {
+		u.NamespaceShares[string(blob.Namespace().Bytes())] += share.SparseSharesNeeded(uint32(blob.DataLen()))
+	}
+}
+
+// remove undoes add.
+func (u *SquareUsage) remove(blobTx *tx.BlobTx, shares int) {
+	u.BlobTxShares -= shares
+	for _, blob := range blobTx.Blobs {
+		u.NamespaceShares[string(blob.Namespace().Bytes())] -= share.SparseSharesNeeded(uint32(blob.DataLen()))
+	}
+}
+
+// TxPrioritizer decides which blob txs a FilteredSquareBuilder considers and
+// in which order. It only affects the txs proposed by this node and never the
+// validity of a proposal, which is why it can be configured per node.
+type TxPrioritizer interface {
+	// PrioritizeBlobTxs returns the blob txs in the order they should be
+	// considered for the square.
+	PrioritizeBlobTxs(blobTxs []*tx.BlobTx) []*tx.BlobTx
+	// AdmitBlobTx reports whether the blob tx may remain in the square. The
+	// usage already includes the blob tx, as measured by the square builder.
+	AdmitBlobTx(blobTx *tx.BlobTx, usage SquareUsage) bool
+}
+
+// NewTxPrioritizer returns the TxPrioritizer for the config.
+func NewTxPrioritizer(cfg TxPrioritizationConfig, txDecoder sdk.TxDecoder) (TxPrioritizer, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &txPrioritizer{cfg: cfg, txDecoder: txDecoder}, nil
+}
+
+// SetTxPrioritizer replaces the TxPrioritizer configured in app.toml with a
+// custom one.
+func (app *App) SetTxPrioritizer(prioritizer TxPrioritizer) {
+	app.txPrioritizer = prioritizer
+}
+
+var _ TxPrioritizer = &txPrioritizer{}
+
+type txPrioritizer struct {
+	cfg       TxPrioritizationConfig
+	txDecoder sdk.TxDecoder
+}
+
+func (p *txPrioritizer) PrioritizeBlobTxs(blobTxs []*tx.BlobTx) []*tx.BlobTx {
+	if p.cfg.BlobOrder != BlobOrderFeePerShare {
+		return blobTxs
+	}
+	return orderByFeePerShare(p.txDecoder, blobTxs)
+}
+
+func (p *txPrioritizer) AdmitBlobTx(blobTx *tx.BlobTx, usage SquareUsage) bool {
+	if p.cfg.MaxNamespaceFraction > 0 {
+		maxNamespaceShares := int(float64(usage.MaxShares) * p.cfg.MaxNamespaceFraction)
+		for _, blob := range blobTx.Blobs {
+			if usage.NamespaceShares[string(blob.Namespace().Bytes())] > maxNamespaceShares {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// rankedBlobTx is a blob tx with the fee it pays per blob share.
+type rankedBlobTx struct {
+	blobTx      *tx.BlobTx
+	feePerShare float64
+	index       int
+}
+
+// orderByFeePerShare orders the blob txs by the fee paid per blob share in
+// descending order. The txs of a signer are queued in their original order and
+// only the head of each signer's queue competes for the next position, so a tx
+// is never placed before a tx of the same signer with a lower sequence. Ties
+// are broken by the original order.
+func orderByFeePerShare(txDecoder sdk.TxDecoder, blobTxs []*tx.BlobTx) []*tx.BlobTx {
+	var (
+		signers []string
+		queues  = make(map[string][]rankedBlobTx)
+	)
+	for i, blobTx := range blobTxs {
+		signer, feePerShare := signerAndFeePerShare(txDecoder, blobTx)
+		if signer == "" {
+			// txs that can't be decoded are filtered later so they only need
+			// a queue of their own
+			signer = fmt.Sprintf("undecodable-%d", i)
+		}
+		if _, ok := queues[signer]; !ok {
+			signers = append(signers, signer)
+		}
+		queues[signer] = append(queues[signer], rankedBlobTx{blobTx: blobTx, feePerShare: feePerShare, index: i})
+	}
+
+	h := make(signerQueues, 0, len(signers))
+	for _, signer := range signers {
+		h = append(h, queues[signer])
+	}
+	heap.Init(&h)
+
+	ordered := make([]*tx.BlobTx, 0, len(blobTxs))
+	for h.Len() > 0 {
+		queue := heap.Pop(&h).([]rankedBlobTx)
+		ordered = append(ordered, queue[0].blobTx)
+		if len(queue) > 1 {
+			heap.Push(&h, queue[1:])
+		}
+	}
+	return ordered
+}
+
+// signerAndFeePerShare returns the signer of the PFB and the fee in utia it
+// pays per blob share. It returns an empty signer if the tx can't be decoded.
+func signerAndFeePerShare(txDecoder sdk.TxDecoder, blobTx *tx.BlobTx) (string, float64) {
+	sdkTx, err := txDecoder(blobTx.Tx)
+	if err != nil {
+		return "", 0
+	}
+	msgs := sdkTx.GetMsgs()
+	if len(msgs) != 1 {
+		return "", 0
+	}
+	pfb, ok := msgs[0].(*blobtypes.MsgPayForBlobs)
+	if !ok {
+		return "", 0
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return pfb.Signer, 0
+	}
+
+	shares := 0
+	for _, blob := range blobTx.Blobs {
+		shares += share.SparseSharesNeeded(uint32(blob.DataLen()))
+	}
+	if shares == 0 {
+		return pfb.Signer, 0
+	}
+	return pfb.Signer, float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(shares)
+}
+
+// signerQueues is a max heap of the queues of blob txs per signer ordered by
+// the fee per share of the head of each queue.
+type signerQueues [][]rankedBlobTx
+
+func (q signerQueues) Len() int { return len(q) }
+
+func (q signerQueues) Less(i, j int) bool {
+	if q[i][0].feePerShare != q[j][0].feePerShare {
+		return q[i][0].feePerShare > q[j][0].feePerShare
+	}
+	return q[i][0].index < q[j][0].index
+}
+
+func (q signerQueues) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *signerQueues) Push(x any) { *q = append(*q, x.([]rankedBlobTx)) }
+
+func (q *signerQueues) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxPrioritizer(t *testing.T) {
+	enc := encoding.MakeConfig(ModuleEncodingRegisters...)
+	accounts := []string{"alice", "bob"}
+	kr := testfactory.TestKeyring(enc.Codec, accounts...)
+	signers := make(map[string]*user.Signer)
+	for i, account := range accounts {
+		signer, err := user.NewSigner(kr, enc.TxConfig, testfactory.ChainID, user.NewAccount(account, uint64(i), 0))
+		require.NoError(t, err)
+		signers[account] = signer
+	}
+
+	namespace := share.RandomBlobNamespace()
+	// newBlobTx returns a blob tx with a single blob that occupies one share
+	// and pays the fee.
+	newBlobTx := func(account string, fee uint64, ns share.Namespace) *tx.BlobTx {
+		blob, err := share.NewV0Blob(ns, []byte("data"))
+		require.NoError(t, err)
+		rawTx, _, err := signers[account].CreatePayForBlobs(account, []*share.Blob{blob}, user.SetFee(fee), user.SetGasLimit(100_000))
+		require.NoError(t, err)
+		require.NoError(t, signers[account].IncrementSequence(account))
+		blobTx, isBlob, err := tx.UnmarshalBlobTx(rawTx)
+		require.NoError(t, err)
+		require.True(t, isBlob)
+		return blobTx
+	}
+
+	aliceLow := newBlobTx("alice", 100, namespace)
+	aliceHigh := newBlobTx("alice", 1000, namespace)
+	bobMedium := newBlobTx("bob", 500, share.RandomBlobNamespace())
+	blobTxs := []*tx.BlobTx{aliceLow, aliceHigh, bobMedium}
+
+	t.Run("mempool order keeps the order", func(t *testing.T) {
+		p, err := NewTxPrioritizer(DefaultTxPrioritizationConfig(), enc.TxConfig.TxDecoder())
+		require.NoError(t, err)
+		require.Equal(t, blobTxs, p.PrioritizeBlobTxs(append([]*tx.BlobTx{}, blobTxs...)))
+	})
+
+	t.Run("fee per share order keeps the order of a signer's txs", func(t *testing.T) {
+		cfg := DefaultTxPrioritizationConfig()
+		cfg.BlobOrder = BlobOrderFeePerShare
+		p, err := NewTxPrioritizer(cfg, enc.TxConfig.TxDecoder())
+		require.NoError(t, err)
+		// alice's high fee tx can't be placed before her low fee tx
+		require.Equal(t, []*tx.BlobTx{bobMedium, aliceLow, aliceHigh}, p.PrioritizeBlobTxs(append([]*tx.BlobTx{}, blobTxs...)))
+	})
+
+	t.Run("namespace cap", func(t *testing.T) {
+		cfg := DefaultTxPrioritizationConfig()
+		cfg.MaxNamespaceFraction = 0.5
+		p, err := NewTxPrioritizer(cfg, enc.TxConfig.TxDecoder())
+		require.NoError(t, err)
+
+		usage := newSquareUsage(2)
+		usage.add(aliceLow, 2)
+		require.True(t, p.AdmitBlobTx(aliceLow, *usage))
+		usage.add(aliceHigh, 1)
+		require.True(t, p.AdmitBlobTx(aliceHigh, *usage))
+		// the namespace already occupies half of the square
+		aliceExtra := newBlobTx("alice", 100, namespace)
+		usage.add(aliceExtra, 1)
+		require.False(t, p.AdmitBlobTx(aliceExtra, *usage))
+		usage.remove(aliceExtra, 1)
+		usage.add(bobMedium, 1)
+		require.True(t, p.AdmitBlobTx(bobMedium, *usage))
+	})
+
+	t.Run("usage counts the shares used by the square builder", func(t *testing.T) {
+		builder, err := square.NewBuilder(4, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		usage := newSquareUsage(4)
+		appendBlobTx := func(blobTx *tx.BlobTx) {
+			sizeBefore := builder.CurrentSize()
+			require.True(t, builder.AppendBlobTx(blobTx))
+			usage.add(blobTx, builder.CurrentSize()-sizeBefore)
+		}
+
+		// the blob and the compact share of the PFB tx use two of the 16
+		// shares
+		appendBlobTx(aliceLow)
+		require.Equal(t, 2, usage.BlobTxShares)
+		require.Equal(t, 1, usage.NamespaceShares[string(namespace.Bytes())])
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		for _, cfg := range []TxPrioritizationConfig{
+			{BlobOrder: "random"},
+			{BlobOrder: BlobOrderMempool, MaxNamespaceFraction: 1.5},
+		} {
+			_, err := NewTxPrioritizer(cfg, enc.TxConfig.TxDecoder())
+			require.Error(t, err)
+		}
+	})
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
 	minfeetypes "github.com/celestiaorg/celestia-app/v6/x/minfee/types"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -76,10 +78,28 @@ func (app App) RegisterUpgradeHandlers() {
 
 			start := time.Now()
 			sdkCtx.Logger().Info("running upgrade handler", "upgrade-name", upgradeName, "start", start)
-			// TODO: Add any upgrade logic here
+			// run the migrations registered by the modules, which store the
+			// params of x/mint, set the dynamic min gas price params of
+			// x/minfee, stamp the signals of x/signal with the upgrade height
+			// and init the genesis of the modules added in this version.
+			toVM, err := app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+			if err != nil {
+				return nil, err
+			}
+
 			sdkCtx.Logger().Info("finished to upgrade", "upgrade-name", upgradeName, "duration-sec", time.Since(start).Seconds())
 
-			return fromVM, nil
+			return toVM, nil
+		},
+	)
+
+	// the upgrade to the app version from which the fee of PayForBlobs is
+	// split changes no state. It only raises the app version that gates the
+	// fee split.
+	app.UpgradeKeeper.SetUpgradeHandler(
+		fmt.Sprintf("v%d", blobtypes.FeeSplitAppVersion),
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
 		},
 	)
 
@@ -88,7 +108,9 @@ func (app App) RegisterUpgradeHandlers() {
 		panic(err)
 	}
 
-	if upgradeInfo.Name == upgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) { //nolint:staticcheck
-		// TODO: Apply any store upgrades here.
+	if upgradeInfo.Name == upgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{nsregistrytypes.StoreKey},
+		}))
 	}
 }
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"github.com/celestiaorg/celestia-app/v6/app"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// AppConfig extends the app.toml config of the SDK with celestia-app specific
+// sections.
+type AppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	TxPrioritization app.TxPrioritizationConfig `mapstructure:"tx-prioritization"`
+	ProposalTrace    app.ProposalTraceConfig    `mapstructure:"proposal-trace"`
+	BlobUsageIndex   app.BlobUsageIndexConfig   `mapstructure:"blob-usage-index"`
+	SquarePlacement  app.SquarePlacementConfig  `mapstructure:"square-placement"`
+}
+
+// initAppConfig returns the app.toml template and the default app config.
+func initAppConfig() (string, *AppConfig) {
+	template := serverconfig.DefaultConfigTemplate + app.TxPrioritizationConfigTemplate + app.ProposalTraceConfigTemplate + app.BlobUsageIndexConfigTemplate + app.SquarePlacementConfigTemplate
+	cfg := &AppConfig{
+		Config:           *app.DefaultAppConfig(),
+		TxPrioritization: app.DefaultTxPrioritizationConfig(),
+		ProposalTrace:    app.DefaultProposalTraceConfig(),
+		BlobUsageIndex:   app.DefaultBlobUsageIndexConfig(),
+		SquarePlacement:  app.DefaultSquarePlacementConfig(),
+	}
+	return template, cfg
+}
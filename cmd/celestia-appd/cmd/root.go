@@ -17,7 +17,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
 	"github.com/cosmos/cosmos-sdk/server"
-	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
@@ -74,8 +73,7 @@ func NewRootCmd() *cobra.Command {
 				return err
 			}
 
-			appTemplate := serverconfig.DefaultConfigTemplate
-			appConfig := app.DefaultAppConfig()
+			appTemplate, appConfig := initAppConfig()
 			tmConfig := app.DefaultConsensusConfig()
 
 			// Override the default tendermint config and app config for celestia-app
@@ -44,14 +44,20 @@ func (m *Multiplexer) Commit(context.Context, *abci.RequestCommit) (*abci.Respon
 	if err != nil {
 		return nil, fmt.Errorf("failed to get app for version %d: %w", m.appVersion, err)
 	}
+	if err := m.checkBlockInFlight(); err != nil {
+		return nil, err
+	}
 
 	resp, err := app.Commit()
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit: %w", err)
 	}
+	m.endBlock()
 
 	// after a successful commit, we start using the app version specified in FinalizeBlock.
+	m.mu.Lock()
 	m.appVersion = m.nextAppVersion
+	m.mu.Unlock()
 
 	return resp, nil
 }
@@ -82,10 +88,18 @@ func (m *Multiplexer) FinalizeBlock(_ context.Context, req *abci.RequestFinalize
 		return nil, fmt.Errorf("failed to get app for version %d: %w", m.appVersion, err)
 	}
 
+	m.beginBlock(req.Height)
 	resp, err := app.FinalizeBlock(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to finalize block: %w", err)
 	}
+	if err := m.checkBlockInFlight(); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	m.lastHeight = req.Height
+	m.mu.Unlock()
 
 	// set the app version to be used in the next block.
 	if resp.ConsensusParamUpdates != nil && resp.ConsensusParamUpdates.GetVersion() != nil {
@@ -0,0 +1,165 @@
+package abci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
+)
+
+// ManifestFileName is the name of the manifest file of an external version.
+const ManifestFileName = "manifest.json"
+
+// ExternalManifest describes an external version binary staged by the node
+// operator in a subdirectory of the external versions directory.
+type ExternalManifest struct {
+	// Version is the version of the binary, e.g. "v7.0.0".
+	Version string `json:"version"`
+	// AppVersion is the app version that the binary runs.
+	AppVersion uint64 `json:"app_version"`
+	// ABCIVersion is the ABCI version of the binary, either 1 or 2.
+	ABCIVersion int `json:"abci_version"`
+	// Binary is the path to the binary relative to the manifest.
+	Binary string `json:"binary"`
+	// SHA256 is the hex encoded SHA-256 checksum of the binary.
+	SHA256 string `json:"sha256"`
+	// PreHandlers are the commands to run before starting the binary.
+	PreHandlers []string `json:"pre_handlers,omitempty"`
+	// StartArgs are the extra arguments to pass to the binary.
+	StartArgs []string `json:"start_args,omitempty"`
+}
+
+// Validate performs basic validation of the manifest.
+func (m ExternalManifest) Validate() error {
+	if m.Version == "" {
+		return errors.New("version must not be empty")
+	}
+	if m.AppVersion == 0 {
+		return errors.New("app version must be positive")
+	}
+	if _, err := m.abciClientVersion(); err != nil {
+		return err
+	}
+	if m.Binary == "" {
+		return errors.New("binary must not be empty")
+	}
+	if filepath.IsAbs(m.Binary) || strings.HasPrefix(filepath.Clean(m.Binary), "..") {
+		return fmt.Errorf("binary %s must be a path inside the manifest directory", m.Binary)
+	}
+	checksum, err := hex.DecodeString(m.SHA256)
+	if err != nil || len(checksum) != sha256.Size {
+		return fmt.Errorf("invalid sha256 checksum %q", m.SHA256)
+	}
+	return nil
+}
+
+func (m ExternalManifest) abciClientVersion() (ABCIClientVersion, error) {
+	switch m.ABCIVersion {
+	case 1:
+		return ABCIClientVersion1, nil
+	case 2:
+		return ABCIClientVersion2, nil
+	}
+	return 0, fmt.Errorf("unsupported abci version %d", m.ABCIVersion)
+}
+
+// LoadExternalVersions loads the external versions staged in dir. Every
+// subdirectory of dir that contains a manifest is an external version. The
+// checksum of each binary is verified before it is used. It returns no
+// versions if dir doesn't exist.
+func LoadExternalVersions(dir string) (Versions, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return Versions{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read external versions directory: %w", err)
+	}
+
+	versions := Versions{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		versionDir := filepath.Join(dir, entry.Name())
+		if _, err := os.Stat(filepath.Join(versionDir, ManifestFileName)); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		version, err := loadExternalVersion(versionDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load external version in %s: %w", versionDir, err)
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// loadExternalVersion loads the external version whose manifest is in dir.
+func loadExternalVersion(dir string) (Version, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return Version{}, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest ExternalManifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return Version{}, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return Version{}, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	pathToBinary := filepath.Join(dir, manifest.Binary)
+	if err := verifyChecksum(pathToBinary, manifest.SHA256); err != nil {
+		return Version{}, err
+	}
+
+	app, err := appd.NewFromPath(manifest.Version, pathToBinary)
+	if err != nil {
+		return Version{}, err
+	}
+
+	abciVersion, _ := manifest.abciClientVersion()
+	return Version{
+		AppVersion:  manifest.AppVersion,
+		ABCIVersion: abciVersion,
+		Appd:        app,
+		PreHandlers: manifest.PreHandlers,
+		StartArgs:   manifest.StartArgs,
+	}, nil
+}
+
+// verifyChecksum returns an error if the SHA-256 checksum of the file at path
+// doesn't match the hex encoded checksum.
+func verifyChecksum(path string, checksum string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open binary: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("failed to hash binary: %w", err)
+	}
+
+	if got := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(got, checksum) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", path, checksum, got)
+	}
+	return nil
+}
+
+// WithExternal returns the versions combined with the external versions,
+// sorted by app version. It returns an error if an external version has the
+// same app version as another version.
+func (v Versions) WithExternal(external Versions) (Versions, error) {
+	return NewVersions(append(slices.Clone(v), external...)...)
+}
@@ -0,0 +1,112 @@
+package abci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadExternalVersions(t *testing.T) {
+	t.Run("missing directory returns no versions", func(t *testing.T) {
+		versions, err := LoadExternalVersions(filepath.Join(t.TempDir(), "missing"))
+		require.NoError(t, err)
+		require.Empty(t, versions)
+	})
+
+	t.Run("loads versions with a manifest", func(t *testing.T) {
+		dir := t.TempDir()
+		stageVersion(t, dir, "v7", ExternalManifest{Version: "v7.0.0", AppVersion: 7, ABCIVersion: 2, StartArgs: []string{"--transport=grpc"}})
+		stageVersion(t, dir, "v8", ExternalManifest{Version: "v8.0.0", AppVersion: 8, ABCIVersion: 1})
+		// a subdirectory without a manifest is not a version
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "other"), 0o755))
+
+		versions, err := LoadExternalVersions(dir)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		require.EqualValues(t, 7, versions[0].AppVersion)
+		require.Equal(t, ABCIClientVersion2, versions[0].ABCIVersion)
+		require.Equal(t, []string{"--transport=grpc"}, versions[0].StartArgs)
+		require.NotNil(t, versions[0].Appd)
+		require.Equal(t, ABCIClientVersion1, versions[1].ABCIVersion)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		dir := t.TempDir()
+		stageVersion(t, dir, "v7", ExternalManifest{Version: "v7.0.0", AppVersion: 7, ABCIVersion: 2})
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "v7", "celestia-appd"), []byte("#!/bin/sh\nexit 1\n"), 0o755))
+
+		_, err := LoadExternalVersions(dir)
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("invalid manifest", func(t *testing.T) {
+		dir := t.TempDir()
+		stageVersion(t, dir, "v7", ExternalManifest{Version: "v7.0.0", AppVersion: 7, ABCIVersion: 3})
+
+		_, err := LoadExternalVersions(dir)
+		require.ErrorContains(t, err, "unsupported abci version 3")
+	})
+}
+
+func TestExternalManifestValidate(t *testing.T) {
+	valid := ExternalManifest{Version: "v7.0.0", AppVersion: 7, ABCIVersion: 2, Binary: "celestia-appd", SHA256: hex.EncodeToString(make([]byte, sha256.Size))}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(*ExternalManifest)
+		errStr string
+	}{
+		{"empty version", func(m *ExternalManifest) { m.Version = "" }, "version must not be empty"},
+		{"zero app version", func(m *ExternalManifest) { m.AppVersion = 0 }, "app version must be positive"},
+		{"binary outside of the directory", func(m *ExternalManifest) { m.Binary = "../celestia-appd" }, "must be a path inside the manifest directory"},
+		{"absolute binary path", func(m *ExternalManifest) { m.Binary = "/bin/celestia-appd" }, "must be a path inside the manifest directory"},
+		{"short checksum", func(m *ExternalManifest) { m.SHA256 = "abcd" }, "invalid sha256 checksum"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := valid
+			tt.modify(&manifest)
+			require.ErrorContains(t, manifest.Validate(), tt.errStr)
+		})
+	}
+}
+
+func TestVersionsWithExternal(t *testing.T) {
+	embedded := Versions{{AppVersion: 3}, {AppVersion: 4}}
+
+	versions, err := embedded.WithExternal(Versions{{AppVersion: 6}})
+	require.NoError(t, err)
+	require.Equal(t, Versions{{AppVersion: 3}, {AppVersion: 4}, {AppVersion: 6}}, versions)
+	require.Len(t, embedded, 2)
+
+	// the native app version 5 isn't embedded
+	require.True(t, versions.ShouldUseLatestApp(5))
+	require.False(t, versions.ShouldUseLatestApp(6))
+
+	_, err = embedded.WithExternal(Versions{{AppVersion: 4}})
+	require.ErrorContains(t, err, "version 4 specified multiple times")
+}
+
+// stageVersion writes an executable and its manifest to a subdirectory of dir.
+// The binary and the checksum of the manifest are set by stageVersion.
+func stageVersion(t *testing.T, dir string, name string, manifest ExternalManifest) {
+	t.Helper()
+	versionDir := filepath.Join(dir, name)
+	require.NoError(t, os.Mkdir(versionDir, 0o755))
+
+	binary := []byte("#!/bin/sh\nexit 0\n")
+	require.NoError(t, os.WriteFile(filepath.Join(versionDir, "celestia-appd"), binary, 0o755))
+	checksum := sha256.Sum256(binary)
+	manifest.Binary = "celestia-appd"
+	manifest.SHA256 = hex.EncodeToString(checksum[:])
+
+	bz, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(versionDir, ManifestFileName), bz, 0o644))
+}
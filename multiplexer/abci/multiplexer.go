@@ -11,8 +11,10 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
 	"github.com/celestiaorg/celestia-app/v6/multiplexer/internal"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
@@ -35,12 +37,22 @@ import (
 	"github.com/hashicorp/go-metrics"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
 	flagTraceStore = "trace-store"
 	flagGRPCOnly   = "grpc-only"
+	// FlagExternalVersionsDir is the flag of the directory from which external versions are loaded.
+	FlagExternalVersionsDir = "external-versions-dir"
+	// FlagMaxRestarts is the flag of the number of consecutive restarts of a crashed embedded app.
+	FlagMaxRestarts = "multiplexer-max-restarts"
+	// FlagRestartBackoff is the flag of the delay before the first restart of a crashed embedded app.
+	FlagRestartBackoff = "multiplexer-restart-backoff"
+
+	// remoteAppTimeout is how long to wait for the connection to a restarted embedded app.
+	remoteAppTimeout = time.Minute
 )
 
 // Multiplexer is responsible for managing multiple versions of applications and coordinating their lifecycle.
@@ -72,16 +84,53 @@ type Multiplexer struct {
 	// cmNode is the comet node which has been created. A reference is required in order to establish
 	// a local connection to it.
 	cmNode *node.Node
-	// versions is a list of versions which contain all embedded binaries.
+	// versions is a list of versions which contain all embedded and external binaries.
 	versions Versions
+	// embeddedVersions is the list of versions which contain the embedded binaries.
+	embeddedVersions Versions
+	// externalVersionsDir is the directory from which external versions are loaded.
+	// External versions are disabled if it is empty.
+	externalVersionsDir string
+	// versionsAppVersion is the app version for which the external versions were last loaded.
+	versionsAppVersion uint64
 	// conn is a grpc client connection and used when creating remote ABCI connections.
 	conn *grpc.ClientConn
 	// ctx is the context which is passed to the comet, grpc and api server starting functions.
 	ctx context.Context
 	// g is the waitgroup to which the comet, grpc and api server init functions are added to.
 	g *errgroup.Group
+	// serversCancel shuts down the gRPC and API servers of the native app. It
+	// is nil if they aren't running.
+	serversCancel context.CancelFunc
+	// servers tracks the gRPC and API servers of the native app until they are shut down.
+	servers sync.WaitGroup
 	// traceWriter is the trace writer for the multiplexer.
 	traceWriter io.WriteCloser
+	// metrics is the application telemetry. It is nil if telemetry is disabled.
+	metrics *telemetry.Metrics
+	// lastHeight is the height of the last finalized block.
+	lastHeight int64
+	// servedAppVersion is the app version that was served by the last call to getApp.
+	servedAppVersion uint64
+	// lastSwitch is the last switch between apps. It is nil if no switch occurred since the multiplexer started.
+	lastSwitch *SwitchStatus
+	// supervisorCfg configures the restarts of a crashed embedded app.
+	supervisorCfg appd.SupervisorConfig
+	// supervisor restarts the active embedded app if it crashes.
+	supervisor *appd.Supervisor
+	// fatalErr receives the error of a supervisor that gave up on the embedded app
+	// or of an embedded app that was restarted while a block was in flight.
+	fatalErr chan error
+	// blockInFlight is the height of the block that was finalized but not yet
+	// committed. It is zero if no block is in flight.
+	blockInFlight int64
+	// blockRestarts is the number of restarts of the embedded app when the
+	// block in flight was finalized.
+	blockRestarts uint64
+	// programArgs are the arguments passed to the embedded apps before their start args.
+	programArgs []string
+	// offline is true if the multiplexer was started without CometBFT, gRPC and API servers.
+	offline bool
 }
 
 // NewMultiplexer creates a new Multiplexer.
@@ -90,16 +139,38 @@ func NewMultiplexer(svrCtx *server.Context, svrCfg serverconfig.Config, clientCt
 		return nil, fmt.Errorf("invalid versions: %w", err)
 	}
 
+	externalVersionsDir := svrCtx.Viper.GetString(FlagExternalVersionsDir)
+	if externalVersionsDir != "" && !filepath.IsAbs(externalVersionsDir) {
+		externalVersionsDir = filepath.Join(svrCtx.Config.RootDir, externalVersionsDir)
+	}
+
+	supervisorCfg := appd.DefaultSupervisorConfig()
+	if svrCtx.Viper.IsSet(FlagMaxRestarts) {
+		supervisorCfg.MaxRestarts = svrCtx.Viper.GetInt(FlagMaxRestarts)
+	}
+	if svrCtx.Viper.IsSet(FlagRestartBackoff) {
+		supervisorCfg.InitialBackoff = svrCtx.Viper.GetDuration(FlagRestartBackoff)
+		supervisorCfg.MaxBackoff = max(supervisorCfg.MaxBackoff, supervisorCfg.InitialBackoff)
+	}
+	if err := supervisorCfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid embedded app restart config: %w", err)
+	}
+
 	mp := &Multiplexer{
-		svrCtx:        svrCtx,
-		svrCfg:        svrCfg,
-		clientContext: clientCtx,
-		appCreator:    appCreator,
-		logger:        svrCtx.Logger.With("multiplexer"),
-		nativeApp:     nil, // app will be initialized if required by the multiplexer.
-		versions:      versions,
-		chainID:       chainID,
-		appVersion:    applicationVersion,
+		svrCtx:              svrCtx,
+		svrCfg:              svrCfg,
+		clientContext:       clientCtx,
+		appCreator:          appCreator,
+		logger:              svrCtx.Logger.With("multiplexer"),
+		nativeApp:           nil, // app will be initialized if required by the multiplexer.
+		versions:            versions,
+		embeddedVersions:    versions,
+		externalVersionsDir: externalVersionsDir,
+		chainID:             chainID,
+		appVersion:          applicationVersion,
+		supervisorCfg:       supervisorCfg,
+		fatalErr:            make(chan error, 1),
+		programArgs:         removeStart(os.Args),
 	}
 
 	return mp, nil
@@ -123,12 +194,23 @@ func (m *Multiplexer) isGrpcOnly() bool {
 func (m *Multiplexer) Start() error {
 	m.g, m.ctx = getCtx(m.svrCtx, true)
 
+	// telemetry is started once as it registers the Prometheus sink globally.
+	metrics, err := startTelemetry(m.svrCfg)
+	if err != nil {
+		return err
+	}
+	m.metrics = metrics
+
 	emitServerInfoMetrics()
 
 	// startApp starts the underlying application, either native or embedded.
 	if err := m.startApp(); err != nil {
 		return err
 	}
+	m.servedAppVersion = m.appVersion
+	emitAppVersionMetrics(m.appVersion, m.isNativeApp())
+
+	m.startStatusServer()
 
 	if m.isGrpcOnly() {
 		m.logger.Info("starting node in gRPC only mode; CometBFT is disabled")
@@ -142,7 +224,7 @@ func (m *Multiplexer) Start() error {
 
 	if m.isEmbeddedApp() {
 		m.logger.Debug("using embedded app, not continuing with grpc or api servers")
-		return m.g.Wait()
+		return m.wait()
 	}
 
 	if err := m.enableGRPCAndAPIServers(m.nativeApp); err != nil {
@@ -151,7 +233,39 @@ func (m *Multiplexer) Start() error {
 
 	// wait for signal capture and gracefully return
 	// we are guaranteed to be waiting for the "ListenForQuitSignals" goroutine.
-	return m.g.Wait()
+	return m.wait()
+}
+
+// wait waits until a quit signal is captured or the embedded app can't be
+// kept running.
+func (m *Multiplexer) wait() error {
+	done := make(chan error, 1)
+	go func() {
+		done <- m.g.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case err := <-m.fatalErr:
+		return err
+	}
+}
+
+// StartOffline starts the app for the current app version without CometBFT
+// and without gRPC and API servers so that ABCI requests can be sent to the
+// multiplexer directly, e.g. to replay blocks. Embedded apps are started with
+// the given program args instead of the args of the running command.
+func (m *Multiplexer) StartOffline(programArgs []string) error {
+	m.g, m.ctx = getCtx(m.svrCtx, false)
+	m.offline = true
+	m.programArgs = programArgs
+
+	if err := m.startApp(); err != nil {
+		return err
+	}
+	m.servedAppVersion = m.appVersion
+	return nil
 }
 
 // enableGRPCAndAPIServers enables the gRPC and API servers for the provided application if configured to do so.
@@ -160,6 +274,8 @@ func (m *Multiplexer) enableGRPCAndAPIServers(app servertypes.Application) error
 	if app == nil {
 		return fmt.Errorf("unable to enable grpc and api servers, app is nil")
 	}
+	var serversCtx context.Context
+	serversCtx, m.serversCancel = context.WithCancel(m.ctx)
 	// if we are running natively and have specified to enable gRPC or API servers
 	// we need to register the relevant services.
 	if m.svrCfg.API.Enable || m.svrCfg.GRPC.Enable {
@@ -173,7 +289,7 @@ func (m *Multiplexer) enableGRPCAndAPIServers(app servertypes.Application) error
 	// startGRPCServer the grpc server in the case of a native app. If using an embedded app
 	// it will use that instead.
 	if m.svrCfg.GRPC.Enable {
-		grpcServer, clientContext, err := m.startGRPCServer()
+		grpcServer, clientContext, err := m.startGRPCServer(serversCtx)
 		if err != nil {
 			return err
 		}
@@ -182,12 +298,7 @@ func (m *Multiplexer) enableGRPCAndAPIServers(app servertypes.Application) error
 		// startAPIServer starts the api server for a native app. If using an embedded app
 		// it will use that instead.
 		if m.svrCfg.API.Enable {
-			metrics, err := startTelemetry(m.svrCfg)
-			if err != nil {
-				return err
-			}
-
-			if err := m.startAPIServer(grpcServer, metrics); err != nil {
+			if err := m.startAPIServer(serversCtx, grpcServer, m.metrics); err != nil {
 				return err
 			}
 		}
@@ -197,6 +308,8 @@ func (m *Multiplexer) enableGRPCAndAPIServers(app servertypes.Application) error
 
 // startApp starts either the native app, or an embedded app.
 func (m *Multiplexer) startApp() error {
+	m.loadExternalVersions()
+
 	// prepare correct version
 	currentVersion, err := m.versions.GetForAppVersion(m.appVersion)
 	if err != nil && errors.Is(err, ErrNoVersionFound) {
@@ -215,7 +328,7 @@ func (m *Multiplexer) startApp() error {
 	}
 
 	if currentVersion.Appd.IsStopped() {
-		programArgs := removeStart(os.Args)
+		programArgs := m.programArgs
 
 		// start an embedded app.
 		m.logger.Debug("starting embedded app", "app_version", currentVersion.AppVersion, "args", currentVersion.GetStartArgs(programArgs))
@@ -231,7 +344,12 @@ func (m *Multiplexer) startApp() error {
 		m.activeVersion = currentVersion
 	}
 
-	return m.initRemoteGrpcConn()
+	if err := m.initRemoteGrpcConn(); err != nil {
+		return err
+	}
+
+	m.superviseEmbeddedApp(currentVersion.Appd, currentVersion.GetStartArgs(m.programArgs))
+	return nil
 }
 
 // removeStart removes the first argument (the binary name) and the start argument from args.
@@ -289,7 +407,8 @@ func (m *Multiplexer) initRemoteGrpcConn() error {
 }
 
 // startGRPCServer initializes and starts a gRPC server if enabled in the configuration, returning the server and updated context.
-func (m *Multiplexer) startGRPCServer() (*grpc.Server, client.Context, error) {
+// The server is shut down once ctx is cancelled.
+func (m *Multiplexer) startGRPCServer(ctx context.Context) (*grpc.Server, client.Context, error) {
 	_, _, err := net.SplitHostPort(m.svrCfg.GRPC.Address)
 	if err != nil {
 		return nil, m.clientContext, err
@@ -334,14 +453,14 @@ func (m *Multiplexer) startGRPCServer() (*grpc.Server, client.Context, error) {
 	blockAPI := coregrpc.NewBlockAPI(coreEnv)
 	coregrpc.RegisterBlockAPIServer(grpcSrv, blockAPI)
 
-	m.g.Go(func() error {
-		return blockAPI.StartNewBlockEventListener(m.ctx)
+	m.goServer(func() error {
+		return blockAPI.StartNewBlockEventListener(ctx)
 	})
 
 	// Start the gRPC server in a goroutine. Note, the provided ctx will ensure
 	// that the server is gracefully shut down.
-	m.g.Go(func() error {
-		return servergrpc.StartGRPCServer(m.ctx, m.logger.With(log.ModuleKey, "grpc-server"), m.svrCfg.GRPC, grpcSrv)
+	m.goServer(func() error {
+		return servergrpc.StartGRPCServer(ctx, m.logger.With(log.ModuleKey, "grpc-server"), m.svrCfg.GRPC, grpcSrv)
 	})
 
 	m.conn = grpcClient
@@ -349,7 +468,8 @@ func (m *Multiplexer) startGRPCServer() (*grpc.Server, client.Context, error) {
 }
 
 // startAPIServer initializes and starts the API server, setting up routes, telemetry, and running it within an error group.
-func (m *Multiplexer) startAPIServer(grpcSrv *grpc.Server, metrics *telemetry.Metrics) error {
+// The server is shut down once ctx is cancelled.
+func (m *Multiplexer) startAPIServer(ctx context.Context, grpcSrv *grpc.Server, metrics *telemetry.Metrics) error {
 	if m.isEmbeddedApp() {
 		return fmt.Errorf("cannot start api server for embedded app")
 	}
@@ -364,12 +484,34 @@ func (m *Multiplexer) startAPIServer(grpcSrv *grpc.Server, metrics *telemetry.Me
 	}
 
 	m.logger.Debug("starting api server")
-	m.g.Go(func() error {
-		return apiSrv.Start(m.ctx, m.svrCfg)
+	m.goServer(func() error {
+		return apiSrv.Start(ctx, m.svrCfg)
 	})
 	return nil
 }
 
+// goServer runs a server of the native app within the error group and tracks
+// it until it is shut down.
+func (m *Multiplexer) goServer(run func() error) {
+	m.servers.Add(1)
+	m.g.Go(func() error {
+		defer m.servers.Done()
+		return run()
+	})
+}
+
+// stopNativeServers shuts down the gRPC and API servers of the native app and
+// waits until they have released their addresses.
+func (m *Multiplexer) stopNativeServers() {
+	if m.serversCancel == nil {
+		return
+	}
+	m.logger.Info("stopping gRPC and API servers of the native app")
+	m.serversCancel()
+	m.servers.Wait()
+	m.serversCancel = nil
+}
+
 // startNativeApp starts a native app.
 func (m *Multiplexer) startNativeApp() (servertypes.Application, error) {
 	traceWriter, err := getTraceWriter(m.svrCtx)
@@ -418,53 +560,177 @@ func openTraceWriter(traceWriterFile string) (w io.WriteCloser, err error) {
 }
 
 // getApp gets the appropriate app based on the latest application version.
+// If the embedded app is being restarted after a crash, it waits until the
+// app is running again without holding the lock, so that status queries and
+// ABCI calls that don't need the app aren't stalled meanwhile.
 func (m *Multiplexer) getApp() (servertypes.ABCI, error) {
+	m.reloadExternalVersions()
+
+	app, supervisor, appVersion, err := m.selectApp()
+	if err != nil {
+		return nil, err
+	}
+	if supervisor != nil {
+		if err := supervisor.WaitReady(m.ctx); err != nil {
+			return nil, fmt.Errorf("embedded app for version %d is not running: %w", appVersion, err)
+		}
+	}
+	return app, nil
+}
+
+// selectApp starts the appropriate app for the latest application version if
+// it isn't running yet and returns it. If it is an embedded app, the
+// supervisor that restarts it and its app version are returned too.
+func (m *Multiplexer) selectApp() (servertypes.ABCI, *appd.Supervisor, uint64, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.logger.Debug("getting app", "app_version", m.appVersion, "next_app_version", m.nextAppVersion)
 
+	switchStart := time.Now()
+	switchFrom := m.servedAppVersion
+	wasStarted := m.started
+	defer func() { m.servedAppVersion = m.appVersion }()
+
 	// get the appropriate version for the latest app version.
 	currentVersion, err := m.versions.GetForAppVersion(m.appVersion)
 	if err != nil {
 		// if we are switching from an embedded binary to a native one, we need to ensure that we stop it
 		// before we start the native app.
 		if err := m.stopEmbeddedApp(); err != nil {
-			return nil, fmt.Errorf("failed to stop embedded app: %w", err)
+			return nil, nil, 0, fmt.Errorf("failed to stop embedded app: %w", err)
 		}
 
 		if m.nativeApp == nil {
 			m.logger.Info("using latest app", "app_version", m.appVersion)
 			app, err := m.startNativeApp()
 			if err != nil {
-				return nil, fmt.Errorf("failed to start latest app: %w", err)
+				return nil, nil, 0, fmt.Errorf("failed to start latest app: %w", err)
 			}
 
 			// NOTE: we don't need to create a comet node as that will have been created when Start was called.
 
-			if err := m.enableGRPCAndAPIServers(app); err != nil {
-				return nil, fmt.Errorf("failed to enable gRPC and API servers: %w", err)
+			if !m.offline {
+				if err := m.enableGRPCAndAPIServers(app); err != nil {
+					return nil, nil, 0, fmt.Errorf("failed to enable gRPC and API servers: %w", err)
+				}
+			}
+
+			if wasStarted {
+				m.recordSwitch(switchFrom, switchStart)
 			}
 		}
 
-		return m.nativeApp, nil
+		return m.nativeApp, nil, 0, nil
 	}
 
 	// check if we need to start the app or if we have a different app running
 	if !m.started || currentVersion.AppVersion > m.activeVersion.AppVersion {
 		m.logger.Info("Using ABCI remote connection", "maximum_app_version", m.activeVersion.AppVersion, "abci_version", m.activeVersion.ABCIVersion.String(), "chain_id", m.chainID)
+		if m.isNativeApp() {
+			// an external version newer than the native app is being used.
+			// The embedded app serves the gRPC and API servers from now on.
+			if err := m.stopNativeAppForEmbeddedApp(); err != nil {
+				return nil, nil, 0, fmt.Errorf("failed to stop native app: %w", err)
+			}
+		}
 		if err := m.startEmbeddedApp(currentVersion); err != nil {
-			return nil, fmt.Errorf("failed to start embedded app: %w", err)
+			return nil, nil, 0, fmt.Errorf("failed to start embedded app: %w", err)
+		}
+		m.superviseEmbeddedApp(currentVersion.Appd, currentVersion.GetStartArgs(m.programArgs))
+
+		if wasStarted {
+			m.recordSwitch(switchFrom, switchStart)
 		}
 	}
 
 	switch m.activeVersion.ABCIVersion {
 	case ABCIClientVersion1:
-		return NewRemoteABCIClientV1(m.conn, m.chainID, m.appVersion), nil
+		return NewRemoteABCIClientV1(m.conn, m.chainID, m.appVersion), m.supervisor, m.activeVersion.AppVersion, nil
 	case ABCIClientVersion2:
-		return NewRemoteABCIClientV2(m.conn), nil
+		return NewRemoteABCIClientV2(m.conn), m.supervisor, m.activeVersion.AppVersion, nil
+	}
+
+	return nil, nil, 0, fmt.Errorf("unknown ABCI client version %d", m.activeVersion.ABCIVersion)
+}
+
+// stopNativeAppForEmbeddedApp shuts down the gRPC and API servers of the
+// native app so that the embedded app can serve them, closes the native app
+// and connects to the ABCI server address on which the embedded app will
+// listen.
+func (m *Multiplexer) stopNativeAppForEmbeddedApp() error {
+	m.stopNativeServers()
+	if err := m.stopNativeApp(); err != nil {
+		return err
+	}
+	m.nativeApp = nil
+	m.started = false
+
+	if err := m.stopGRPCConnection(); err != nil {
+		return err
+	}
+	return m.initRemoteGrpcConn()
+}
+
+// reloadExternalVersions reloads the external versions when the app version
+// changes so that a version staged while the node is running is picked up.
+// The binaries are read and hashed without holding the lock so that ABCI
+// calls and status queries aren't blocked meanwhile.
+func (m *Multiplexer) reloadExternalVersions() {
+	m.mu.Lock()
+	appVersion, stale := m.appVersion, m.appVersion != m.versionsAppVersion
+	m.mu.Unlock()
+	if !stale {
+		return
+	}
+
+	versions, ok := m.readExternalVersions()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.versionsAppVersion == appVersion {
+		// loaded concurrently
+		return
+	}
+	m.versionsAppVersion = appVersion
+	if ok {
+		m.versions = versions
 	}
+}
 
-	return nil, fmt.Errorf("unknown ABCI client version %d", m.activeVersion.ABCIVersion)
+// loadExternalVersions loads the external versions and combines them with the
+// embedded versions. The previous versions are kept if the external versions
+// are invalid.
+func (m *Multiplexer) loadExternalVersions() {
+	m.versionsAppVersion = m.appVersion
+	if versions, ok := m.readExternalVersions(); ok {
+		m.versions = versions
+	}
+}
+
+// readExternalVersions reads the external versions and returns them combined
+// with the embedded versions. It returns false if external versions are
+// disabled or invalid. It doesn't modify the multiplexer.
+func (m *Multiplexer) readExternalVersions() (Versions, bool) {
+	if m.externalVersionsDir == "" {
+		return nil, false
+	}
+
+	external, err := LoadExternalVersions(m.externalVersionsDir)
+	if err != nil {
+		m.logger.Error("failed to load external versions, keeping the current versions", "dir", m.externalVersionsDir, "err", err)
+		return nil, false
+	}
+
+	versions, err := m.embeddedVersions.WithExternal(external)
+	if err != nil {
+		m.logger.Error("invalid external versions, keeping the current versions", "dir", m.externalVersionsDir, "err", err)
+		return nil, false
+	}
+
+	for _, version := range external {
+		m.logger.Info("loaded external version", "app_version", version.AppVersion, "abci_version", version.ABCIVersion.String())
+	}
+	return versions, true
 }
 
 // startEmbeddedApp starts an embedded version of the app.
@@ -489,7 +755,7 @@ func (m *Multiplexer) startEmbeddedApp(version Version) error {
 		}
 
 		// start the new app
-		programArgs := removeStart(os.Args)
+		programArgs := m.programArgs
 
 		m.logger.Info("Starting app for version", "app_version", version.AppVersion, "args", version.GetStartArgs(programArgs))
 		if err := version.Appd.Start(version.GetStartArgs(programArgs)...); err != nil {
@@ -589,8 +855,108 @@ func (m *Multiplexer) stopNativeApp() error {
 	return nil
 }
 
+// superviseEmbeddedApp restarts the embedded app if it crashes until the
+// restart budget is exhausted.
+func (m *Multiplexer) superviseEmbeddedApp(app *appd.Appd, args []string) {
+	m.stopSupervisor()
+
+	conn := m.conn
+	supervisor := appd.NewSupervisor(app, args, m.supervisorCfg, m.logger.With(log.ModuleKey, "supervisor"), func(ctx context.Context) error {
+		return waitForRemoteApp(ctx, conn)
+	})
+	m.supervisor = supervisor
+
+	go func() {
+		if err := supervisor.Run(m.ctx); err != nil {
+			m.logger.Error("embedded app can't be kept running, shutting down", "err", err)
+			select {
+			case m.fatalErr <- fmt.Errorf("embedded app failed: %w", err):
+			default:
+			}
+		}
+	}()
+}
+
+// beginBlock records that the block at the height is being finalized so that
+// a restart of the embedded app before it is committed is detected.
+func (m *Multiplexer) beginBlock(height int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blockInFlight = height
+	m.blockRestarts = m.supervisorRestarts()
+}
+
+// endBlock records that the block in flight was committed.
+func (m *Multiplexer) endBlock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blockInFlight = 0
+}
+
+// checkBlockInFlight returns an error if the embedded app was restarted after
+// the block in flight was finalized. The restarted app lost the finalized
+// block and CometBFT only replays it on a handshake, so the error is also
+// reported as fatal to shut down the node, which replays the block once it is
+// started again.
+func (m *Multiplexer) checkBlockInFlight() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.blockInFlight == 0 || m.supervisorRestarts() == m.blockRestarts {
+		return nil
+	}
+	err := fmt.Errorf("embedded app was restarted while block %d was in flight, restart the node to replay it", m.blockInFlight)
+	select {
+	case m.fatalErr <- err:
+	default:
+	}
+	return err
+}
+
+// supervisorRestarts returns the number of restarts of the embedded app.
+func (m *Multiplexer) supervisorRestarts() uint64 {
+	if m.supervisor == nil {
+		return 0
+	}
+	return m.supervisor.Restarts()
+}
+
+// stopSupervisor stops restarting the embedded app.
+func (m *Multiplexer) stopSupervisor() {
+	if m.supervisor == nil {
+		return
+	}
+	m.supervisor.Stop()
+	m.supervisor = nil
+}
+
+// waitForRemoteApp waits until the gRPC connection to the embedded app is ready.
+func waitForRemoteApp(ctx context.Context, conn *grpc.ClientConn) error {
+	if conn == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, remoteAppTimeout)
+	defer cancel()
+
+	conn.ResetConnectBackoff()
+	for {
+		state := conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Idle:
+			conn.Connect()
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("remote app connection is %s: %w", state, ctx.Err())
+		}
+	}
+}
+
 // stopEmbeddedApp stops any embedded app versions if they are currently running.
 func (m *Multiplexer) stopEmbeddedApp() error {
+	// stop the supervisor first so that it doesn't restart the stopped app.
+	m.stopSupervisor()
 	if !m.embeddedVersionRunning() {
 		return nil
 	}
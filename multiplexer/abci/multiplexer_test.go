@@ -1,9 +1,17 @@
 package abci
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 )
 
 func TestOpenTraceWriter(t *testing.T) {
@@ -52,3 +60,76 @@ func TestRemoveStart(t *testing.T) {
 		require.Equal(t, test.want, got)
 	}
 }
+
+func TestStopNativeServers(t *testing.T) {
+	m := &Multiplexer{logger: log.NewNopLogger()}
+	m.g, m.ctx = errgroup.WithContext(context.Background())
+	var serversCtx context.Context
+	serversCtx, m.serversCancel = context.WithCancel(m.ctx)
+
+	var stopped atomic.Bool
+	m.goServer(func() error {
+		<-serversCtx.Done()
+		stopped.Store(true)
+		return nil
+	})
+
+	m.stopNativeServers()
+	require.True(t, stopped.Load())
+	require.Nil(t, m.serversCancel)
+	// the rest of the node keeps running
+	require.NoError(t, m.ctx.Err())
+
+	// stopping the servers again is a no-op
+	m.stopNativeServers()
+}
+
+func TestReloadExternalVersions(t *testing.T) {
+	m := &Multiplexer{logger: log.NewNopLogger(), appVersion: 3, versionsAppVersion: 2}
+	m.reloadExternalVersions()
+	require.EqualValues(t, 3, m.versionsAppVersion)
+	require.Empty(t, m.versions)
+}
+
+func TestCheckBlockInFlight(t *testing.T) {
+	dir := t.TempDir()
+	starts, crash := filepath.Join(dir, "starts"), filepath.Join(dir, "crash")
+	// the app crashes on its first start once the crash file exists and keeps
+	// running after it is restarted.
+	script := `#!/bin/sh
+if [ "$1" = "--help" ]; then exit 0; fi
+echo start >> ` + starts + `
+if [ "$(wc -l < ` + starts + `)" -gt 1 ]; then exec sleep 10; fi
+while [ ! -f ` + crash + ` ]; do sleep 0.01; done
+exit 1
+`
+	path := filepath.Join(dir, "celestia-appd")
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o755))
+	app, err := appd.NewFromPath("v1.0.0", path)
+	require.NoError(t, err)
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+
+	cfg := appd.SupervisorConfig{MaxRestarts: 1, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	m := &Multiplexer{logger: log.NewNopLogger(), fatalErr: make(chan error, 1)}
+	m.supervisor = appd.NewSupervisor(app, nil, cfg, log.NewNopLogger(), nil)
+	go func() { _ = m.supervisor.Run(context.Background()) }()
+	t.Cleanup(m.stopSupervisor)
+
+	// a block that is committed without a restart is fine.
+	m.beginBlock(1)
+	require.NoError(t, m.checkBlockInFlight())
+	m.endBlock()
+
+	m.beginBlock(2)
+	require.NoError(t, os.WriteFile(crash, nil, 0o600))
+	require.Eventually(t, func() bool { return m.supervisor.Restarts() == 1 }, 10*time.Second, 10*time.Millisecond)
+	require.Error(t, m.checkBlockInFlight())
+	require.Error(t, <-m.fatalErr)
+
+	// restarts between blocks don't affect the next block.
+	m.endBlock()
+	require.NoError(t, m.checkBlockInFlight())
+	m.beginBlock(3)
+	require.NoError(t, m.checkBlockInFlight())
+}
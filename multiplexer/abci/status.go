@@ -0,0 +1,175 @@
+package abci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+)
+
+const (
+	// FlagStatusAddress is the flag of the address on which the multiplexer serves its status.
+	FlagStatusAddress = "multiplexer-status-address"
+
+	// StatusPath is the HTTP path of the multiplexer status.
+	StatusPath = "/multiplexer/status"
+	// HealthPath is the HTTP path of the multiplexer health check.
+	HealthPath = "/multiplexer/health"
+)
+
+// Status is the status of the multiplexer.
+type Status struct {
+	// AppVersion is the app version that the multiplexer is serving.
+	AppVersion uint64 `json:"app_version"`
+	// Native is true if the native app is running and false if an embedded app is running.
+	Native bool `json:"native"`
+	// Embedded is the status of the embedded app if one is running.
+	Embedded *EmbeddedStatus `json:"embedded,omitempty"`
+	// RemoteABCIConnection is the state of the gRPC connection to the embedded app.
+	RemoteABCIConnection string `json:"remote_abci_connection,omitempty"`
+	// LastSwitch is the last switch between apps since the multiplexer started.
+	LastSwitch *SwitchStatus `json:"last_switch,omitempty"`
+}
+
+// EmbeddedStatus is the status of an embedded app.
+type EmbeddedStatus struct {
+	AppVersion uint64        `json:"app_version"`
+	Version    string        `json:"version"`
+	Running    bool          `json:"running"`
+	PID        int           `json:"pid,omitempty"`
+	Uptime     time.Duration `json:"uptime"`
+}
+
+// SwitchStatus describes a switch between apps.
+type SwitchStatus struct {
+	// Height is the height of the first block processed by the new app.
+	Height         int64         `json:"height"`
+	FromAppVersion uint64        `json:"from_app_version"`
+	ToAppVersion   uint64        `json:"to_app_version"`
+	Native         bool          `json:"native"`
+	Time           time.Time     `json:"time"`
+	Duration       time.Duration `json:"duration"`
+}
+
+// Healthy returns true if the app that serves the app version is running.
+func (s Status) Healthy() bool {
+	if s.Native {
+		return true
+	}
+	return s.Embedded != nil && s.Embedded.Running
+}
+
+// Status returns the status of the multiplexer.
+func (m *Multiplexer) Status() Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status := Status{
+		AppVersion: m.appVersion,
+		Native:     m.isNativeApp(),
+		LastSwitch: m.lastSwitch,
+	}
+	if m.activeVersion.Appd != nil {
+		status.Embedded = &EmbeddedStatus{
+			AppVersion: m.activeVersion.AppVersion,
+			Version:    m.activeVersion.Appd.Version(),
+			Running:    m.activeVersion.Appd.IsRunning(),
+			PID:        m.activeVersion.Appd.PID(),
+			Uptime:     m.activeVersion.Appd.Uptime(),
+		}
+		if m.conn != nil {
+			status.RemoteABCIConnection = m.conn.GetState().String()
+		}
+	}
+	return status
+}
+
+// recordSwitch records a switch between apps and emits its metrics.
+func (m *Multiplexer) recordSwitch(fromAppVersion uint64, start time.Time) {
+	m.lastSwitch = &SwitchStatus{
+		Height:         m.lastHeight + 1,
+		FromAppVersion: fromAppVersion,
+		ToAppVersion:   m.appVersion,
+		Native:         m.isNativeApp(),
+		Time:           start,
+		Duration:       time.Since(start),
+	}
+	m.logger.Info("switched app", "height", m.lastSwitch.Height, "from_app_version", fromAppVersion, "to_app_version", m.appVersion, "native", m.lastSwitch.Native, "duration", m.lastSwitch.Duration)
+
+	labels := []metrics.Label{
+		telemetry.NewLabel("from_app_version", strconv.FormatUint(fromAppVersion, 10)),
+		telemetry.NewLabel("to_app_version", strconv.FormatUint(m.appVersion, 10)),
+		telemetry.NewLabel("native", strconv.FormatBool(m.lastSwitch.Native)),
+	}
+	telemetry.IncrCounterWithLabels([]string{"multiplexer", "switch"}, 1, labels)
+	telemetry.SetGaugeWithLabels([]string{"multiplexer", "switch", "duration_ms"}, float32(m.lastSwitch.Duration.Milliseconds()), labels)
+	telemetry.SetGauge(float32(m.lastSwitch.Height), "multiplexer", "switch", "height")
+	emitAppVersionMetrics(m.appVersion, m.lastSwitch.Native)
+}
+
+// emitAppVersionMetrics emits the app version that is being served and
+// whether it is served by the native app.
+func emitAppVersionMetrics(appVersion uint64, native bool) {
+	telemetry.SetGauge(float32(appVersion), "multiplexer", "app_version")
+	nativeGauge := float32(0)
+	if native {
+		nativeGauge = 1
+	}
+	telemetry.SetGauge(nativeGauge, "multiplexer", "native")
+}
+
+// statusHandler returns the HTTP handler of the multiplexer status and health check.
+func (m *Multiplexer) statusHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(StatusPath, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, m.Status())
+	})
+	mux.HandleFunc(HealthPath, func(w http.ResponseWriter, _ *http.Request) {
+		status := m.Status()
+		code := http.StatusOK
+		if !status.Healthy() {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, status)
+	})
+	return mux
+}
+
+// startStatusServer serves the multiplexer status on the configured address
+// until the multiplexer context is done.
+func (m *Multiplexer) startStatusServer() {
+	address := m.svrCtx.Viper.GetString(FlagStatusAddress)
+	if address == "" {
+		return
+	}
+
+	srv := &http.Server{
+		Addr:              address,
+		Handler:           m.statusHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	m.logger.Info("starting multiplexer status server", "address", address)
+	m.g.Go(func() error {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	m.g.Go(func() error {
+		<-m.ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,82 @@
+package abci
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus(t *testing.T) {
+	m := &Multiplexer{logger: log.NewNopLogger(), appVersion: 3, lastHeight: 10}
+
+	// no app is running
+	status := m.Status()
+	require.False(t, status.Native)
+	require.Nil(t, status.Embedded)
+	require.False(t, status.Healthy())
+
+	binary := filepath.Join(t.TempDir(), "celestia-appd")
+	require.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\n[ \"$1\" = --help ] && exit 0\nexec sleep 10\n"), 0o755))
+	app, err := appd.NewFromPath("v3.0.0", binary)
+	require.NoError(t, err)
+	m.activeVersion = Version{AppVersion: 3, Appd: app}
+
+	status = m.Status()
+	require.Equal(t, &EmbeddedStatus{AppVersion: 3, Version: "v3.0.0"}, status.Embedded)
+	require.False(t, status.Healthy())
+
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { require.NoError(t, app.Stop()) })
+	status = m.Status()
+	require.True(t, status.Embedded.Running)
+	require.Positive(t, status.Embedded.PID)
+	require.True(t, status.Healthy())
+
+	m.appVersion = 4
+	m.recordSwitch(3, time.Now().Add(-time.Second))
+	status = m.Status()
+	require.EqualValues(t, 11, status.LastSwitch.Height)
+	require.EqualValues(t, 3, status.LastSwitch.FromAppVersion)
+	require.EqualValues(t, 4, status.LastSwitch.ToAppVersion)
+	require.GreaterOrEqual(t, status.LastSwitch.Duration, time.Second)
+}
+
+func TestStatusHandler(t *testing.T) {
+	m := &Multiplexer{logger: log.NewNopLogger(), appVersion: 5}
+	handler := m.statusHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HealthPath, nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	// a native app is healthy
+	m.nativeApp = nativeAppStub{}
+	m.lastSwitch = &SwitchStatus{Height: 100, FromAppVersion: 4, ToAppVersion: 5, Native: true}
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HealthPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, StatusPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status Status
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.EqualValues(t, 5, status.AppVersion)
+	require.True(t, status.Native)
+	require.Equal(t, m.lastSwitch.Height, status.LastSwitch.Height)
+}
+
+// nativeAppStub is a native app whose methods must not be called.
+type nativeAppStub struct {
+	servertypes.Application
+}
@@ -45,6 +45,8 @@ func (v Versions) Sorted() Versions {
 
 // GetForAppVersion returns the version for a given appVersion.
 // if the app version specified is lower than the minimum app version, return the lowest version.
+// if there is no version for an app version between the lowest and the highest version, e.g. because
+// the version after the native app has been staged as an external version, it returns ErrNoVersionFound.
 func (v Versions) GetForAppVersion(appVersion uint64) (Version, error) {
 	if len(v) == 0 {
 		return Version{}, fmt.Errorf("%w: %d", ErrNoVersionFound, appVersion)
@@ -64,8 +66,12 @@ func (v Versions) GetForAppVersion(appVersion uint64) (Version, error) {
 		}
 	}
 
-	// return the lowest version if the exact version is not found.
-	return lowestVersion, nil
+	// return the lowest version if the app version is lower than any version we have.
+	if appVersion < lowestVersion.AppVersion {
+		return lowestVersion, nil
+	}
+
+	return Version{}, fmt.Errorf("%w: %d", ErrNoVersionFound, appVersion)
 }
 
 // ShouldUseLatestApp returns true if there is no version found with the given appVersion.
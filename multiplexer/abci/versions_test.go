@@ -65,6 +65,17 @@ func TestGetForAppVersion(t *testing.T) {
 			expected:    Version{AppVersion: 4},
 			expectedErr: nil,
 		},
+		{
+			name: "app version between versions returns error",
+			versions: Versions{
+				{AppVersion: 3},
+				{AppVersion: 4},
+				{AppVersion: 7},
+			},
+			appVersion:  6,
+			expected:    Version{},
+			expectedErr: fmt.Errorf("%w: %d", ErrNoVersionFound, 6),
+		},
 	}
 
 	for _, tt := range tests {
@@ -114,6 +125,14 @@ func TestShouldUseLatestApp(t *testing.T) {
 			},
 			3, true,
 		},
+		{
+			"App version between versions",
+			Versions{
+				{AppVersion: 1},
+				{AppVersion: 3},
+			},
+			2, true,
+		},
 	}
 
 	for _, tt := range tests {
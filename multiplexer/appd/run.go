@@ -10,7 +10,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sync"
 	"syscall"
+	"time"
 )
 
 // Appd represents a celestia-appd binary.
@@ -23,8 +25,20 @@ type Appd struct {
 	stdin  io.Reader
 	stderr io.Writer
 	stdout io.Writer
-	// cmd is the started celestia-appd binary.
-	cmd *exec.Cmd
+
+	mu sync.Mutex
+	// proc is the last started celestia-appd process.
+	proc *process
+}
+
+// process is a started celestia-appd process.
+type process struct {
+	cmd       *exec.Cmd
+	startedAt time.Time
+	// exited is closed once cmd has exited.
+	exited chan struct{}
+	// err is the error returned by waiting for cmd. It is set before exited is closed.
+	err error
 }
 
 // New returns a new Appd instance.
@@ -56,6 +70,31 @@ func New(version string, compressedBinary []byte) (*Appd, error) {
 	return appd, nil
 }
 
+// NewFromPath returns a new Appd instance for a binary that is already on disk,
+// e.g. one staged by the node operator.
+func NewFromPath(version string, pathToBinary string) (*Appd, error) {
+	info, err := os.Stat(pathToBinary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat binary for version %s: %w", version, err)
+	}
+	if info.IsDir() || info.Mode()&0o111 == 0 {
+		return nil, fmt.Errorf("binary %s for version %s is not executable", pathToBinary, version)
+	}
+
+	if err := verifyBinaryIsExecutable(pathToBinary); err != nil {
+		return nil, fmt.Errorf("failed to verify binary is executable: %w", err)
+	}
+
+	appd := &Appd{
+		version: version,
+		path:    pathToBinary,
+		stdin:   os.Stdin,
+		stdout:  os.Stdout,
+		stderr:  os.Stderr,
+	}
+	return appd, nil
+}
+
 // Start starts the appd binary with the given arguments.
 func (a *Appd) Start(args ...string) error {
 	cmd := exec.Command(a.path, append([]string{"start"}, args...)...)
@@ -76,50 +115,105 @@ func (a *Appd) Start(args ...string) error {
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("failed to start %s: %w", a.path, err)
 	}
-	a.cmd = cmd
+
+	proc := &process{cmd: cmd, startedAt: time.Now(), exited: make(chan struct{})}
+	// wait for the process in the background so that an unexpected exit is detected.
+	go func() {
+		proc.err = cmd.Wait()
+		close(proc.exited)
+	}()
+
+	a.mu.Lock()
+	a.proc = proc
+	a.mu.Unlock()
 	return nil
 }
 
+// lastProcess returns the last started process or nil if none was started.
+func (a *Appd) lastProcess() *process {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.proc
+}
+
+// Exited returns a channel that is closed once the last started appd process
+// has exited. It returns nil if no process was started.
+func (a *Appd) Exited() <-chan struct{} {
+	proc := a.lastProcess()
+	if proc == nil {
+		return nil
+	}
+	return proc.exited
+}
+
+// ExitErr returns the error with which the last started appd process exited.
+// It returns nil if the process hasn't exited.
+func (a *Appd) ExitErr() error {
+	proc := a.lastProcess()
+	if proc == nil || !proc.hasExited() {
+		return nil
+	}
+	return proc.err
+}
+
+// Version returns the version of the celestia-appd binary.
+func (a *Appd) Version() string {
+	return a.version
+}
+
+// PID returns the process ID of the running appd process or 0 if it isn't running.
+func (a *Appd) PID() int {
+	proc := a.lastProcess()
+	if proc == nil || proc.hasExited() {
+		return 0
+	}
+	return proc.cmd.Process.Pid
+}
+
+// Uptime returns how long the running appd process has been running or 0 if
+// it isn't running.
+func (a *Appd) Uptime() time.Duration {
+	proc := a.lastProcess()
+	if proc == nil || proc.hasExited() {
+		return 0
+	}
+	return time.Since(proc.startedAt)
+}
+
 func (a *Appd) IsRunning() bool {
 	return !a.IsStopped()
 }
 
 func (a *Appd) IsStopped() bool {
 	// Never started or failed to start
-	if a.cmd == nil || a.cmd.Process == nil {
-		return true
-	}
-
-	// If ProcessState is not nil, it means Wait() was called and the process has finished
-	// (either by exiting normally or being terminated by a signal)
-	if a.cmd.ProcessState != nil {
+	proc := a.lastProcess()
+	if proc == nil {
 		return true
 	}
 
-	// ProcessState is nil, which means the process is still running
-	return false
+	// the process has finished (either by exiting normally or being terminated by a signal)
+	return proc.hasExited()
 }
 
 // Stop interrupts and then kills the running appd process if it exists and
 // waits for it to fully exit. If the process is not running, it returns nil.
 func (a *Appd) Stop() error {
-	if a.cmd == nil {
-		return nil
-	}
-	if a.cmd.Process == nil {
+	proc := a.lastProcess()
+	if proc == nil || proc.hasExited() {
 		return nil
 	}
 
-	err := a.cmd.Process.Signal(os.Interrupt)
+	err := proc.cmd.Process.Signal(os.Interrupt)
 	if err != nil {
 		log.Printf("Failed to send interrupt signal, attempting to kill: %v", err)
-		if err := a.cmd.Process.Kill(); err != nil {
-			return fmt.Errorf("failed to kill process with PID %d: %w", a.cmd.Process.Pid, err)
+		if err := proc.cmd.Process.Kill(); err != nil && !proc.hasExited() {
+			return fmt.Errorf("failed to kill process with PID %d: %w", proc.cmd.Process.Pid, err)
 		}
 	}
 
 	// Wait for the process to actually exit
-	if err := a.cmd.Wait(); err != nil {
+	<-proc.exited
+	if err := proc.err; err != nil {
 		log.Printf("Process finished with error: %v\n", err)
 	} else {
 		log.Printf("Process finished with no error\n")
@@ -127,6 +221,16 @@ func (a *Appd) Stop() error {
 	return nil
 }
 
+// hasExited returns true if the process has exited.
+func (p *process) hasExited() bool {
+	select {
+	case <-p.exited:
+		return true
+	default:
+		return false
+	}
+}
+
 // CreateExecCommand creates an exec.Cmd for the appd binary.
 func (a *Appd) CreateExecCommand(args ...string) *exec.Cmd {
 	cmd := exec.Command(a.path, args...)
@@ -0,0 +1,255 @@
+package appd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+// ErrRestartBudgetExhausted is returned by the supervisor when the appd
+// process exited more often than it may be restarted.
+var ErrRestartBudgetExhausted = errors.New("restart budget exhausted")
+
+// SupervisorConfig configures how the supervisor restarts an appd process
+// that exited unexpectedly.
+type SupervisorConfig struct {
+	// MaxRestarts is the number of consecutive restarts after which the
+	// supervisor gives up. Zero disables restarts.
+	MaxRestarts int
+	// InitialBackoff is the delay before the first restart. It doubles with
+	// every consecutive restart.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay before a restart.
+	MaxBackoff time.Duration
+	// ResetAfter is the uptime after which a process is considered healthy
+	// and the consecutive restarts are reset.
+	ResetAfter time.Duration
+}
+
+// DefaultSupervisorConfig returns the default supervisor config.
+func DefaultSupervisorConfig() SupervisorConfig {
+	return SupervisorConfig{
+		MaxRestarts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		ResetAfter:     10 * time.Minute,
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c SupervisorConfig) Validate() error {
+	if c.MaxRestarts < 0 {
+		return fmt.Errorf("max restarts must not be negative: %d", c.MaxRestarts)
+	}
+	if c.InitialBackoff <= 0 {
+		return fmt.Errorf("initial backoff must be positive: %s", c.InitialBackoff)
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("max backoff %s must not be less than the initial backoff %s", c.MaxBackoff, c.InitialBackoff)
+	}
+	return nil
+}
+
+// backoff returns the delay before the restart following the given number of
+// consecutive restarts.
+func (c SupervisorConfig) backoff(restarts int) time.Duration {
+	delay := c.InitialBackoff
+	for i := 0; i < restarts && delay < c.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, c.MaxBackoff)
+}
+
+// Supervisor restarts a started appd process when it exits unexpectedly.
+type Supervisor struct {
+	appd   *Appd
+	args   []string
+	cfg    SupervisorConfig
+	logger log.Logger
+	// onRestart is called after every restart, e.g. to wait for the
+	// connection to the restarted process.
+	onRestart func(ctx context.Context) error
+
+	mu sync.Mutex
+	// ready is closed while the process is running and replaced while it is
+	// being restarted.
+	ready chan struct{}
+	// stop is closed by Stop.
+	stop     chan struct{}
+	stopOnce sync.Once
+	// done is closed once Run returns.
+	done chan struct{}
+	// err is the error with which Run returned. It is set before done is closed.
+	err error
+	// restarts is the number of times the process was restarted.
+	restarts atomic.Uint64
+}
+
+// NewSupervisor returns a supervisor for the appd process, which must have
+// been started with args. onRestart may be nil.
+func NewSupervisor(appd *Appd, args []string, cfg SupervisorConfig, logger log.Logger, onRestart func(ctx context.Context) error) *Supervisor {
+	ready := make(chan struct{})
+	close(ready)
+	return &Supervisor{
+		appd:      appd,
+		args:      args,
+		cfg:       cfg,
+		logger:    logger,
+		onRestart: onRestart,
+		ready:     ready,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Run supervises the process until Stop is called or ctx is done, in which
+// case it returns nil. It returns an error wrapping ErrRestartBudgetExhausted
+// if the process can't be kept running.
+func (s *Supervisor) Run(ctx context.Context) error {
+	// cancel ctx on Stop so that onRestart returns.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err := s.run(ctx)
+	s.err = err
+	close(s.done)
+	return err
+}
+
+func (s *Supervisor) run(ctx context.Context) error {
+	restarts := 0
+	for {
+		select {
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-s.appd.Exited():
+		}
+
+		// the process may have exited because it is being stopped.
+		select {
+		case <-s.stop:
+			return nil
+		default:
+		}
+
+		s.setRestarting()
+		proc := s.appd.lastProcess()
+		exitErr := proc.err
+		if s.cfg.ResetAfter > 0 && time.Since(proc.startedAt) >= s.cfg.ResetAfter {
+			restarts = 0
+		}
+		if restarts >= s.cfg.MaxRestarts {
+			return fmt.Errorf("appd %s exited %d times in a row, last with %v: %w", s.appd.version, restarts+1, exitErr, ErrRestartBudgetExhausted)
+		}
+
+		delay := s.cfg.backoff(restarts)
+		restarts++
+		s.logger.Error("appd exited unexpectedly, restarting", "version", s.appd.version, "err", exitErr, "restart", restarts, "max_restarts", s.cfg.MaxRestarts, "backoff", delay)
+
+		select {
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+
+		if err := s.appd.Start(s.args...); err != nil {
+			// a process that failed to start is handled like a process that exited.
+			s.logger.Error("failed to restart appd", "version", s.appd.version, "err", err)
+			s.appd.setFailed(err)
+			continue
+		}
+
+		if s.onRestart != nil {
+			if err := s.onRestart(ctx); err != nil {
+				// a process that isn't reachable is stopped and restarted.
+				s.logger.Error("appd restarted but is not reachable", "version", s.appd.version, "err", err)
+				if err := s.appd.Stop(); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		s.logger.Info("appd restarted", "version", s.appd.version, "pid", s.appd.PID())
+		s.restarts.Add(1)
+		s.setReady()
+	}
+}
+
+// WaitReady blocks while the process is being restarted. It returns an error
+// if the supervisor gave up on the process or ctx is done first.
+func (s *Supervisor) WaitReady(ctx context.Context) error {
+	s.mu.Lock()
+	ready := s.ready
+	s.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-s.done:
+		if s.err != nil {
+			return s.err
+		}
+		return errors.New("supervisor stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Restarts returns the number of times the process was restarted. A change
+// tells the caller that the state the process held in memory was lost.
+func (s *Supervisor) Restarts() uint64 {
+	return s.restarts.Load()
+}
+
+// Stop stops supervising the process and waits for Run to return. It doesn't
+// stop the process.
+func (s *Supervisor) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+	<-s.done
+}
+
+func (s *Supervisor) setRestarting() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.ready:
+		s.ready = make(chan struct{})
+	default:
+	}
+}
+
+func (s *Supervisor) setReady() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.ready:
+	default:
+		close(s.ready)
+	}
+}
+
+// setFailed records a process that failed to start as an exited process.
+func (a *Appd) setFailed(err error) {
+	exited := make(chan struct{})
+	close(exited)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.proc = &process{startedAt: time.Now(), exited: exited, err: err}
+}
@@ -0,0 +1,93 @@
+package appd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupervisorConfigBackoff(t *testing.T) {
+	cfg := SupervisorConfig{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	require.Equal(t, time.Second, cfg.backoff(0))
+	require.Equal(t, 2*time.Second, cfg.backoff(1))
+	require.Equal(t, 4*time.Second, cfg.backoff(2))
+	require.Equal(t, 5*time.Second, cfg.backoff(3))
+	require.Equal(t, 5*time.Second, cfg.backoff(100))
+
+	require.NoError(t, DefaultSupervisorConfig().Validate())
+	require.Error(t, SupervisorConfig{MaxRestarts: -1, InitialBackoff: time.Second, MaxBackoff: time.Second}.Validate())
+	require.Error(t, SupervisorConfig{InitialBackoff: 0, MaxBackoff: time.Second}.Validate())
+	require.Error(t, SupervisorConfig{InitialBackoff: time.Second, MaxBackoff: time.Millisecond}.Validate())
+}
+
+func TestSupervisor(t *testing.T) {
+	cfg := SupervisorConfig{MaxRestarts: 2, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+	t.Run("restarts a crashed process", func(t *testing.T) {
+		// the process crashes on its first start and keeps running afterwards.
+		starts := filepath.Join(t.TempDir(), "starts")
+		app := newMockAppd(t, `echo start >> `+starts+`
+if [ "$(wc -l < `+starts+`)" -gt 1 ]; then exec sleep 10; fi
+exit 1`)
+		require.NoError(t, app.Start())
+
+		restarted := make(chan struct{}, 1)
+		supervisor := NewSupervisor(app, nil, cfg, log.NewNopLogger(), func(context.Context) error {
+			restarted <- struct{}{}
+			return nil
+		})
+		go func() { _ = supervisor.Run(context.Background()) }()
+
+		select {
+		case <-restarted:
+		case <-time.After(10 * time.Second):
+			t.Fatal("process was not restarted")
+		}
+		require.NoError(t, supervisor.WaitReady(context.Background()))
+		require.True(t, app.IsRunning())
+		require.EqualValues(t, 1, supervisor.Restarts())
+
+		// a stopped supervisor doesn't restart the process.
+		supervisor.Stop()
+		require.NoError(t, app.Stop())
+		require.True(t, app.IsStopped())
+	})
+
+	t.Run("gives up once the restart budget is exhausted", func(t *testing.T) {
+		app := newMockAppd(t, "exit 1")
+		require.NoError(t, app.Start())
+
+		supervisor := NewSupervisor(app, nil, cfg, log.NewNopLogger(), nil)
+		err := supervisor.Run(context.Background())
+		require.ErrorIs(t, err, ErrRestartBudgetExhausted)
+		require.ErrorContains(t, err, "exited 3 times in a row")
+		require.ErrorIs(t, supervisor.WaitReady(context.Background()), ErrRestartBudgetExhausted)
+	})
+
+	t.Run("stops and restarts an unreachable process", func(t *testing.T) {
+		app := newMockAppd(t, "exec sleep 10")
+		require.NoError(t, app.Start())
+		require.NoError(t, app.Stop())
+
+		supervisor := NewSupervisor(app, nil, cfg, log.NewNopLogger(), func(context.Context) error {
+			return errors.New("unreachable")
+		})
+		err := supervisor.Run(context.Background())
+		require.ErrorIs(t, err, ErrRestartBudgetExhausted)
+		require.True(t, app.IsStopped())
+	})
+}
+
+// newMockAppd returns an Appd for a script that runs the given shell commands.
+func newMockAppd(t *testing.T, script string) *Appd {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "celestia-appd")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o755))
+	return &Appd{version: "v1.0.0", path: path, stdout: os.Stdout, stderr: os.Stderr}
+}
@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"github.com/celestiaorg/celestia-app/v6/multiplexer/abci"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
 )
 
 // StartCommandHandler is the type that must implement the multiplexer to match Cosmos SDK start logic.
@@ -27,3 +29,11 @@ func New(versions abci.Versions) StartCommandHandler {
 		return start(versions, svrCtx, clientCtx, appCreator)
 	}
 }
+
+// AddFlags adds the multiplexer flags to the start command.
+func AddFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(abci.FlagExternalVersionsDir, "", "Directory from which external version binaries are loaded, each in a subdirectory with a manifest.json. Relative paths are relative to the home directory.")
+	startCmd.Flags().String(abci.FlagStatusAddress, "", "Address on which the multiplexer serves its status and health check over HTTP, e.g. 127.0.0.1:26662. Disabled if empty.")
+	startCmd.Flags().Int(abci.FlagMaxRestarts, appd.DefaultSupervisorConfig().MaxRestarts, "Number of consecutive restarts of a crashed embedded app after which the node shuts down")
+	startCmd.Flags().Duration(abci.FlagRestartBackoff, appd.DefaultSupervisorConfig().InitialBackoff, "Delay before the first restart of a crashed embedded app. It doubles with every consecutive restart")
+}
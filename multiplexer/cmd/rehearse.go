@@ -0,0 +1,335 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/abci"
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFromHeight = "from"
+	flagToHeight   = "to"
+	flagBlocksHome = "blocks-home"
+)
+
+// NewRehearseCmd creates a command that replays stored blocks through the
+// multiplexer without CometBFT to verify that the node transitions cleanly
+// between app versions, e.g. before a network upgrade.
+func NewRehearseCmd(versions abci.Versions, appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rehearse",
+		Short: "Replay stored blocks through the multiplexer to rehearse an upgrade",
+		Long: `Replay stored blocks through the multiplexer without CometBFT and p2p.
+
+The app state in the home directory is replayed block by block from the block
+store, switching between embedded and native apps like a running node would.
+The app hash, the results hash and the app version after every block are
+compared with the header of the next block. The replay stops at the first
+divergence and reports its height and reason.
+
+The replay modifies the app state, so always run it on a copy of the home
+directory of a stopped node. The blocks are read from --blocks-home, which
+defaults to the home directory and must contain the blocks up to --to + 1.`,
+		Example: `rehearse --home ~/rehearsal --blocks-home ~/.celestia-app --to 5000000`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svrCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			from, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagToHeight)
+			if err != nil {
+				return err
+			}
+			blocksHome, err := cmd.Flags().GetString(flagBlocksHome)
+			if err != nil {
+				return err
+			}
+			if blocksHome == "" {
+				blocksHome = svrCtx.Config.RootDir
+			}
+
+			svrCfg, err := getAndValidateConfig(svrCtx)
+			if err != nil {
+				return err
+			}
+			// getState closes the state store of the home directory, which may
+			// also be the state store that the blocks are replayed with.
+			chainID, appVersion, err := getState(svrCtx.Config)
+			if err != nil {
+				return fmt.Errorf("failed to get current app state: %w", err)
+			}
+
+			blocksDir := filepath.Join(blocksHome, "data")
+			blockStoreDB, err := dbm.NewDB("blockstore", dbm.BackendType(svrCtx.Config.DBBackend), blocksDir)
+			if err != nil {
+				return fmt.Errorf("failed to open block store: %w", err)
+			}
+			defer blockStoreDB.Close()
+			stateDB, err := dbm.NewDB("state", dbm.BackendType(svrCtx.Config.DBBackend), blocksDir)
+			if err != nil {
+				return fmt.Errorf("failed to open state store: %w", err)
+			}
+			defer stateDB.Close()
+
+			blockStore := store.NewBlockStore(blockStoreDB)
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+			state, err := stateStore.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load state: %w", err)
+			}
+
+			multiplexer, err := abci.NewMultiplexer(svrCtx, svrCfg, clientCtx, appCreator, versions, chainID, appVersion)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := multiplexer.Stop(); err != nil {
+					svrCtx.Logger.Error("failed to stop multiplexer", "err", err)
+				}
+			}()
+
+			programArgs := []string{"--home", svrCtx.Config.RootDir, "--grpc.enable=false", "--api.enable=false"}
+			if err := multiplexer.StartOffline(programArgs); err != nil {
+				return fmt.Errorf("failed to start multiplexer: %w", err)
+			}
+
+			r := &rehearsal{
+				app:           multiplexer,
+				blocks:        blockStore,
+				validators:    stateStore,
+				initialHeight: max(state.InitialHeight, 1),
+				logger:        svrCtx.Logger,
+			}
+			report, err := r.run(cmd.Context(), from, to)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(report.String())
+			if report.Divergence != nil {
+				return fmt.Errorf("replay diverged at height %d", report.Divergence.Height)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "First height to replay. Defaults to the height after the last height of the app state, which is the only height the replay can start at")
+	cmd.Flags().Int64(flagToHeight, 0, "Last height to replay. Defaults to the height before the last stored block")
+	cmd.Flags().String(flagBlocksHome, "", "Home directory with the block store and state store to replay blocks from. Defaults to --home")
+	cmd.Flags().String(abci.FlagExternalVersionsDir, "", "Directory from which external version binaries are loaded, e.g. to rehearse an upgrade to a staged version")
+	return cmd
+}
+
+// blockSource is the store from which blocks are replayed.
+type blockSource interface {
+	Base() int64
+	Height() int64
+	LoadBlock(height int64) *cmttypes.Block
+}
+
+// validatorSource is the store of the validator sets that decided blocks.
+type validatorSource interface {
+	LoadValidators(height int64) (*cmttypes.ValidatorSet, error)
+}
+
+// rehearsal replays blocks through an ABCI application.
+type rehearsal struct {
+	app           abcitypes.Application
+	blocks        blockSource
+	validators    validatorSource
+	initialHeight int64
+	logger        log.Logger
+}
+
+// rehearsalReport is the result of a rehearsal.
+type rehearsalReport struct {
+	FromHeight int64
+	// ToHeight is the last height that was replayed.
+	ToHeight int64
+	// Switches are the app version changes during the replay.
+	Switches []appVersionSwitch
+	// Divergence is the first divergence from the stored blocks, if any.
+	Divergence *divergence
+}
+
+type appVersionSwitch struct {
+	Height         int64
+	FromAppVersion uint64
+	ToAppVersion   uint64
+}
+
+type divergence struct {
+	Height  int64
+	Reasons []string
+}
+
+func (r rehearsalReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "replayed heights %d to %d\n", r.FromHeight, r.ToHeight)
+	for _, s := range r.Switches {
+		fmt.Fprintf(&sb, "app version %d -> %d at height %d\n", s.FromAppVersion, s.ToAppVersion, s.Height)
+	}
+	if r.Divergence == nil {
+		sb.WriteString("no divergence")
+	} else {
+		fmt.Fprintf(&sb, "DIVERGENCE at height %d: %s", r.Divergence.Height, strings.Join(r.Divergence.Reasons, "; "))
+	}
+	return sb.String()
+}
+
+// run replays the blocks from height from to height to. If from is 0 the
+// replay starts after the last height of the app. If to is 0 the replay ends
+// before the last stored block, which is needed to check the last replayed
+// block.
+func (r *rehearsal) run(ctx context.Context, from, to int64) (rehearsalReport, error) {
+	info, err := r.app.Info(ctx, &abcitypes.RequestInfo{})
+	if err != nil {
+		return rehearsalReport{}, fmt.Errorf("failed to get app info: %w", err)
+	}
+	if from == 0 {
+		from = info.LastBlockHeight + 1
+	}
+	if from != info.LastBlockHeight+1 {
+		return rehearsalReport{}, fmt.Errorf("the app state is at height %d so the replay must start at height %d, not %d", info.LastBlockHeight, info.LastBlockHeight+1, from)
+	}
+	if to == 0 {
+		to = r.blocks.Height() - 1
+	}
+	if from < r.blocks.Base() || to >= r.blocks.Height() {
+		return rehearsalReport{}, fmt.Errorf("heights %d to %d can't be replayed with blocks %d to %d: the block after the last replayed height is needed", from, to, r.blocks.Base(), r.blocks.Height())
+	}
+	if to < from {
+		return rehearsalReport{}, fmt.Errorf("nothing to replay from height %d to %d", from, to)
+	}
+
+	block := r.blocks.LoadBlock(from)
+	if block == nil {
+		return rehearsalReport{}, fmt.Errorf("block %d not found", from)
+	}
+	report := rehearsalReport{FromHeight: from, ToHeight: from - 1}
+	if info.LastBlockHeight > 0 && !bytes.Equal(info.LastBlockAppHash, block.AppHash) {
+		report.Divergence = &divergence{
+			Height:  info.LastBlockHeight,
+			Reasons: []string{fmt.Sprintf("app state has app hash %X but block %d expects %X", info.LastBlockAppHash, from, block.AppHash)},
+		}
+		return report, nil
+	}
+
+	appVersion := block.Version.App
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		next := r.blocks.LoadBlock(height + 1)
+		if next == nil {
+			return report, fmt.Errorf("block %d not found", height+1)
+		}
+
+		nextAppVersion, reasons := r.replayBlock(ctx, block, next, appVersion)
+		if len(reasons) > 0 {
+			report.Divergence = &divergence{Height: height, Reasons: reasons}
+			return report, nil
+		}
+		if nextAppVersion != appVersion {
+			report.Switches = append(report.Switches, appVersionSwitch{Height: height + 1, FromAppVersion: appVersion, ToAppVersion: nextAppVersion})
+			r.logger.Info("app version changed", "height", height+1, "from_app_version", appVersion, "to_app_version", nextAppVersion)
+		}
+
+		report.ToHeight = height
+		if height%1000 == 0 {
+			r.logger.Info("replayed block", "height", height, "to", to)
+		}
+		appVersion = nextAppVersion
+		block = next
+	}
+	return report, nil
+}
+
+// replayBlock finalizes and commits block and compares the results with the
+// header of the next block. It returns the app version of the next block and
+// the reasons why the results diverge from the next block, if any.
+func (r *rehearsal) replayBlock(ctx context.Context, block, next *cmttypes.Block, appVersion uint64) (nextAppVersion uint64, reasons []string) {
+	req, err := r.finalizeBlockRequest(block)
+	if err != nil {
+		return 0, []string{err.Error()}
+	}
+
+	resp, err := r.app.FinalizeBlock(ctx, req)
+	if err != nil {
+		return 0, []string{fmt.Sprintf("FinalizeBlock failed: %v", err)}
+	}
+	if len(resp.TxResults) != len(block.Txs) {
+		return 0, []string{fmt.Sprintf("FinalizeBlock returned %d tx results for %d txs", len(resp.TxResults), len(block.Txs))}
+	}
+	if _, err := r.app.Commit(ctx, &abcitypes.RequestCommit{}); err != nil {
+		return 0, []string{fmt.Sprintf("Commit failed: %v", err)}
+	}
+
+	if !bytes.Equal(resp.AppHash, next.AppHash) {
+		reasons = append(reasons, fmt.Sprintf("app hash %X doesn't match app hash %X of block %d", resp.AppHash, next.AppHash, next.Height))
+	}
+	if resultsHash := cmttypes.NewResults(resp.TxResults).Hash(); !bytes.Equal(resultsHash, next.LastResultsHash) {
+		reasons = append(reasons, fmt.Sprintf("results hash %X doesn't match last results hash %X of block %d", resultsHash, next.LastResultsHash, next.Height))
+	}
+
+	nextAppVersion = appVersion
+	if resp.ConsensusParamUpdates != nil && resp.ConsensusParamUpdates.GetVersion() != nil {
+		nextAppVersion = resp.ConsensusParamUpdates.GetVersion().App
+	}
+	if nextAppVersion != next.Version.App {
+		reasons = append(reasons, fmt.Sprintf("app version %d doesn't match app version %d of block %d", nextAppVersion, next.Version.App, next.Height))
+	}
+	return nextAppVersion, reasons
+}
+
+// finalizeBlockRequest returns the request with which CometBFT finalizes the
+// block.
+func (r *rehearsal) finalizeBlockRequest(block *cmttypes.Block) (req *abcitypes.RequestFinalizeBlock, err error) {
+	var commitInfo abcitypes.CommitInfo
+	if block.Height != r.initialHeight {
+		lastValSet, err := r.validators.LoadValidators(block.Height - 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load validator set at height %d: %w", block.Height-1, err)
+		}
+		// BuildLastCommitInfo panics if the commit doesn't match the validator set.
+		defer func() {
+			if rec := recover(); rec != nil {
+				err = errors.New(fmt.Sprint(rec))
+			}
+		}()
+		commitInfo = sm.BuildLastCommitInfo(block, lastValSet, r.initialHeight)
+	}
+
+	pbHeader := block.Header.ToProto()
+	return &abcitypes.RequestFinalizeBlock{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  commitInfo,
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+		Header:             pbHeader,
+	}, nil
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRehearsal(t *testing.T) {
+	// the app version changes from 1 to 2 after block 3.
+	const switchHeight = 3
+	blocks := newMockBlocks(t, 6, switchHeight)
+	validators := mockValidators{cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10)})}
+
+	t.Run("replays all blocks", func(t *testing.T) {
+		r := &rehearsal{app: &mockApp{switchHeight: switchHeight}, blocks: blocks, validators: validators, initialHeight: 1, logger: log.NewNopLogger()}
+		report, err := r.run(context.Background(), 0, 0)
+		require.NoError(t, err)
+		require.Nil(t, report.Divergence)
+		require.EqualValues(t, 1, report.FromHeight)
+		require.EqualValues(t, 5, report.ToHeight)
+		require.Equal(t, []appVersionSwitch{{Height: 4, FromAppVersion: 1, ToAppVersion: 2}}, report.Switches)
+	})
+
+	t.Run("reports the first divergence", func(t *testing.T) {
+		// the app doesn't switch to the next app version.
+		r := &rehearsal{app: &mockApp{}, blocks: blocks, validators: validators, initialHeight: 1, logger: log.NewNopLogger()}
+		report, err := r.run(context.Background(), 1, 5)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, report.ToHeight)
+		require.EqualValues(t, 3, report.Divergence.Height)
+		require.Len(t, report.Divergence.Reasons, 1)
+		require.Contains(t, report.Divergence.Reasons[0], "app version 1 doesn't match app version 2 of block 4")
+		require.Contains(t, report.String(), "DIVERGENCE at height 3")
+	})
+
+	t.Run("reports a different app hash", func(t *testing.T) {
+		tampered := newMockBlocks(t, 6, switchHeight)
+		tampered.blocks[5].AppHash = []byte("tampered")
+		r := &rehearsal{app: &mockApp{switchHeight: switchHeight}, blocks: tampered, validators: validators, initialHeight: 1, logger: log.NewNopLogger()}
+		report, err := r.run(context.Background(), 0, 0)
+		require.NoError(t, err)
+		require.EqualValues(t, 4, report.Divergence.Height)
+		require.Contains(t, report.Divergence.Reasons[0], "doesn't match app hash 74616D7065726564 of block 5")
+	})
+
+	t.Run("starts after the height of the app", func(t *testing.T) {
+		app := &mockApp{switchHeight: switchHeight}
+		r := &rehearsal{app: app, blocks: blocks, validators: validators, initialHeight: 1, logger: log.NewNopLogger()}
+		_, err := r.run(context.Background(), 2, 0)
+		require.ErrorContains(t, err, "the app state is at height 0 so the replay must start at height 1, not 2")
+
+		_, err = r.run(context.Background(), 1, 6)
+		require.ErrorContains(t, err, "the block after the last replayed height is needed")
+
+		report, err := r.run(context.Background(), 0, 2)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, report.ToHeight)
+		report, err = r.run(context.Background(), 0, 0)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, report.FromHeight)
+		require.Len(t, report.Switches, 1)
+
+		// an app whose state doesn't match the blocks can't be replayed.
+		app = &mockApp{switchHeight: switchHeight, height: 2, appHash: []byte("wrong")}
+		r.app = app
+		report, err = r.run(context.Background(), 0, 0)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, report.Divergence.Height)
+	})
+}
+
+// mockApp is an app whose app hash is the hash of the previous app hash, the
+// height and the txs. It switches to app version 2 after switchHeight if
+// switchHeight is positive.
+type mockApp struct {
+	abcitypes.BaseApplication
+	switchHeight int64
+	height       int64
+	appHash      []byte
+	pending      []byte
+}
+
+func (a *mockApp) Info(context.Context, *abcitypes.RequestInfo) (*abcitypes.ResponseInfo, error) {
+	return &abcitypes.ResponseInfo{LastBlockHeight: a.height, LastBlockAppHash: a.appHash}, nil
+}
+
+func (a *mockApp) FinalizeBlock(_ context.Context, req *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error) {
+	h := sha256.New()
+	h.Write(a.appHash)
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(req.Height)))
+	resp := &abcitypes.ResponseFinalizeBlock{}
+	for _, tx := range req.Txs {
+		h.Write(tx)
+		resp.TxResults = append(resp.TxResults, &abcitypes.ExecTxResult{Data: tx})
+	}
+	if a.switchHeight > 0 && req.Height == a.switchHeight {
+		resp.ConsensusParamUpdates = &cmtproto.ConsensusParams{Version: &cmtproto.VersionParams{App: 2}}
+	}
+	a.pending = h.Sum(nil)
+	resp.AppHash = a.pending
+	return resp, nil
+}
+
+func (a *mockApp) Commit(context.Context, *abcitypes.RequestCommit) (*abcitypes.ResponseCommit, error) {
+	a.height++
+	a.appHash = a.pending
+	return &abcitypes.ResponseCommit{}, nil
+}
+
+// mockBlocks is a block store with the blocks 1 to n.
+type mockBlocks struct {
+	blocks map[int64]*cmttypes.Block
+	height int64
+}
+
+// newMockBlocks returns the blocks that the mock app with the given switch
+// height produces.
+func newMockBlocks(t *testing.T, n int64, switchHeight int64) *mockBlocks {
+	t.Helper()
+	app := &mockApp{switchHeight: switchHeight}
+	blocks := &mockBlocks{blocks: make(map[int64]*cmttypes.Block), height: n}
+
+	appVersion := uint64(1)
+	var lastResultsHash []byte
+	for height := int64(1); height <= n; height++ {
+		block := &cmttypes.Block{
+			Header: cmttypes.Header{Height: height, AppHash: app.appHash, LastResultsHash: lastResultsHash},
+			Data:   cmttypes.Data{Txs: cmttypes.Txs{[]byte{byte(height)}}},
+		}
+		block.Version.App = appVersion
+		if height > 1 {
+			block.LastCommit = &cmttypes.Commit{Height: height - 1, Signatures: []cmttypes.CommitSig{{BlockIDFlag: cmttypes.BlockIDFlagCommit}}}
+		}
+		blocks.blocks[height] = block
+
+		resp, err := app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{Height: height, Txs: block.Txs.ToSliceOfBytes()})
+		require.NoError(t, err)
+		_, err = app.Commit(context.Background(), nil)
+		require.NoError(t, err)
+		lastResultsHash = cmttypes.NewResults(resp.TxResults).Hash()
+		if resp.ConsensusParamUpdates != nil {
+			appVersion = resp.ConsensusParamUpdates.Version.App
+		}
+	}
+	return blocks
+}
+
+func (b *mockBlocks) Base() int64   { return 1 }
+func (b *mockBlocks) Height() int64 { return b.height }
+
+func (b *mockBlocks) LoadBlock(height int64) *cmttypes.Block {
+	return b.blocks[height]
+}
+
+type mockValidators struct {
+	valSet *cmttypes.ValidatorSet
+}
+
+func (v mockValidators) LoadValidators(int64) (*cmttypes.ValidatorSet, error) {
+	return v.valSet, nil
+}
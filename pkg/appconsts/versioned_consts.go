@@ -17,7 +17,8 @@ func GetSquareSizeUpperBound(_ string) int {
 }
 
 // GetUpgradeHeightDelay returns the delay in blocks after a quorum has been
-// reached that the chain should upgrade to the new version.
+// reached that the chain should upgrade to the new version. It is the default
+// for chains whose x/signal params don't set an upgrade height delay.
 func GetUpgradeHeightDelay(chainID string) int64 {
 	if chainID == TestChainID {
 		return TestUpgradeHeightDelay
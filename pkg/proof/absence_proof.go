@@ -0,0 +1,196 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/da"
+	"github.com/celestiaorg/celestia-app/v6/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ErrNamespacePresent is returned when creating an absence proof for a
+// namespace that has shares in the square.
+var ErrNamespacePresent = errors.New("namespace is present in the square")
+
+// NewNamespaceAbsenceProof takes an ODS, extends it, then returns a proof to
+// the data root that the square contains no shares of the namespace.
+func NewNamespaceAbsenceProof(dataSquare square.Square, namespace share.Namespace) (NamespaceAbsenceProof, error) {
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+	return NewNamespaceAbsenceProofFromEDS(eds, namespace)
+}
+
+// NewNamespaceAbsenceProofFromEDS takes an extended data square and returns a
+// proof to the data root that the square contains no shares of the namespace.
+// The proof covers the row whose namespace range contains the namespace or, if
+// there is none, the rows directly before and after the namespace. Returns
+// ErrNamespacePresent if the square contains shares of the namespace.
+func NewNamespaceAbsenceProofFromEDS(eds *rsmt2d.ExtendedDataSquare, namespace share.Namespace) (NamespaceAbsenceProof, error) {
+	squareSize := square.Size(len(eds.FlattenedODS()))
+
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+
+	startRow, endRow := absenceRowRange(edsRowRoots[:squareSize], namespace)
+	rowProof := newRowProof(edsRowRoots, edsColRoots, startRow, endRow)
+
+	nmtProofs := make([]*NMTProof, 0, endRow-startRow+1)
+	for i := startRow; i <= endRow; i++ {
+		// we have to re-create the tree as the eds one is not accessible.
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(i))
+		for _, sh := range eds.Row(uint(i)) {
+			if err := tree.Push(sh); err != nil {
+				return NamespaceAbsenceProof{}, err
+			}
+		}
+		root, err := tree.Root()
+		if err != nil {
+			return NamespaceAbsenceProof{}, err
+		}
+		if !bytes.Equal(edsRowRoots[i], root) {
+			return NamespaceAbsenceProof{}, errors.New("eds row root is different than tree root")
+		}
+
+		proof, err := tree.ProveNamespace(namespace.Bytes())
+		if err != nil {
+			return NamespaceAbsenceProof{}, err
+		}
+		if !proof.IsEmptyProof() && !proof.IsOfAbsence() {
+			return NamespaceAbsenceProof{}, fmt.Errorf("%w: row %d", ErrNamespacePresent, i)
+		}
+		nmtProofs = append(nmtProofs, &NMTProof{
+			Start:    int32(proof.Start()),
+			End:      int32(proof.End()),
+			Nodes:    proof.Nodes(),
+			LeafHash: proof.LeafHash(),
+		})
+	}
+
+	return NamespaceAbsenceProof{
+		NmtProofs:        nmtProofs,
+		NamespaceId:      namespace.ID(),
+		NamespaceVersion: uint32(namespace.Version()),
+		RowProof:         rowProof,
+	}, nil
+}
+
+// absenceRowRange returns the range of rows, inclusive on both ends, that an
+// absence proof of the namespace has to cover. Rows of the ODS are sorted by
+// namespace so at most one row can have the namespace within its range if the
+// namespace is absent.
+func absenceRowRange(rowRoots [][]byte, namespace share.Namespace) (int, int) {
+	for i, root := range rowRoots {
+		if bytes.Compare(namespace.Bytes(), minNamespace(root)) < 0 {
+			if i == 0 {
+				return 0, 0
+			}
+			if bytes.Compare(namespace.Bytes(), maxNamespace(rowRoots[i-1])) <= 0 {
+				return i - 1, i - 1
+			}
+			return i - 1, i
+		}
+	}
+	last := len(rowRoots) - 1
+	return last, last
+}
+
+// Validate runs basic validations on the proof then verifies that it proves
+// the absence of the namespace from the square with the data root. It returns
+// nil if the proof is valid. Otherwise, it returns a sensible error.
+func (ap NamespaceAbsenceProof) Validate(root []byte) error {
+	if ap.RowProof == nil {
+		return errors.New("empty row proof")
+	}
+	// RowProof.Validate computes the number of rows with unsigned integers
+	// which wrap around if EndRow is before StartRow.
+	if ap.RowProof.EndRow < ap.RowProof.StartRow {
+		return fmt.Errorf("end row %d must not be before start row %d", ap.RowProof.EndRow, ap.RowProof.StartRow)
+	}
+	if len(ap.RowProof.Proofs) == 0 || len(ap.NmtProofs) == 0 {
+		return errors.New("the proof must contain at least one row")
+	}
+	if len(ap.NmtProofs) != len(ap.RowProof.RowRoots) {
+		return fmt.Errorf("the number of NMT proofs %d must equal the number of row roots %d", len(ap.NmtProofs), len(ap.RowProof.RowRoots))
+	}
+	if ap.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("namespace version %d must fit in a byte", ap.NamespaceVersion)
+	}
+	if err := ap.RowProof.Validate(root); err != nil {
+		return err
+	}
+
+	// the indexes of the Merkle proofs must match the rows as the rows
+	// before and after them are assumed to not contain the namespace.
+	for i, proof := range ap.RowProof.Proofs {
+		if proof.Index != int64(ap.RowProof.StartRow)+int64(i) {
+			return fmt.Errorf("row proof %d has index %d, expected %d", i, proof.Index, int64(ap.RowProof.StartRow)+int64(i))
+		}
+	}
+	// the data root commits to 2*squareSize row roots and as many column
+	// roots.
+	lastRow := ap.RowProof.Proofs[len(ap.RowProof.Proofs)-1].Total/4 - 1
+
+	namespace := append([]byte{uint8(ap.NamespaceVersion)}, ap.NamespaceId...)
+	firstRoot := ap.RowProof.RowRoots[0]
+	if ap.RowProof.StartRow != 0 && bytes.Compare(minNamespace(firstRoot), namespace) >= 0 {
+		return errors.New("namespace could be in a row before the proven rows")
+	}
+	lastRoot := ap.RowProof.RowRoots[len(ap.RowProof.RowRoots)-1]
+	if int64(ap.RowProof.EndRow) != lastRow && bytes.Compare(maxNamespace(lastRoot), namespace) <= 0 {
+		return errors.New("namespace could be in a row after the proven rows")
+	}
+
+	if !ap.VerifyProof() {
+		return errors.New("namespace absence proof failed to verify")
+	}
+	return nil
+}
+
+// VerifyProof verifies that each NMT proof proves the absence of the
+// namespace from its row root. Returns true if all proofs are valid.
+func (ap NamespaceAbsenceProof) VerifyProof() bool {
+	if ap.NamespaceVersion > math.MaxUint8 {
+		return false
+	}
+	namespace := append([]byte{uint8(ap.NamespaceVersion)}, ap.NamespaceId...)
+	for i, proof := range ap.NmtProofs {
+		nmtProof := nmt.NewAbsenceProof(
+			int(proof.Start),
+			int(proof.End),
+			proof.Nodes,
+			proof.LeafHash,
+			true,
+		)
+		// a non empty proof without a leaf hash proves inclusion.
+		if !nmtProof.IsEmptyProof() && !nmtProof.IsOfAbsence() {
+			return false
+		}
+		if !nmtProof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespace, nil, ap.RowProof.RowRoots[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func minNamespace(root []byte) []byte {
+	return nmt.MinNamespace(root, share.NamespaceSize)
+}
+
+func maxNamespace(root []byte) []byte {
+	return nmt.MaxNamespace(root, share.NamespaceSize)
+}
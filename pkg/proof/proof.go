@@ -95,19 +95,7 @@ func NewShareInclusionProofFromEDS(
 		return ShareProof{}, err
 	}
 
-	// create the binary merkle inclusion proof for all the square rows to the data root
-	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
-	rowProofs := make([]*Proof, endRow-startRow+1)
-	rowRoots := make([][]byte, endRow-startRow+1)
-	for i := startRow; i <= endRow; i++ {
-		rowProofs[i-startRow] = &Proof{
-			Total:    allProofs[i].Total,
-			Index:    allProofs[i].Index,
-			LeafHash: allProofs[i].LeafHash,
-			Aunts:    allProofs[i].Aunts,
-		}
-		rowRoots[i-startRow] = edsRowRoots[i]
-	}
+	rowProof := newRowProof(edsRowRoots, edsColRoots, startRow, endRow)
 
 	// get the extended rows containing the shares.
 	rows := make([][]share.Share, endRow-startRow+1)
@@ -119,17 +107,12 @@ func NewShareInclusionProofFromEDS(
 		rows[i-startRow] = shares
 	}
 
-	shareProofs, rawShares, err := CreateShareToRowRootProofs(squareSize, rows, rowRoots, startLeaf, endLeaf)
+	shareProofs, rawShares, err := CreateShareToRowRootProofs(squareSize, rows, rowProof.RowRoots, startLeaf, endLeaf)
 	if err != nil {
 		return ShareProof{}, err
 	}
 	return ShareProof{
-		RowProof: &RowProof{
-			RowRoots: rowRoots,
-			Proofs:   rowProofs,
-			StartRow: uint32(startRow),
-			EndRow:   uint32(endRow),
-		},
+		RowProof:         rowProof,
 		Data:             rawShares,
 		ShareProofs:      shareProofs,
 		NamespaceId:      namespace.ID(),
@@ -137,6 +120,29 @@ func NewShareInclusionProofFromEDS(
 	}, nil
 }
 
+// newRowProof creates the binary merkle inclusion proof of the rows in the
+// range [startRow, endRow] to the data root.
+func newRowProof(edsRowRoots, edsColRoots [][]byte, startRow, endRow int) *RowProof {
+	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+	rowProofs := make([]*Proof, endRow-startRow+1)
+	rowRoots := make([][]byte, endRow-startRow+1)
+	for i := startRow; i <= endRow; i++ {
+		rowProofs[i-startRow] = &Proof{
+			Total:    allProofs[i].Total,
+			Index:    allProofs[i].Index,
+			LeafHash: allProofs[i].LeafHash,
+			Aunts:    allProofs[i].Aunts,
+		}
+		rowRoots[i-startRow] = edsRowRoots[i]
+	}
+	return &RowProof{
+		RowRoots: rowRoots,
+		Proofs:   rowProofs,
+		StartRow: uint32(startRow),
+		EndRow:   uint32(endRow),
+	}
+}
+
 func safeConvertUint64ToInt(val uint64) (int, error) {
 	if val > math.MaxInt {
 		return 0, fmt.Errorf("value %d is too large to convert to int", val)
@@ -13,8 +13,11 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
 	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
 	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -231,6 +234,151 @@ func TestNewShareInclusionProof(t *testing.T) {
 	}
 }
 
+func TestNewBlobInclusionProof(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns2}, []int{500, 2000})
+	txs := append(testfactory.GenerateRandomTxs(20, 500), blobTxs...).ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(txs[len(txs)-1])
+	require.NoError(t, err)
+	require.True(t, isBlob)
+	blob := bTx.Blobs[0]
+	commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+
+	resp, err := proof.NewBlobInclusionProof(txs, blob.Namespace(), commitment)
+	require.NoError(t, err)
+	require.EqualValues(t, len(txs)-1, resp.TxIndex)
+	require.Equal(t, share.SparseSharesNeeded(uint32(blob.DataLen())), int(resp.EndShare-resp.StartShare))
+	require.NoError(t, resp.BlobProof.Validate(dataRoot))
+	require.NoError(t, resp.PfbProof.Validate(dataRoot))
+
+	// the proven shares contain the blob
+	shares, err := share.FromBytes(resp.BlobProof.Data)
+	require.NoError(t, err)
+	blobs, err := share.ParseBlobs(shares)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	require.Equal(t, blob.Data(), blobs[0].Data())
+
+	_, err = proof.NewBlobInclusionProof(txs, ns1, commitment)
+	require.ErrorIs(t, err, proof.ErrBlobNotFound)
+}
+
+func TestNewNamespaceAbsenceProof(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns3 := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns3}, []int{4000, 4000})
+	txs := append(testfactory.GenerateRandomTxs(20, 500), blobTxs...).ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	testCases := []struct {
+		name      string
+		namespace share.Namespace
+	}{
+		{
+			name:      "namespace before all shares",
+			namespace: share.MustNewV0Namespace(make([]byte, share.NamespaceVersionZeroIDSize)),
+		},
+		{
+			name:      "namespace between two blobs",
+			namespace: share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)),
+		},
+		{
+			name:      "namespace after all blobs",
+			namespace: share.MustNewV0Namespace(bytes.Repeat([]byte{0xff}, share.NamespaceVersionZeroIDSize)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			absenceProof, err := proof.NewNamespaceAbsenceProof(dataSquare, tc.namespace)
+			require.NoError(t, err)
+			require.NoError(t, absenceProof.Validate(dataRoot))
+			require.Error(t, absenceProof.Validate(bytes.Repeat([]byte{1}, len(dataRoot))))
+		})
+	}
+
+	t.Run("present namespace", func(t *testing.T) {
+		_, err := proof.NewNamespaceAbsenceProof(dataSquare, ns3)
+		require.ErrorIs(t, err, proof.ErrNamespacePresent)
+	})
+
+	t.Run("proof of a different namespace", func(t *testing.T) {
+		ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+		absenceProof, err := proof.NewNamespaceAbsenceProof(dataSquare, ns2)
+		require.NoError(t, err)
+		absenceProof.NamespaceId = ns1.ID()
+		require.Error(t, absenceProof.Validate(dataRoot))
+	})
+
+	t.Run("malformed proofs", func(t *testing.T) {
+		absenceProof, err := proof.NewNamespaceAbsenceProof(dataSquare, share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)))
+		require.NoError(t, err)
+
+		// the number of rows of EndRow-StartRow+1 wraps around to zero.
+		wrapped := absenceProof
+		wrapped.RowProof = &proof.RowProof{StartRow: 1, EndRow: 0}
+		wrapped.NmtProofs = nil
+		require.Error(t, wrapped.Validate(dataRoot))
+
+		empty := absenceProof
+		empty.RowProof = &proof.RowProof{}
+		empty.NmtProofs = nil
+		require.Error(t, empty.Validate(dataRoot))
+	})
+
+	t.Run("namespace between two rows", func(t *testing.T) {
+		// a 4x4 square with each row filled by a single namespace
+		var rowsSquare square.Square
+		for _, id := range []byte{1, 3, 5, 7} {
+			ns := share.MustNewV0Namespace(bytes.Repeat([]byte{id}, share.NamespaceVersionZeroIDSize))
+			shares, err := share.NamespacePaddingShares(ns, share.ShareVersionZero, 4)
+			require.NoError(t, err)
+			rowsSquare = append(rowsSquare, shares...)
+		}
+		eds, err := da.ExtendShares(share.ToBytes(rowsSquare))
+		require.NoError(t, err)
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+		absenceProof, err := proof.NewNamespaceAbsenceProof(rowsSquare, ns2)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, absenceProof.RowProof.StartRow)
+		require.EqualValues(t, 1, absenceProof.RowProof.EndRow)
+		require.NoError(t, absenceProof.Validate(dah.Hash()))
+
+		// the proof is incomplete without the row after the namespace
+		absenceProof.RowProof.RowRoots = absenceProof.RowProof.RowRoots[:1]
+		absenceProof.RowProof.Proofs = absenceProof.RowProof.Proofs[:1]
+		absenceProof.RowProof.EndRow = 0
+		absenceProof.NmtProofs = absenceProof.NmtProofs[:1]
+		require.Error(t, absenceProof.Validate(dah.Hash()))
+	})
+}
+
 // TestAllSharesInclusionProof creates a proof for all shares in the data
 // square. Since we can't prove multiple namespaces at the moment, all the
 // shares use the same namespace.
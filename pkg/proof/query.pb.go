@@ -0,0 +1,1419 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/proof/query.proto
+
+package proof
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBlobInclusionProofRequest is the request type for the
+// BlobInclusionProof gRPC method.
+type QueryBlobInclusionProofRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace of the blob, i.e. the version followed by
+	// the ID.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// commitment is the share commitment of the blob.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *QueryBlobInclusionProofRequest) Reset()         { *m = QueryBlobInclusionProofRequest{} }
+func (m *QueryBlobInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobInclusionProofRequest) ProtoMessage()    {}
+func (*QueryBlobInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{0}
+}
+func (m *QueryBlobInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryBlobInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryBlobInclusionProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlobInclusionProofRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryBlobInclusionProofRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// QueryBlobInclusionProofResponse is the response type for the
+// BlobInclusionProof gRPC method.
+type QueryBlobInclusionProofResponse struct {
+	// blob_proof proves the shares of the blob to the data root.
+	BlobProof *ShareProof `protobuf:"bytes,1,opt,name=blob_proof,json=blobProof,proto3" json:"blob_proof,omitempty"`
+	// pfb_proof proves the shares of the PFB transaction to the data root.
+	PfbProof *ShareProof `protobuf:"bytes,2,opt,name=pfb_proof,json=pfbProof,proto3" json:"pfb_proof,omitempty"`
+	// tx_index is the index of the PFB transaction in the block.
+	TxIndex uint32 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// start_share and end_share are the range of shares occupied by the blob in
+	// the square. The start is inclusive and the end is exclusive.
+	StartShare uint32 `protobuf:"varint,4,opt,name=start_share,json=startShare,proto3" json:"start_share,omitempty"`
+	EndShare   uint32 `protobuf:"varint,5,opt,name=end_share,json=endShare,proto3" json:"end_share,omitempty"`
+	DataRoot   []byte `protobuf:"bytes,6,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *QueryBlobInclusionProofResponse) Reset()         { *m = QueryBlobInclusionProofResponse{} }
+func (m *QueryBlobInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobInclusionProofResponse) ProtoMessage()    {}
+func (*QueryBlobInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{1}
+}
+func (m *QueryBlobInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryBlobInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryBlobInclusionProofResponse) GetBlobProof() *ShareProof {
+	if m != nil {
+		return m.BlobProof
+	}
+	return nil
+}
+
+func (m *QueryBlobInclusionProofResponse) GetPfbProof() *ShareProof {
+	if m != nil {
+		return m.PfbProof
+	}
+	return nil
+}
+
+func (m *QueryBlobInclusionProofResponse) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *QueryBlobInclusionProofResponse) GetStartShare() uint32 {
+	if m != nil {
+		return m.StartShare
+	}
+	return 0
+}
+
+func (m *QueryBlobInclusionProofResponse) GetEndShare() uint32 {
+	if m != nil {
+		return m.EndShare
+	}
+	return 0
+}
+
+func (m *QueryBlobInclusionProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+// QueryNamespaceAbsenceProofRequest is the request type for the
+// NamespaceAbsenceProof gRPC method.
+type QueryNamespaceAbsenceProofRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace, i.e. the version followed by the ID.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) Reset()         { *m = QueryNamespaceAbsenceProofRequest{} }
+func (m *QueryNamespaceAbsenceProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceAbsenceProofRequest) ProtoMessage()    {}
+func (*QueryNamespaceAbsenceProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{2}
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceAbsenceProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceAbsenceProofRequest.Merge(m, src)
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceAbsenceProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceAbsenceProofRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceAbsenceProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// QueryNamespaceAbsenceProofResponse is the response type for the
+// NamespaceAbsenceProof gRPC method.
+type QueryNamespaceAbsenceProofResponse struct {
+	Proof    *NamespaceAbsenceProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	DataRoot []byte                 `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) Reset()         { *m = QueryNamespaceAbsenceProofResponse{} }
+func (m *QueryNamespaceAbsenceProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceAbsenceProofResponse) ProtoMessage()    {}
+func (*QueryNamespaceAbsenceProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{3}
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceAbsenceProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceAbsenceProofResponse.Merge(m, src)
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceAbsenceProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceAbsenceProofResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceAbsenceProofResponse) GetProof() *NamespaceAbsenceProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBlobInclusionProofRequest)(nil), "celestia.core.v1.proof.QueryBlobInclusionProofRequest")
+	proto.RegisterType((*QueryBlobInclusionProofResponse)(nil), "celestia.core.v1.proof.QueryBlobInclusionProofResponse")
+	proto.RegisterType((*QueryNamespaceAbsenceProofRequest)(nil), "celestia.core.v1.proof.QueryNamespaceAbsenceProofRequest")
+	proto.RegisterType((*QueryNamespaceAbsenceProofResponse)(nil), "celestia.core.v1.proof.QueryNamespaceAbsenceProofResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/proof/query.proto", fileDescriptor_0e626addf1ae410d)
+}
+
+var fileDescriptor_0e626addf1ae410d = []byte{
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xae, 0x13, 0x12, 0x92, 0x57, 0x58, 0x4e, 0xa2, 0x32, 0xa1, 0x72, 0x8b, 0x25, 0x44, 0x97,
+	0xd8, 0x6a, 0x40, 0x20, 0xba, 0xa0, 0x96, 0x01, 0x75, 0x41, 0x60, 0x26, 0x58, 0xac, 0xb3, 0x73,
+	0x71, 0x2c, 0xec, 0x7b, 0x57, 0xdf, 0x25, 0x0a, 0x42, 0x2c, 0x0c, 0x2c, 0x2c, 0x48, 0x8c, 0xfc,
+	0x1b, 0xfc, 0x11, 0x88, 0xa9, 0x12, 0x0b, 0x23, 0x4a, 0xf8, 0x43, 0x90, 0xcf, 0x8e, 0x69, 0x45,
+	0x1c, 0xf1, 0x63, 0x89, 0xee, 0xde, 0x7b, 0xdf, 0xf7, 0xde, 0xf7, 0xdd, 0x8b, 0xc1, 0x0e, 0x59,
+	0xc2, 0xa4, 0x8a, 0xa9, 0x1b, 0x62, 0xc6, 0xdc, 0xe9, 0xbe, 0x2b, 0x32, 0xc4, 0x91, 0x7b, 0x32,
+	0x61, 0xd9, 0x4b, 0x47, 0x64, 0xa8, 0x90, 0x6c, 0x2d, 0x6b, 0x9c, 0xbc, 0xc6, 0x99, 0xee, 0x3b,
+	0xba, 0xa6, 0xb7, 0x1d, 0x21, 0x46, 0x09, 0x73, 0xa9, 0x88, 0x5d, 0xca, 0x39, 0x2a, 0xaa, 0x62,
+	0xe4, 0xb2, 0x40, 0xf5, 0xea, 0x98, 0xf5, 0x6f, 0x51, 0x63, 0x4f, 0xc1, 0x7a, 0x92, 0x37, 0x3a,
+	0x4a, 0x30, 0x38, 0xe6, 0x61, 0x32, 0x91, 0x31, 0xf2, 0xc7, 0x79, 0x81, 0xc7, 0x4e, 0x26, 0x4c,
+	0x2a, 0xb2, 0x05, 0xed, 0x31, 0x8b, 0xa3, 0xb1, 0x32, 0x8d, 0x5d, 0x63, 0xaf, 0xe9, 0x95, 0x37,
+	0xb2, 0x0d, 0x5d, 0x4e, 0x53, 0x26, 0x05, 0x0d, 0x99, 0xd9, 0xd8, 0x35, 0xf6, 0x2e, 0x79, 0xbf,
+	0x02, 0xc4, 0x02, 0x08, 0x31, 0x4d, 0x63, 0x95, 0x32, 0xae, 0xcc, 0xa6, 0x4e, 0x9f, 0x89, 0xd8,
+	0x1f, 0x1b, 0xb0, 0x53, 0xdb, 0x58, 0x0a, 0xe4, 0x92, 0x91, 0x43, 0x80, 0x20, 0xc1, 0xc0, 0xd7,
+	0xf3, 0xea, 0xee, 0x9b, 0x03, 0xdb, 0x59, 0x6d, 0x85, 0xf3, 0x74, 0x4c, 0x33, 0x56, 0xe0, 0xbb,
+	0x39, 0x4a, 0x1f, 0xc9, 0x7d, 0xe8, 0x8a, 0xd1, 0x92, 0xa1, 0xf1, 0xc7, 0x0c, 0x1d, 0x31, 0x2a,
+	0x09, 0xae, 0x42, 0x47, 0xcd, 0xfc, 0x98, 0x0f, 0xd9, 0x4c, 0xab, 0xb8, 0xec, 0x5d, 0x54, 0xb3,
+	0xe3, 0xfc, 0x4a, 0x76, 0x60, 0x53, 0x2a, 0x9a, 0x29, 0x5f, 0xe6, 0x40, 0xf3, 0x82, 0xce, 0x82,
+	0x0e, 0x69, 0x2a, 0x72, 0x0d, 0xba, 0x8c, 0x0f, 0xcb, 0x74, 0x4b, 0xa7, 0x3b, 0x8c, 0x0f, 0xab,
+	0xe4, 0x90, 0x2a, 0xea, 0x67, 0x88, 0xca, 0x6c, 0x6b, 0x7f, 0x3a, 0x79, 0xc0, 0x43, 0x54, 0xf6,
+	0x33, 0xb8, 0xae, 0xcd, 0x79, 0xb4, 0xf4, 0xf3, 0x30, 0x90, 0x8c, 0x87, 0xec, 0xff, 0x1f, 0xc6,
+	0x7e, 0x6b, 0x80, 0xbd, 0x8e, 0xbb, 0xf4, 0xfe, 0x01, 0xb4, 0xce, 0xda, 0xde, 0xaf, 0x33, 0x6d,
+	0x35, 0x4b, 0x81, 0x3d, 0xaf, 0xb1, 0x71, 0x5e, 0xe3, 0xe0, 0x5d, 0x13, 0x5a, 0x7a, 0x10, 0xf2,
+	0xc9, 0x00, 0xf2, 0xfb, 0x1a, 0x90, 0x3b, 0x75, 0x3d, 0xd7, 0x2f, 0x6c, 0xef, 0xee, 0x5f, 0xe3,
+	0x0a, 0xcd, 0x76, 0xff, 0xcd, 0xd7, 0x1f, 0x1f, 0x1a, 0x37, 0xc9, 0x0d, 0xb7, 0xe6, 0x8f, 0x93,
+	0xef, 0x95, 0xfb, 0xaa, 0xb0, 0xf9, 0x35, 0xf9, 0x62, 0xc0, 0x95, 0x95, 0xf2, 0xc9, 0xbd, 0xb5,
+	0x13, 0xac, 0x7b, 0xd4, 0xde, 0xc1, 0xbf, 0x40, 0xcb, 0xf9, 0x0f, 0xf4, 0xfc, 0xb7, 0xc9, 0xa0,
+	0x6e, 0xfe, 0x6a, 0x0b, 0x7c, 0x5a, 0xe0, 0x2b, 0x31, 0x47, 0x0f, 0x3f, 0xcf, 0x2d, 0xe3, 0x74,
+	0x6e, 0x19, 0xdf, 0xe7, 0x96, 0xf1, 0x7e, 0x61, 0x6d, 0x9c, 0x2e, 0xac, 0x8d, 0x6f, 0x0b, 0x6b,
+	0xe3, 0x79, 0x3f, 0x8a, 0xd5, 0x78, 0x12, 0x38, 0x21, 0xa6, 0x15, 0x2f, 0x66, 0x51, 0x75, 0xee,
+	0x53, 0x21, 0x5c, 0xf1, 0x22, 0x2a, 0x7a, 0x04, 0x6d, 0xfd, 0x5d, 0xb9, 0xf5, 0x33, 0x00, 0x00,
+	0xff, 0xff, 0xe5, 0x2d, 0x8e, 0x79, 0xd7, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlobInclusionProof locates a blob by its namespace and share commitment in
+	// the square of the block at the given height and returns the proofs of the
+	// blob's shares and of the PFB transaction that paid for it to the block's
+	// data root.
+	BlobInclusionProof(ctx context.Context, in *QueryBlobInclusionProofRequest, opts ...grpc.CallOption) (*QueryBlobInclusionProofResponse, error)
+	// NamespaceAbsenceProof returns a proof to the block's data root that the
+	// square of the block at the given height contains no shares of the
+	// namespace.
+	NamespaceAbsenceProof(ctx context.Context, in *QueryNamespaceAbsenceProofRequest, opts ...grpc.CallOption) (*QueryNamespaceAbsenceProofResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlobInclusionProof(ctx context.Context, in *QueryBlobInclusionProofRequest, opts ...grpc.CallOption) (*QueryBlobInclusionProofResponse, error) {
+	out := new(QueryBlobInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/BlobInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespaceAbsenceProof(ctx context.Context, in *QueryNamespaceAbsenceProofRequest, opts ...grpc.CallOption) (*QueryNamespaceAbsenceProofResponse, error) {
+	out := new(QueryNamespaceAbsenceProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/NamespaceAbsenceProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlobInclusionProof locates a blob by its namespace and share commitment in
+	// the square of the block at the given height and returns the proofs of the
+	// blob's shares and of the PFB transaction that paid for it to the block's
+	// data root.
+	BlobInclusionProof(context.Context, *QueryBlobInclusionProofRequest) (*QueryBlobInclusionProofResponse, error)
+	// NamespaceAbsenceProof returns a proof to the block's data root that the
+	// square of the block at the given height contains no shares of the
+	// namespace.
+	NamespaceAbsenceProof(context.Context, *QueryNamespaceAbsenceProofRequest) (*QueryNamespaceAbsenceProofResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlobInclusionProof(ctx context.Context, req *QueryBlobInclusionProofRequest) (*QueryBlobInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) NamespaceAbsenceProof(ctx context.Context, req *QueryNamespaceAbsenceProofRequest) (*QueryNamespaceAbsenceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceAbsenceProof not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlobInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/BlobInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobInclusionProof(ctx, req.(*QueryBlobInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespaceAbsenceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceAbsenceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceAbsenceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/NamespaceAbsenceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceAbsenceProof(ctx, req.(*QueryNamespaceAbsenceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.proof.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobInclusionProof",
+			Handler:    _Query_BlobInclusionProof_Handler,
+		},
+		{
+			MethodName: "NamespaceAbsenceProof",
+			Handler:    _Query_NamespaceAbsenceProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/proof/query.proto",
+}
+
+func (m *QueryBlobInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndShare))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartShare))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PfbProof != nil {
+		{
+			size, err := m.PfbProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlobProof != nil {
+		{
+			size, err := m.BlobProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBlobInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlobInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlobProof != nil {
+		l = m.BlobProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PfbProof != nil {
+		l = m.PfbProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	if m.StartShare != 0 {
+		n += 1 + sovQuery(uint64(m.StartShare))
+	}
+	if m.EndShare != 0 {
+		n += 1 + sovQuery(uint64(m.EndShare))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBlobInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlobProof == nil {
+				m.BlobProof = &ShareProof{}
+			}
+			if err := m.BlobProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfbProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfbProof == nil {
+				m.PfbProof = &ShareProof{}
+			}
+			if err := m.PfbProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShare", wireType)
+			}
+			m.StartShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShare", wireType)
+			}
+			m.EndShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceAbsenceProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceAbsenceProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceAbsenceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceAbsenceProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceAbsenceProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceAbsenceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &NamespaceAbsenceProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,211 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrBlobNotFound is returned if no blob with the namespace and commitment is
+// part of the block.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// RegisterQueryService registers the proof query service on the gRPC router.
+func RegisterQueryService(qrt gogogrpc.Server, clientCtx client.Context) {
+	RegisterQueryServer(qrt, NewQueryServer(clientCtx))
+}
+
+// RegisterGRPCGatewayRoutes mounts the proof query service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ QueryServer = &queryServer{}
+
+type queryServer struct {
+	clientCtx client.Context
+}
+
+func NewQueryServer(clientCtx client.Context) QueryServer {
+	return &queryServer{clientCtx: clientCtx}
+}
+
+// BlobInclusionProof implements the QueryServer.BlobInclusionProof method
+// using the block fetched from the underlying celestia-core RPC server.
+func (s *queryServer) BlobInclusionProof(ctx context.Context, req *QueryBlobInclusionProofRequest) (*QueryBlobInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+	if len(req.Commitment) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "commitment cannot be empty")
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	signClient, ok := node.(rpcclient.SignClient)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "node does not support fetching blocks")
+	}
+	resBlock, err := signClient.Block(ctx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := NewBlobInclusionProof(resBlock.Block.Data.Txs.ToSliceOfBytes(), namespace, req.Commitment)
+	if errors.Is(err, ErrBlobNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%s at height %d", err, req.Height)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.DataRoot = resBlock.Block.DataHash
+	return resp, nil
+}
+
+// NamespaceAbsenceProof implements the QueryServer.NamespaceAbsenceProof
+// method using the block fetched from the underlying celestia-core RPC server.
+func (s *queryServer) NamespaceAbsenceProof(ctx context.Context, req *QueryNamespaceAbsenceProofRequest) (*QueryNamespaceAbsenceProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	signClient, ok := node.(rpcclient.SignClient)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "node does not support fetching blocks")
+	}
+	resBlock, err := signClient.Block(ctx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	dataSquare, err := square.Construct(resBlock.Block.Data.Txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	absenceProof, err := NewNamespaceAbsenceProof(dataSquare, namespace)
+	if errors.Is(err, ErrNamespacePresent) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s at height %d", err, req.Height)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &QueryNamespaceAbsenceProofResponse{
+		Proof:    &absenceProof,
+		DataRoot: resBlock.Block.DataHash,
+	}, nil
+}
+
+// NewBlobInclusionProof locates the blob with the namespace and share
+// commitment in the square built from the txs and returns the inclusion proofs
+// of the blob and of the PFB tx that paid for it. The data root of the
+// response is left empty.
+func NewBlobInclusionProof(txs [][]byte, namespace share.Namespace, commitment []byte) (*QueryBlobInclusionProofResponse, error) {
+	txIndex, blobIndex, err := findBlob(txs, namespace, commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	// As we don't have access to the application's state machine we use the
+	// upper bound square size instead of the square size dictated from
+	// governance. This results in the same square.
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold, txs...)
+	if err != nil {
+		return nil, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, err
+	}
+	start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+	if err != nil {
+		return nil, err
+	}
+	length, err := builder.BlobShareLength(txIndex, blobIndex)
+	if err != nil {
+		return nil, err
+	}
+	shareRange := share.NewRange(start, start+length)
+
+	blobProof, err := NewShareInclusionProof(dataSquare, namespace, shareRange)
+	if err != nil {
+		return nil, fmt.Errorf("creating blob inclusion proof: %w", err)
+	}
+	pfbShareRange, err := builder.FindTxShareRange(txIndex)
+	if err != nil {
+		return nil, err
+	}
+	pfbProof, err := NewShareInclusionProof(dataSquare, share.PayForBlobNamespace, pfbShareRange)
+	if err != nil {
+		return nil, fmt.Errorf("creating PFB inclusion proof: %w", err)
+	}
+
+	return &QueryBlobInclusionProofResponse{
+		BlobProof:  &blobProof,
+		PfbProof:   &pfbProof,
+		TxIndex:    uint32(txIndex),
+		StartShare: uint32(shareRange.Start),
+		EndShare:   uint32(shareRange.End),
+	}, nil
+}
+
+// findBlob returns the index of the blob tx and the index of the blob within
+// it. Commitments are only computed for blobs of the namespace.
+func findBlob(txs [][]byte, namespace share.Namespace, commitment []byte) (int, int, error) {
+	for txIndex, rawTx := range txs {
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlob || err != nil {
+			continue
+		}
+		for blobIndex, blob := range bTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+			blobCommitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+			if err != nil {
+				return 0, 0, err
+			}
+			if bytes.Equal(blobCommitment, commitment) {
+				return txIndex, blobIndex, nil
+			}
+		}
+	}
+	return 0, 0, ErrBlobNotFound
+}
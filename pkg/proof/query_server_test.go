@@ -0,0 +1,75 @@
+package proof_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryBlobInclusionProof(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestQueryBlobInclusionProof in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	cctx, _, _ := testnode.NewNetwork(t, testnode.DefaultConfig().WithTimeoutCommit(100*time.Millisecond))
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := user.SetupTxClient(ctx, cctx.Keyring, cctx.GRPCClient, enc)
+	require.NoError(t, err)
+
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(1500))
+	require.NoError(t, err)
+	resp, err := txClient.SubmitPayForBlob(ctx, []*share.Blob{blob}, user.SetGasLimitAndGasPrice(200_000, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+
+	queryClient := proof.NewQueryClient(cctx.GRPCClient)
+	proofResp, err := queryClient.BlobInclusionProof(ctx, &proof.QueryBlobInclusionProofRequest{
+		Height:     resp.Height,
+		Namespace:  blob.Namespace().Bytes(),
+		Commitment: commitment,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, proofResp.DataRoot)
+	require.NoError(t, proofResp.BlobProof.Validate(proofResp.DataRoot))
+	require.NoError(t, proofResp.PfbProof.Validate(proofResp.DataRoot))
+
+	_, err = queryClient.BlobInclusionProof(ctx, &proof.QueryBlobInclusionProofRequest{
+		Height:     resp.Height,
+		Namespace:  share.RandomBlobNamespace().Bytes(),
+		Commitment: commitment,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	absenceResp, err := queryClient.NamespaceAbsenceProof(ctx, &proof.QueryNamespaceAbsenceProofRequest{
+		Height:    resp.Height,
+		Namespace: share.RandomBlobNamespace().Bytes(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, absenceResp.Proof.Validate(absenceResp.DataRoot))
+
+	_, err = queryClient.NamespaceAbsenceProof(ctx, &proof.QueryNamespaceAbsenceProofRequest{
+		Height:    resp.Height,
+		Namespace: blob.Namespace().Bytes(),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,339 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// referenceBlobSize is the size of the blobs of the PayForBlobs used to
+// measure the overhead of a PayForBlobs. It is large enough for the varints
+// encoding the blob sizes to be as long as for any blob below the max tx size.
+const referenceBlobSize = 1 << 21
+
+var (
+	// ErrBlobExceedsMaxTxSize is the reason a blob is deferred if a
+	// PayForBlobs containing only that blob exceeds the max tx size.
+	ErrBlobExceedsMaxTxSize = errors.New("blob exceeds the max tx size")
+	// ErrBlobExceedsMaxSquareSize is the reason a blob is deferred if a
+	// PayForBlobs containing only that blob occupies more shares than a square
+	// of the max square size holds.
+	ErrBlobExceedsMaxSquareSize = errors.New("blob exceeds the max square size")
+	// ErrMaxPFBsReached is the reason a blob is deferred if it didn't fit in
+	// any of the PayForBlobs and no more PayForBlobs can be created.
+	ErrMaxPFBsReached = errors.New("max number of PayForBlobs reached")
+)
+
+// BlobPackerOption configures a BlobPacker.
+type BlobPackerOption func(p *BlobPacker)
+
+// WithPackerMaxTxSize sets the max size in bytes of a packed BlobTx.
+func WithPackerMaxTxSize(maxTxSize int) BlobPackerOption {
+	return func(p *BlobPacker) {
+		p.maxTxSize = maxTxSize
+	}
+}
+
+// WithPackerMaxSquareSize sets the max square size the shares of a packed
+// PayForBlobs must fit in. It should match the network's effective max square
+// size, i.e. min(GovMaxSquareSize, SquareSizeUpperBound).
+func WithPackerMaxSquareSize(maxSquareSize int) BlobPackerOption {
+	return func(p *BlobPacker) {
+		p.maxSquareSize = maxSquareSize
+	}
+}
+
+// WithPackerMaxPFBs sets the max number of PayForBlobs a single call to Pack
+// produces.
+func WithPackerMaxPFBs(maxPFBs int) BlobPackerOption {
+	return func(p *BlobPacker) {
+		p.maxPFBs = maxPFBs
+	}
+}
+
+// PackedPFB is a group of blobs that can be paid for by a single PayForBlobs.
+type PackedPFB struct {
+	Blobs []*share.Blob
+	// Shares is the max number of shares the square builder reserves for the
+	// PayForBlobs and its blobs, including the padding before each blob.
+	Shares int
+	// TxSize is the estimated size in bytes of the BlobTx.
+	TxSize int
+	// GasLimit is the estimated gas needed by the PayForBlobs.
+	GasLimit uint64
+}
+
+// DeferredBlob is a blob that could not be packed together with the reason.
+type DeferredBlob struct {
+	Blob   *share.Blob
+	Reason error
+}
+
+// BlobPacker groups blobs into as few PayForBlobs as possible, which
+// minimises the fixed gas cost paid per PayForBlobs, while keeping every
+// PayForBlobs below the max tx size and within the shares of a max sized
+// square. The size of a PayForBlobs is derived from a PayForBlobs signed
+// without a memo. Shares are counted the same way as the square builder does,
+// which is never less than what the BlobShareDecorator in the blob module's
+// ante handler counts.
+type BlobPacker struct {
+	maxTxSize     int
+	maxSquareSize int
+	maxPFBs       int
+	overhead      pfbOverhead
+}
+
+// NewBlobPacker returns a BlobPacker that by default packs blobs for the
+// network's default max tx size, max square size and max number of PFB
+// messages per block.
+func NewBlobPacker(opts ...BlobPackerOption) (*BlobPacker, error) {
+	overhead, err := measurePFBOverheadOnce()
+	if err != nil {
+		return nil, fmt.Errorf("measuring the PayForBlobs overhead: %w", err)
+	}
+	p := &BlobPacker{
+		maxTxSize:     appconsts.MaxTxSize,
+		maxSquareSize: min(appconsts.DefaultGovMaxSquareSize, appconsts.SquareSizeUpperBound),
+		maxPFBs:       appconsts.MaxPFBMessages,
+		overhead:      overhead,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
+}
+
+// Pack packs the blobs into PayForBlobs using a first fit decreasing
+// strategy. Blobs keep their relative order within a PayForBlobs and the
+// PayForBlobs are ordered by their first blob. Blobs that can't be packed are
+// returned, in their original order, together with the reason so that callers
+// can retry them in a later call.
+func (p *BlobPacker) Pack(blobs []*share.Blob) ([]PackedPFB, []DeferredBlob) {
+	order := make([]int, len(blobs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(blobs[order[i]].Data()) > len(blobs[order[j]].Data())
+	})
+
+	var (
+		bins     []*pfbBin
+		deferred = make(map[int]error)
+	)
+	for _, idx := range order {
+		blob := blobs[idx]
+		single := &pfbBin{}
+		single.add(idx, blob)
+		if err := p.check(single); err != nil {
+			deferred[idx] = err
+			continue
+		}
+
+		packed := false
+		for _, bin := range bins {
+			if p.check(bin.with(idx, blob)) == nil {
+				bin.add(idx, blob)
+				packed = true
+				break
+			}
+		}
+		if packed {
+			continue
+		}
+		if len(bins) >= p.maxPFBs {
+			deferred[idx] = ErrMaxPFBsReached
+			continue
+		}
+		bins = append(bins, single)
+	}
+
+	for _, bin := range bins {
+		sort.Ints(bin.indexes)
+	}
+	sort.Slice(bins, func(i, j int) bool { return bins[i].indexes[0] < bins[j].indexes[0] })
+
+	pfbs := make([]PackedPFB, len(bins))
+	for i, bin := range bins {
+		packedBlobs := make([]*share.Blob, len(bin.indexes))
+		for j, idx := range bin.indexes {
+			packedBlobs[j] = blobs[idx]
+		}
+		pfbs[i] = PackedPFB{
+			Blobs:    packedBlobs,
+			Shares:   p.shares(bin),
+			TxSize:   p.blobTxSize(bin),
+			GasLimit: blobtypes.DefaultEstimateGas(bin.blobSizes),
+		}
+	}
+
+	deferredBlobs := make([]DeferredBlob, 0, len(deferred))
+	for idx, blob := range blobs {
+		if reason, ok := deferred[idx]; ok {
+			deferredBlobs = append(deferredBlobs, DeferredBlob{Blob: blob, Reason: reason})
+		}
+	}
+	return pfbs, deferredBlobs
+}
+
+// check returns the reason the bin can't be paid for by a single PayForBlobs.
+func (p *BlobPacker) check(bin *pfbBin) error {
+	if p.blobTxSize(bin) > p.maxTxSize {
+		return ErrBlobExceedsMaxTxSize
+	}
+	maxShares := p.maxSquareSize * p.maxSquareSize
+	if blobtypes.SharesNeeded(uint32(p.txSize(bin)), bin.blobSizes) > maxShares || p.shares(bin) > maxShares {
+		return ErrBlobExceedsMaxSquareSize
+	}
+	return nil
+}
+
+// txSize is the size of the PayForBlobs tx without the blobs.
+func (p *BlobPacker) txSize(bin *pfbBin) int {
+	return p.overhead.tx.size(len(bin.blobSizes))
+}
+
+// blobTxSize is the size of the BlobTx wrapping the PayForBlobs.
+func (p *BlobPacker) blobTxSize(bin *pfbBin) int {
+	return p.txSize(bin) + p.overhead.blobTx.size(len(bin.blobSizes)) + bin.dataSize
+}
+
+// shares returns the max number of shares the square builder reserves for
+// the PayForBlobs tx and its blobs. Like the square builder, it counts the
+// PayForBlobs tx wrapped with its share indexes and the worst case padding
+// needed to align each blob with its subtree roots.
+func (p *BlobPacker) shares(bin *pfbBin) int {
+	counter := share.NewCompactShareCounter()
+	counter.Add(p.txSize(bin) + p.overhead.indexWrapper.size(len(bin.blobSizes)))
+	sum := counter.Size()
+	for _, size := range bin.blobSizes {
+		blobShares := share.SparseSharesNeeded(size)
+		sum += blobShares + inclusion.SubTreeWidth(blobShares, appconsts.SubtreeRootThreshold) - 1
+	}
+	return sum
+}
+
+// pfbBin is a candidate group of blobs for a single PayForBlobs.
+type pfbBin struct {
+	indexes   []int
+	blobSizes []uint32
+	dataSize  int
+}
+
+func (b *pfbBin) add(idx int, blob *share.Blob) {
+	b.indexes = append(b.indexes, idx)
+	b.blobSizes = append(b.blobSizes, uint32(len(blob.Data())))
+	b.dataSize += len(blob.Data())
+}
+
+// with returns a copy of the bin with the blob added.
+func (b *pfbBin) with(idx int, blob *share.Blob) *pfbBin {
+	c := &pfbBin{
+		indexes:   append([]int{}, b.indexes...),
+		blobSizes: append([]uint32{}, b.blobSizes...),
+		dataSize:  b.dataSize,
+	}
+	c.add(idx, blob)
+	return c
+}
+
+// linearSize is a size in bytes that grows linearly with the number of blobs.
+type linearSize struct {
+	base    int
+	perBlob int
+}
+
+func (s linearSize) size(blobs int) int {
+	return s.base + s.perBlob*blobs
+}
+
+// pfbOverhead is the size in bytes a PayForBlobs adds on top of the data of
+// its blobs.
+type pfbOverhead struct {
+	// tx is the size of the signed sdk tx containing the MsgPayForBlobs.
+	tx linearSize
+	// blobTx is the size the BlobTx adds on top of the sdk tx and the data of
+	// the blobs.
+	blobTx linearSize
+	// indexWrapper is the size the IndexWrapper, which the square builder
+	// stores instead of the sdk tx, adds on top of the sdk tx.
+	indexWrapper linearSize
+}
+
+var measurePFBOverheadOnce = sync.OnceValues(measurePFBOverhead)
+
+// measurePFBOverhead signs PayForBlobs with one and with two reference blobs
+// and derives the overhead per PayForBlobs and per blob from their sizes. The
+// PayForBlobs are signed with the largest fee, gas limit and sequence, and
+// with a fee granter, so that no PayForBlobs signed without a memo is larger.
+func measurePFBOverhead() (pfbOverhead, error) {
+	const keyName = "pfb-overhead"
+	encCfg := encoding.MakeConfig()
+	blobtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	kr := keyring.NewInMemory(encCfg.Codec)
+	record, _, err := kr.NewMnemonic(keyName, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	if err != nil {
+		return pfbOverhead{}, err
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return pfbOverhead{}, err
+	}
+	signer, err := NewSigner(kr, encCfg.TxConfig, "", NewAccount(keyName, math.MaxUint64, math.MaxUint64))
+	if err != nil {
+		return pfbOverhead{}, err
+	}
+	blob, err := share.NewV1Blob(share.RandomBlobNamespace(), make([]byte, referenceBlobSize), addr)
+	if err != nil {
+		return pfbOverhead{}, err
+	}
+	worstCaseShareIndex := uint32(appconsts.SquareSizeUpperBound * appconsts.SquareSizeUpperBound)
+
+	var measured [2]pfbOverhead
+	for i := range measured {
+		blobs := make([]*share.Blob, i+1)
+		shareIndexes := make([]uint32, i+1)
+		for j := range blobs {
+			blobs[j] = blob
+			shareIndexes[j] = worstCaseShareIndex
+		}
+		rawTx, _, err := signer.CreatePayForBlobs(keyName, blobs, SetGasLimit(math.MaxUint64), SetFee(math.MaxInt64), SetFeeGranter(addr))
+		if err != nil {
+			return pfbOverhead{}, err
+		}
+		blobTx, _, err := blobtx.UnmarshalBlobTx(rawTx)
+		if err != nil {
+			return pfbOverhead{}, err
+		}
+		txSize := len(blobTx.Tx)
+		measured[i] = pfbOverhead{
+			tx:           linearSize{base: txSize},
+			blobTx:       linearSize{base: len(rawTx) - txSize - len(blobs)*referenceBlobSize},
+			indexWrapper: linearSize{base: proto.Size(blobtx.NewIndexWrapper(blobTx.Tx, shareIndexes...)) - txSize},
+		}
+	}
+
+	// derive base + perBlob*blobs from the sizes for one and two blobs
+	derive := func(one, two linearSize) linearSize {
+		perBlob := two.base - one.base
+		return linearSize{base: one.base - perBlob, perBlob: perBlob}
+	}
+	return pfbOverhead{
+		tx:           derive(measured[0].tx, measured[1].tx),
+		blobTx:       derive(measured[0].blobTx, measured[1].blobTx),
+		indexWrapper: derive(measured[0].indexWrapper, measured[1].indexWrapper),
+	}, nil
+}
@@ -0,0 +1,105 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobPacker(t *testing.T) {
+	newBlob := func(size int) *share.Blob {
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), make([]byte, size))
+		require.NoError(t, err)
+		return blob
+	}
+
+	t.Run("packs small blobs into a single PFB", func(t *testing.T) {
+		blobs := []*share.Blob{newBlob(100), newBlob(2000), newBlob(300)}
+		packer, err := NewBlobPacker()
+		require.NoError(t, err)
+		pfbs, deferred := packer.Pack(blobs)
+		require.Empty(t, deferred)
+		require.Len(t, pfbs, 1)
+		// blobs keep their relative order
+		require.Equal(t, blobs, pfbs[0].Blobs)
+		require.Greater(t, pfbs[0].GasLimit, uint64(0))
+		require.Greater(t, pfbs[0].Shares, len(blobs))
+	})
+
+	t.Run("splits blobs that exceed the max tx size", func(t *testing.T) {
+		blobs := []*share.Blob{newBlob(600), newBlob(500), newBlob(400), newBlob(300)}
+		packer, err := NewBlobPacker(WithPackerMaxTxSize(1600))
+		require.NoError(t, err)
+		pfbs, deferred := packer.Pack(blobs)
+		require.Empty(t, deferred)
+		require.Len(t, pfbs, 2)
+		for _, pfb := range pfbs {
+			require.LessOrEqual(t, pfb.TxSize, 1600)
+		}
+		// first fit decreasing pairs the largest with the smallest blob
+		require.Equal(t, []*share.Blob{blobs[0], blobs[3]}, pfbs[0].Blobs)
+		require.Equal(t, []*share.Blob{blobs[1], blobs[2]}, pfbs[1].Blobs)
+	})
+
+	t.Run("defers blobs that can never fit", func(t *testing.T) {
+		blobs := []*share.Blob{newBlob(100), newBlob(5000), newBlob(1600)}
+		packer, err := NewBlobPacker(WithPackerMaxTxSize(3000), WithPackerMaxSquareSize(2))
+		require.NoError(t, err)
+		pfbs, deferred := packer.Pack(blobs)
+		require.Len(t, pfbs, 1)
+		require.Equal(t, []*share.Blob{blobs[0]}, pfbs[0].Blobs)
+		require.Len(t, deferred, 2)
+		require.Equal(t, blobs[1], deferred[0].Blob)
+		require.ErrorIs(t, deferred[0].Reason, ErrBlobExceedsMaxTxSize)
+		require.Equal(t, blobs[2], deferred[1].Blob)
+		require.ErrorIs(t, deferred[1].Reason, ErrBlobExceedsMaxSquareSize)
+	})
+
+	t.Run("defers blobs once the max number of PFBs is reached", func(t *testing.T) {
+		blobs := []*share.Blob{newBlob(1000), newBlob(1000), newBlob(1000)}
+		packer, err := NewBlobPacker(WithPackerMaxTxSize(1600), WithPackerMaxPFBs(2))
+		require.NoError(t, err)
+		pfbs, deferred := packer.Pack(blobs)
+		require.Len(t, pfbs, 2)
+		require.Len(t, deferred, 1)
+		require.Equal(t, blobs[2], deferred[0].Blob)
+		require.ErrorIs(t, deferred[0].Reason, ErrMaxPFBsReached)
+	})
+
+	t.Run("matches a signed PFB and the square builder", func(t *testing.T) {
+		encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+		kr := testfactory.TestKeyring(encCfg.Codec)
+		signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, NewAccount(testfactory.TestAccName, 1, 5))
+		require.NoError(t, err)
+
+		blobs := []*share.Blob{newBlob(100), newBlob(20_000), newBlob(3000)}
+		packer, err := NewBlobPacker()
+		require.NoError(t, err)
+		pfbs, deferred := packer.Pack(blobs)
+		require.Empty(t, deferred)
+		require.Len(t, pfbs, 1)
+
+		rawTx, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, pfbs[0].Blobs, SetGasLimit(pfbs[0].GasLimit), SetFee(10_000))
+		require.NoError(t, err)
+		// the measured overhead is an upper bound for the size of a signed PFB
+		// that only exceeds it by the fee granter, the signers of v1 blobs and
+		// the longest varints
+		require.GreaterOrEqual(t, pfbs[0].TxSize, len(rawTx))
+		require.Less(t, pfbs[0].TxSize-len(rawTx), 256)
+
+		blobTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		require.NoError(t, err)
+		require.True(t, isBlob)
+		builder, err := square.NewBuilder(appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		require.True(t, builder.AppendBlobTx(blobTx))
+		require.Equal(t, builder.CurrentSize(), pfbs[0].Shares)
+	})
+}
@@ -0,0 +1,376 @@
+package user
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/x/feegrant"
+	apperrors "github.com/celestiaorg/celestia-app/v6/app/errors"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultBlobQueueMaxRetries is the number of times a PayForBlobs is
+	// resubmitted after a transient broadcast failure.
+	DefaultBlobQueueMaxRetries = 3
+	// DefaultBlobQueueWorkerBalance is the amount of utia sent to a worker
+	// account that does not exist yet so that it is created on chain. Fees
+	// are paid by the default account through a feegrant.
+	DefaultBlobQueueWorkerBalance = 1
+	// DefaultBlobQueueWorkerSpendLimit is the max amount of utia in fees a
+	// worker account may spend from the default account's feegrant.
+	DefaultBlobQueueWorkerSpendLimit = 100_000_000
+)
+
+// ErrBlobQueueClosed is returned for blobs submitted to a closed BlobQueue.
+var ErrBlobQueueClosed = errors.New("blob queue is closed")
+
+// BlobQueueOption configures a BlobQueue.
+type BlobQueueOption func(q *BlobQueue)
+
+// WithBlobQueueMaxRetries sets how many times a PayForBlobs is resubmitted
+// after a transient broadcast failure.
+func WithBlobQueueMaxRetries(maxRetries int) BlobQueueOption {
+	return func(q *BlobQueue) {
+		q.maxRetries = maxRetries
+	}
+}
+
+// WithBlobQueueWorkerBalance sets the amount of utia sent to newly created
+// worker accounts.
+func WithBlobQueueWorkerBalance(balance int64) BlobQueueOption {
+	return func(q *BlobQueue) {
+		q.workerBalance = balance
+	}
+}
+
+// WithBlobQueueWorkerSpendLimit sets the max amount of utia in fees a newly
+// granted worker account may spend from the default account's feegrant.
+func WithBlobQueueWorkerSpendLimit(spendLimit int64) BlobQueueOption {
+	return func(q *BlobQueue) {
+		q.workerSpendLimit = spendLimit
+	}
+}
+
+// BlobResult is the outcome of a submission to the BlobQueue.
+type BlobResult struct {
+	Response *TxResponse
+	Err      error
+}
+
+type blobJob struct {
+	ctx    context.Context
+	blobs  []*share.Blob
+	opts   []TxOption
+	result chan BlobResult
+}
+
+// BlobQueue submits PayForBlobs concurrently from a pool of worker accounts so
+// that a slow transaction of one account doesn't block the transactions queued
+// behind it. The keys of the worker accounts are derived from the TxClient's
+// default account and added to its keyring. The worker accounts are funded and
+// granted a feegrant by the default account, which pays for all fees. The feegrant of a worker is limited to the worker spend limit. Once it
+// is spent the feegrant module removes it and a new BlobQueue grants a new
+// one. Submissions are dispatched to the workers in the order they are
+// queued. BlobQueue is thread-safe.
+type BlobQueue struct {
+	client           *TxClient
+	workers          []string
+	maxRetries       int
+	workerBalance    int64
+	workerSpendLimit int64
+
+	mtx    sync.RWMutex
+	closed bool
+	jobs   chan *blobJob
+	wg     sync.WaitGroup
+}
+
+// NewBlobQueue sets up numWorkers worker accounts, funding and feegranting
+// the ones that aren't set up yet, and starts a worker for each of them.
+// Worker keys are derived deterministically from the default account's private
+// key so that a restarted process reuses the same accounts and their feegrants
+// even if the keyring isn't persisted. The queue must be closed with Close.
+func (client *TxClient) NewBlobQueue(ctx context.Context, numWorkers int, opts ...BlobQueueOption) (*BlobQueue, error) {
+	if numWorkers < 1 {
+		return nil, errors.New("number of workers must be greater than 0")
+	}
+
+	q := &BlobQueue{
+		client:           client,
+		workers:          make([]string, numWorkers),
+		maxRetries:       DefaultBlobQueueMaxRetries,
+		workerBalance:    DefaultBlobQueueWorkerBalance,
+		workerSpendLimit: DefaultBlobQueueWorkerSpendLimit,
+		jobs:             make(chan *blobJob),
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	for i := range q.workers {
+		q.workers[i] = blobQueueWorkerName(i)
+	}
+	if err := q.setupWorkers(ctx); err != nil {
+		return nil, err
+	}
+
+	q.wg.Add(numWorkers)
+	for _, worker := range q.workers {
+		go q.runWorker(worker)
+	}
+	return q, nil
+}
+
+// Submit queues the blobs to be paid for by one of the worker accounts and
+// returns a channel on which the result is sent once the PayForBlobs is
+// confirmed or has failed. The TxOptions are applied on top of the gas limit
+// and gas price estimated by the gas estimation service.
+func (q *BlobQueue) Submit(ctx context.Context, blobs []*share.Blob, opts ...TxOption) <-chan BlobResult {
+	job := &blobJob{
+		ctx:    ctx,
+		blobs:  blobs,
+		opts:   opts,
+		result: make(chan BlobResult, 1),
+	}
+
+	q.mtx.RLock()
+	defer q.mtx.RUnlock()
+	if q.closed {
+		job.result <- BlobResult{Err: ErrBlobQueueClosed}
+		return job.result
+	}
+
+	select {
+	case q.jobs <- job:
+	case <-ctx.Done():
+		job.result <- BlobResult{Err: ctx.Err()}
+	}
+	return job.result
+}
+
+// Workers returns the names of the worker accounts.
+func (q *BlobQueue) Workers() []string {
+	return q.workers
+}
+
+// Close stops accepting new submissions and waits for the workers to finish
+// the submissions they are processing.
+func (q *BlobQueue) Close() {
+	q.mtx.Lock()
+	if q.closed {
+		q.mtx.Unlock()
+		return
+	}
+	q.closed = true
+	close(q.jobs)
+	q.mtx.Unlock()
+	q.wg.Wait()
+}
+
+func (q *BlobQueue) runWorker(worker string) {
+	defer q.wg.Done()
+	for job := range q.jobs {
+		resp, err := q.submit(job, worker)
+		job.result <- BlobResult{Response: resp, Err: err}
+	}
+}
+
+// submit broadcasts and confirms the PayForBlobs from the worker account,
+// retrying transient broadcast failures. If the worker's sequence is out of
+// sync with the node, it is recovered from the node's response before
+// retrying. Any other error is returned straight away.
+func (q *BlobQueue) submit(job *blobJob, worker string) (*TxResponse, error) {
+	gasLimit, gasPrice, err := q.estimateBlobCost(job.ctx, job.blobs)
+	if err != nil {
+		return nil, err
+	}
+	opts := append([]TxOption{
+		SetGasLimitAndGasPrice(gasLimit, gasPrice),
+		SetFeeGranter(q.client.DefaultAddress()),
+	}, job.opts...)
+
+	for attempt := 0; attempt <= q.maxRetries; attempt++ {
+		var resp *TxResponse
+		resp, err = q.client.SubmitPayForBlobWithAccount(job.ctx, worker, job.blobs, opts...)
+		if err == nil {
+			return resp, nil
+		}
+
+		var broadcastErr *BroadcastTxError
+		switch {
+		case job.ctx.Err() != nil:
+			return nil, job.ctx.Err()
+		case errors.As(err, &broadcastErr) && apperrors.IsNonceMismatchCode(broadcastErr.Code):
+			if err := q.recoverSequence(worker, broadcastErr.ErrorLog); err != nil {
+				return nil, errors.Join(broadcastErr, err)
+			}
+		case !isTransientBroadcastErr(err):
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("submitting blobs from worker %s after %d retries: %w", worker, q.maxRetries, err)
+}
+
+// estimateBlobCost returns the gas limit and the medium priority gas price of a
+// PayForBlobs paying for the blobs from a worker account through the default
+// account's feegrant, as estimated by the gas estimation service.
+func (q *BlobQueue) estimateBlobCost(ctx context.Context, blobs []*share.Blob) (uint64, float64, error) {
+	infos := make([]*gasestimation.BlobInfo, len(blobs))
+	for i, blob := range blobs {
+		infos[i] = &gasestimation.BlobInfo{
+			Namespace:    blob.Namespace().Bytes(),
+			DataSize:     uint32(len(blob.Data())),
+			ShareVersion: uint32(blob.ShareVersion()),
+		}
+	}
+	resp, err := q.client.gasEstimationClient.EstimateBlobCost(ctx, &gasestimation.EstimateBlobCostRequest{
+		Blobs:            infos,
+		SignerPubKeyType: gasestimation.PubKeyTypeSecp256k1,
+		FeeGranted:       true,
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("estimating blob cost: %w", err)
+	}
+	for _, fee := range resp.Fees {
+		if fee.TxPriority == gasestimation.TxPriority_TX_PRIORITY_MEDIUM {
+			return resp.EstimatedGasUsed, fee.GasPrice, nil
+		}
+	}
+	return 0, 0, errors.New("blob cost estimate has no medium priority fee")
+}
+
+// isTransientBroadcastErr reports whether the error is a broadcast failure
+// that may succeed when retried, i.e. a full mempool or an unreachable node.
+func isTransientBroadcastErr(err error) bool {
+	var broadcastErr *BroadcastTxError
+	if errors.As(err, &broadcastErr) {
+		return broadcastErr.Code == sdkerrors.ErrMempoolIsFull.ABCICode()
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// recoverSequence sets the worker's sequence to the one expected by the node.
+func (q *BlobQueue) recoverSequence(worker, errorLog string) error {
+	expectedSequence, err := apperrors.ParseExpectedSequence(errorLog)
+	if err != nil {
+		return fmt.Errorf("parsing expected sequence: %w", err)
+	}
+	q.client.mtx.Lock()
+	defer q.client.mtx.Unlock()
+	return q.client.signer.SetSequence(worker, expectedSequence)
+}
+
+// setupWorkers adds the keys of the worker accounts that aren't in the keyring
+// yet and, in a single transaction from the default account, funds the ones
+// that don't exist on chain and grants a feegrant to the ones without one.
+func (q *BlobQueue) setupWorkers(ctx context.Context) error {
+	client := q.client
+	granter := client.DefaultAddress()
+	granterStr, err := client.signer.addressCodec.BytesToString(granter)
+	if err != nil {
+		return err
+	}
+	feegrantClient := feegrant.NewQueryClient(client.conns[0])
+
+	msgs := make([]sdktypes.Msg, 0, 2*len(q.workers))
+	for _, worker := range q.workers {
+		addr, err := q.workerAddress(worker)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = QueryAccount(ctx, client.conns[0], client.registry, addr)
+		switch {
+		case status.Code(err) == codes.NotFound:
+			msgs = append(msgs, bank.NewMsgSend(granter, addr, sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, q.workerBalance))))
+		case err != nil:
+			return fmt.Errorf("querying account of worker %s: %w", worker, err)
+		}
+
+		granteeStr, err := client.signer.addressCodec.BytesToString(addr)
+		if err != nil {
+			return err
+		}
+		// the allowance query fails with an internal error if there is no
+		// allowance, so the allowances of the grantee are listed instead
+		allowances, err := feegrantClient.Allowances(ctx, &feegrant.QueryAllowancesRequest{Grantee: granteeStr})
+		if err != nil {
+			return fmt.Errorf("querying feegrants of worker %s: %w", worker, err)
+		}
+		if !slices.ContainsFunc(allowances.Allowances, func(grant *feegrant.Grant) bool { return grant.Granter == granterStr }) {
+			allowance := &feegrant.BasicAllowance{
+				SpendLimit: sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, q.workerSpendLimit)),
+			}
+			grantMsg, err := feegrant.NewMsgGrantAllowance(allowance, granter, addr)
+			if err != nil {
+				return fmt.Errorf("creating feegrant for worker %s: %w", worker, err)
+			}
+			msgs = append(msgs, grantMsg)
+		}
+	}
+
+	if len(msgs) > 0 {
+		if _, err := client.SubmitTx(ctx, msgs); err != nil {
+			return fmt.Errorf("setting up worker accounts: %w", err)
+		}
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	for _, worker := range q.workers {
+		if err := client.checkAccountLoaded(ctx, worker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// workerAddress returns the address of the worker account. If its key isn't in
+// the TxClient's keyring yet, it is derived from the private key of the
+// default account and the name of the worker and added to the keyring.
+func (q *BlobQueue) workerAddress(worker string) (sdktypes.AccAddress, error) {
+	keys := q.client.signer.keys
+	if record, err := keys.Key(worker); err == nil {
+		return record.GetAddress()
+	}
+
+	defaultRecord, err := keys.Key(q.client.defaultAccount)
+	if err != nil {
+		return nil, err
+	}
+	local := defaultRecord.GetLocal()
+	if local == nil || local.PrivKey == nil {
+		return nil, fmt.Errorf("deriving key for worker %s: the private key of the default account %s is not stored in the keyring", worker, q.client.defaultAccount)
+	}
+	defaultKey, ok := local.PrivKey.GetCachedValue().(cryptotypes.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("deriving key for worker %s: unexpected private key type %T", worker, local.PrivKey.GetCachedValue())
+	}
+	workerKey := secp256k1.GenPrivKeyFromSecret(append(defaultKey.Bytes(), worker...))
+	if err := keys.ImportPrivKeyHex(worker, hex.EncodeToString(workerKey.Bytes()), string(hd.Secp256k1Type)); err != nil {
+		return nil, fmt.Errorf("adding key for worker %s: %w", worker, err)
+	}
+	return sdktypes.AccAddress(workerKey.PubKey().Address()), nil
+}
+
+func blobQueueWorkerName(i int) string { return fmt.Sprintf("blob-queue-worker-%d", i) }
@@ -0,0 +1,117 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobQueue(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping blob queue test in short mode")
+	}
+
+	_, txClient, ctx := setupTxClientWithDefaultParams(t)
+
+	numWorkers := 3
+	queue, err := txClient.NewBlobQueue(ctx.GoContext(), numWorkers)
+	require.NoError(t, err)
+	require.Len(t, queue.Workers(), numWorkers)
+	for _, worker := range queue.Workers() {
+		require.NotNil(t, txClient.Account(worker))
+	}
+
+	submitCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	numSubmissions := 2 * numWorkers
+	submitted := make([][]*share.Blob, numSubmissions)
+	results := make([]<-chan user.BlobResult, numSubmissions)
+	for i := range results {
+		submitted[i] = blobfactory.ManyRandBlobs(random.New(), 1000)
+		results[i] = queue.Submit(submitCtx, submitted[i])
+	}
+
+	serviceClient := sdktx.NewServiceClient(ctx.GRPCClient)
+	heights := make(map[int64]int)
+	signers := make([]string, numSubmissions)
+	sequences := make(map[string]uint64)
+	for i, result := range results {
+		res := <-result
+		require.NoError(t, res.Err)
+		require.Equal(t, abci.CodeTypeOK, res.Response.Code)
+		heights[res.Response.Height]++
+
+		// every submission is included with the blobs that were submitted
+		getTxResp, err := serviceClient.GetTx(ctx.GoContext(), &sdktx.GetTxRequest{Hash: res.Response.TxHash})
+		require.NoError(t, err)
+		require.Len(t, getTxResp.Tx.Body.Messages, 1)
+		var msg blobtypes.MsgPayForBlobs
+		require.NoError(t, msg.Unmarshal(getTxResp.Tx.Body.Messages[0].Value))
+		want, err := blobtypes.NewMsgPayForBlobs(msg.Signer, appconsts.Version, submitted[i]...)
+		require.NoError(t, err)
+		require.Equal(t, want.ShareCommitments, msg.ShareCommitments)
+		signers[i] = msg.Signer
+
+		// submissions are dispatched in order, so each worker signs its
+		// submissions with increasing sequences
+		sequence := getTxResp.Tx.AuthInfo.SignerInfos[0].Sequence
+		if prev, ok := sequences[msg.Signer]; ok {
+			require.Greater(t, sequence, prev)
+		}
+		sequences[msg.Signer] = sequence
+	}
+	// the first submissions are dispatched to idle workers, one each
+	require.Len(t, sequences, numWorkers)
+	for i := range numWorkers {
+		require.NotContains(t, signers[:i], signers[i])
+	}
+	// submissions of different workers are not serialised behind each other
+	require.Less(t, len(heights), numSubmissions)
+
+	// errors that won't go away are returned without retrying
+	res := <-queue.Submit(submitCtx, blobfactory.ManyRandBlobs(random.New(), 1000), user.SetGasLimit(1))
+	var broadcastErr *user.BroadcastTxError
+	require.ErrorAs(t, res.Err, &broadcastErr)
+	require.NotContains(t, res.Err.Error(), "retries")
+
+	queue.Close()
+	res = <-queue.Submit(submitCtx, blobfactory.ManyRandBlobs(random.New(), 1000))
+	require.ErrorIs(t, res.Err, user.ErrBlobQueueClosed)
+
+	// worker keys are derived from the default account, so a new queue
+	// recovers the worker accounts that were already set up even if their keys
+	// are missing from the keyring
+	keys := txClient.Signer().Keyring()
+	addresses := make([]string, numWorkers)
+	for i, worker := range queue.Workers() {
+		record, err := keys.Key(worker)
+		require.NoError(t, err)
+		addr, err := record.GetAddress()
+		require.NoError(t, err)
+		addresses[i] = addr.String()
+		require.NoError(t, keys.Delete(worker))
+	}
+	queue, err = txClient.NewBlobQueue(ctx.GoContext(), numWorkers)
+	require.NoError(t, err)
+	for i, worker := range queue.Workers() {
+		record, err := keys.Key(worker)
+		require.NoError(t, err)
+		addr, err := record.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, addresses[i], addr.String())
+	}
+	res = <-queue.Submit(submitCtx, blobfactory.ManyRandBlobs(random.New(), 1000))
+	require.NoError(t, res.Err)
+	queue.Close()
+}
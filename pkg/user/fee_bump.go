@@ -0,0 +1,230 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cometbft/cometbft/rpc/core"
+	comettypes "github.com/cometbft/cometbft/types"
+)
+
+// feeBumpPolicy describes by how much the TxClient raises the gas price of a
+// transaction that was evicted from the mempool.
+type feeBumpPolicy struct {
+	// maxGasPrice is the gas price that a replacement will never exceed.
+	maxGasPrice float64
+	// step is the minimum increase of the gas price for each replacement.
+	step float64
+}
+
+// WithFeeBumpPolicy makes ConfirmTx replace a transaction that was evicted
+// from the mempool with one that has the same sequence but a higher gas price.
+// The new gas price is the greater of the current price plus step and the
+// price estimated for a higher TxPriority, capped at maxGasPrice; once it is
+// reached, an evicted transaction is resubmitted as is. Every replacement is
+// tracked alongside the transactions it replaces until one of them is
+// committed. Pending transactions are never replaced, as nodes reject a
+// transaction with the same sequence as one already in their mempool.
+func WithFeeBumpPolicy(maxGasPrice, step float64) Option {
+	return func(c *TxClient) {
+		c.feeBumpPolicy = &feeBumpPolicy{
+			maxGasPrice: maxGasPrice,
+			step:        step,
+		}
+	}
+}
+
+// nextGasPrice returns the gas price of the next replacement and false if the
+// gas price can't be increased any further.
+func (p feeBumpPolicy) nextGasPrice(current, estimated float64) (float64, bool) {
+	next := min(max(current+p.step, estimated), p.maxGasPrice)
+	return next, next > current
+}
+
+// confirmTxWithFeeBump waits until the transaction, or a replacement of it, is
+// committed or all of them fail. Whenever the latest replacement is evicted, it
+// is re-signed with a higher gas price. If the TxClient was configured with
+// WithEventSubscription, the candidates are resolved by the committed tx
+// events of their signer and their status is only checked every
+// eventStatusTimeout, else it is polled.
+func (client *TxClient) confirmTxWithFeeBump(ctx context.Context, txHash string) (*TxResponse, error) {
+	txClient := tx.NewTxClient(client.conns[0])
+
+	pollTicker := time.NewTicker(client.pollTime)
+	defer pollTicker.Stop()
+
+	candidates := []string{txHash}
+	priority := gasestimation.TxPriority_TX_PRIORITY_MEDIUM
+
+	// events maps every candidate to the channel of its committed tx event
+	var events map[string]chan comettypes.EventDataTx
+	_, signer, exists := client.GetTxFromTxTracker(txHash)
+	if account := client.Account(signer); client.txEvents != nil && exists && account != nil {
+		if err := client.txEvents.subscribe(ctx, account.Address()); err != nil {
+			return nil, err
+		}
+		events = make(map[string]chan comettypes.EventDataTx)
+		defer func() {
+			for candidate, ch := range events {
+				client.txEvents.unwatch(candidate, ch)
+			}
+		}()
+	}
+	watch := func(candidate string) {
+		if events != nil {
+			events[candidate] = make(chan comettypes.EventDataTx, 1)
+			client.txEvents.watch(candidate, events[candidate])
+		}
+	}
+	watch(txHash)
+
+	// commit returns the response of the committed candidate
+	commit := func(candidate string, height int64, code uint32, errorLog string) (*TxResponse, error) {
+		// the other candidates can never be committed as they share the sequence
+		for _, other := range candidates {
+			if other != candidate {
+				client.deleteFromTxTracker(other)
+			}
+		}
+		return client.handleCommittedTx(candidate, height, code, errorLog)
+	}
+
+	var lastStatusCheck time.Time
+	for {
+		for candidate, ch := range events {
+			select {
+			case data := <-ch:
+				return commit(candidate, data.Height, data.Result.Code, data.Result.Log)
+			default:
+			}
+		}
+
+		if events == nil || time.Since(lastStatusCheck) >= eventStatusTimeout {
+			lastStatusCheck = time.Now()
+			for i := 0; i < len(candidates); i++ {
+				candidate := candidates[i]
+				latest := i == len(candidates)-1
+				resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: candidate})
+				if err != nil {
+					return nil, err
+				}
+
+				switch {
+				case resp.Status == core.TxStatusPending:
+					continue
+				case resp.Status == core.TxStatusCommitted:
+					return commit(candidate, resp.Height, resp.ExecutionCode, resp.Error)
+				case resp.Status == core.TxStatusEvicted && latest:
+					replacement, err := client.bumpFee(ctx, candidate, priority)
+					if err != nil {
+						return nil, err
+					}
+					if replacement == "" {
+						// the gas price can't be raised any further so resubmit as is
+						if txResponse, done, err := client.handleTxStatus(ctx, candidate, resp); done {
+							return txResponse, err
+						}
+						continue
+					}
+					candidates = append(candidates, replacement)
+					watch(replacement)
+					priority = gasestimation.TxPriority_TX_PRIORITY_HIGH
+					// the replacement is checked on the next tick
+					i++
+				case len(candidates) == 1:
+					txResponse, _, err := client.handleTxStatus(ctx, candidate, resp)
+					return txResponse, err
+				default:
+					// a replaced candidate was evicted or dropped; another
+					// candidate with the same sequence may still be committed
+					client.deleteFromTxTracker(candidate)
+					candidates = append(candidates[:i], candidates[i+1:]...)
+					i--
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-pollTicker.C:
+		}
+	}
+}
+
+// bumpFee re-signs the tracked transaction with a higher gas price and
+// broadcasts it without incrementing the signer's sequence. It returns the
+// hash of the replacement or an empty hash if the gas price has reached the
+// maximum allowed by the policy.
+func (client *TxClient) bumpFee(ctx context.Context, txHash string, priority gasestimation.TxPriority) (string, error) {
+	client.mtx.Lock()
+	info, exists := client.txTracker[txHash]
+	client.mtx.Unlock()
+	if !exists {
+		return "", fmt.Errorf("tx: %s not found in txTracker", txHash)
+	}
+
+	currentGasPrice, err := client.txGasPrice(info.txBytes)
+	if err != nil {
+		return "", err
+	}
+	estimatedGasPrice, err := client.EstimateGasPrice(ctx, priority)
+	if err != nil {
+		return "", fmt.Errorf("estimating gas price: %w", err)
+	}
+	gasPrice, ok := client.feeBumpPolicy.nextGasPrice(currentGasPrice, estimatedGasPrice)
+	if !ok {
+		return "", nil
+	}
+
+	client.mtx.Lock()
+	txBytes, err := client.signer.ReplaceTxFee(info.txBytes, gasPrice)
+	client.mtx.Unlock()
+	if err != nil {
+		return "", fmt.Errorf("replacing fee of tx %s: %w", txHash, err)
+	}
+
+	resp, err := client.broadcastTx(ctx, client.conns[0], txBytes, info.signer)
+	if err != nil {
+		return "", fmt.Errorf("broadcasting replacement of tx %s: %w", txHash, err)
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	err = client.setTxInfo(resp.TxHash, txInfo{
+		sequence:  info.sequence,
+		signer:    info.signer,
+		timestamp: time.Now(),
+		txBytes:   txBytes,
+	})
+	if err != nil {
+		return "", fmt.Errorf("persisting tx %s to tx tracker store: %w", resp.TxHash, err)
+	}
+	return resp.TxHash, nil
+}
+
+// txGasPrice returns the gas price paid by the encoded transaction, or blob
+// transaction.
+func (client *TxClient) txGasPrice(txBytes []byte) (float64, error) {
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(txBytes)
+	if isBlob {
+		if err != nil {
+			return 0, err
+		}
+		txBytes = bTx.Tx
+	}
+	sdkTx, err := client.signer.DecodeTx(txBytes)
+	if err != nil {
+		return 0, err
+	}
+	if sdkTx.GetGas() == 0 {
+		return 0, nil
+	}
+	fee := sdkTx.GetFee().AmountOf(appconsts.BondDenom)
+	return float64(fee.Uint64()) / float64(sdkTx.GetGas()), nil
+}
@@ -0,0 +1,318 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/rpc/core"
+	comettypes "github.com/cometbft/cometbft/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestFeeBumpPolicyNextGasPrice(t *testing.T) {
+	policy := feeBumpPolicy{maxGasPrice: 0.1, step: 0.01}
+
+	testCases := []struct {
+		name      string
+		current   float64
+		estimated float64
+		want      float64
+		wantOK    bool
+	}{
+		{name: "step when the estimate is lower", current: 0.004, estimated: 0.002, want: 0.014, wantOK: true},
+		{name: "estimate when it is higher than a step", current: 0.004, estimated: 0.05, want: 0.05, wantOK: true},
+		{name: "capped at the max gas price", current: 0.095, estimated: 0.2, want: 0.1, wantOK: true},
+		{name: "no bump once the max gas price is reached", current: 0.1, estimated: 0.2, want: 0.1, wantOK: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := policy.nextGasPrice(tc.current, tc.estimated)
+			require.Equal(t, tc.wantOK, ok)
+			require.InDelta(t, tc.want, got, 1e-9)
+		})
+	}
+}
+
+func TestReplaceTxFee(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(encCfg.Codec)
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, NewAccount(testfactory.TestAccName, 1, 5))
+	require.NoError(t, err)
+
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), []byte("data"))
+	require.NoError(t, err)
+	gasLimit := uint64(100_000)
+	txBytes, sequence, err := signer.CreatePayForBlobs(testfactory.TestAccName, []*share.Blob{blob}, SetGasLimitAndGasPrice(gasLimit, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+
+	replaced, err := signer.ReplaceTxFee(txBytes, 0.01)
+	require.NoError(t, err)
+	require.NotEqual(t, txBytes, replaced)
+
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(replaced)
+	require.NoError(t, err)
+	require.True(t, isBlob)
+	require.Len(t, bTx.Blobs, 1)
+	require.Equal(t, blob.Data(), bTx.Blobs[0].Data())
+
+	sdkTx, err := signer.DecodeTx(bTx.Tx)
+	require.NoError(t, err)
+	require.Equal(t, gasLimit, sdkTx.GetGas())
+	require.EqualValues(t, 1000, sdkTx.GetFee().AmountOf(appconsts.BondDenom).Int64())
+	sigs, err := sdkTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, sequence, sigs[0].Sequence)
+
+	// the local sequence of the account is left untouched
+	require.EqualValues(t, 5, signer.Account(testfactory.TestAccName).Sequence())
+}
+
+func TestConfirmTxWithFeeBump(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), []byte("data"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		maxGasPrice float64
+		// broadcastCode is the code with which the node rejects broadcasts
+		broadcastCode uint32
+		// wantGasPrice is the gas price of the broadcast tx, if any
+		wantGasPrice float64
+		wantErr      bool
+	}{
+		{
+			name:         "evicted tx is replaced with a higher fee",
+			maxGasPrice:  0.1,
+			wantGasPrice: 0.05, // the estimated gas price exceeds a step
+		},
+		{
+			name:         "evicted tx is resubmitted as is at the max gas price",
+			maxGasPrice:  appconsts.DefaultMinGasPrice,
+			wantGasPrice: appconsts.DefaultMinGasPrice,
+		},
+		{
+			name:          "failed replacement is returned",
+			maxGasPrice:   0.1,
+			broadcastCode: 13,
+			wantErr:       true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &feeBumpNode{broadcastCode: tc.broadcastCode, statuses: make(map[string]string)}
+			conn := node.start(t)
+
+			kr := testfactory.TestKeyring(encCfg.Codec)
+			signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, NewAccount(testfactory.TestAccName, 1, 5))
+			require.NoError(t, err)
+			client, err := NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry,
+				WithFeeBumpPolicy(tc.maxGasPrice, 0.001), WithPollTime(10*time.Millisecond))
+			require.NoError(t, err)
+
+			txBytes, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, []*share.Blob{blob}, SetGasLimitAndGasPrice(100_000, appconsts.DefaultMinGasPrice))
+			require.NoError(t, err)
+			txHash := txHashOf(txBytes)
+			require.NoError(t, client.trackTransaction(testfactory.TestAccName, txHash, txBytes))
+			node.setStatus(txHash, core.TxStatusEvicted)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			resp, err := client.ConfirmTx(ctx, txHash)
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Nil(t, resp)
+				return
+			}
+			require.NoError(t, err)
+
+			broadcasts := node.broadcasts()
+			require.Len(t, broadcasts, 1)
+			gasPrice, err := client.txGasPrice(broadcasts[0])
+			require.NoError(t, err)
+			require.InDelta(t, tc.wantGasPrice, gasPrice, 1e-9)
+			require.Equal(t, txHashOf(broadcasts[0]), resp.TxHash)
+			require.EqualValues(t, 10, resp.Height)
+			require.Empty(t, client.PendingTxs())
+		})
+	}
+
+}
+
+func TestConfirmTxWithFeeBumpAndEvents(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), []byte("data"))
+	require.NoError(t, err)
+
+	node := &feeBumpNode{statuses: make(map[string]string)}
+	conn := node.start(t)
+
+	kr := testfactory.TestKeyring(encCfg.Codec)
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, NewAccount(testfactory.TestAccName, 1, 5))
+	require.NoError(t, err)
+	events := &fakeEventsClient{}
+	client, err := NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry,
+		WithFeeBumpPolicy(0.1, 0.001), WithEventSubscription(events), WithPollTime(10*time.Millisecond))
+	require.NoError(t, err)
+
+	txBytes, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, []*share.Blob{blob}, SetGasLimitAndGasPrice(100_000, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	txHash := txHashOf(txBytes)
+	require.NoError(t, client.trackTransaction(testfactory.TestAccName, txHash, txBytes))
+	node.setStatus(txHash, core.TxStatusEvicted)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var (
+		resp *TxResponse
+		done = make(chan error, 1)
+	)
+	go func() {
+		var err error
+		resp, err = client.ConfirmTx(ctx, txHash)
+		done <- err
+	}()
+
+	// the replacement is confirmed by its committed tx event
+	require.Eventually(t, func() bool { return len(node.broadcasts()) > 0 }, 5*time.Second, 10*time.Millisecond)
+	replacement := node.broadcasts()[0]
+	bTx, _, err := blobtx.UnmarshalBlobTx(replacement)
+	require.NoError(t, err)
+	events.publish(comettypes.EventDataTx{TxResult: abci.TxResult{Height: 10, Tx: bTx.Tx}})
+	require.NoError(t, <-done)
+
+	gasPrice, err := client.txGasPrice(replacement)
+	require.NoError(t, err)
+	require.InDelta(t, 0.05, gasPrice, 1e-9) // the estimated gas price exceeds a step
+	require.Equal(t, txHashOf(replacement), resp.TxHash)
+	require.EqualValues(t, 10, resp.Height)
+	require.Empty(t, client.PendingTxs())
+	// the statuses are only checked when the confirmation starts
+	require.Equal(t, 1, node.statusQueries())
+}
+
+func txHashOf(txBytes []byte) string {
+	if bTx, isBlob, err := blobtx.UnmarshalBlobTx(txBytes); isBlob && err == nil {
+		txBytes = bTx.Tx
+	}
+	return fmt.Sprintf("%X", tmhash.Sum(txBytes))
+}
+
+// feeBumpNode mocks the endpoints of a node used to confirm a transaction
+// with a fee bump policy. Broadcast transactions are committed on the
+// following status query.
+type feeBumpNode struct {
+	sdktx.UnimplementedServiceServer
+	tx.UnimplementedTxServer
+	gasestimation.UnimplementedGasEstimatorServer
+
+	broadcastCode uint32
+
+	mtx      sync.Mutex
+	statuses map[string]string
+	txs      [][]byte
+	attempts int
+	queries  int
+}
+
+func (n *feeBumpNode) start(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	sdktx.RegisterServiceServer(srv, n)
+	tx.RegisterTxServer(srv, n)
+	gasestimation.RegisterGasEstimatorServer(srv, n)
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			panic(err)
+		}
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+	return conn
+}
+
+func (n *feeBumpNode) setStatus(txHash, status string) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.statuses[txHash] = status
+}
+
+func (n *feeBumpNode) broadcasts() [][]byte {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.txs
+}
+
+func (n *feeBumpNode) broadcastAttempts() int {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.attempts
+}
+
+func (n *feeBumpNode) statusQueries() int {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.queries
+}
+
+func (n *feeBumpNode) BroadcastTx(_ context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+	txHash := txHashOf(req.TxBytes)
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.attempts++
+	if n.broadcastCode != abci.CodeTypeOK {
+		return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{TxHash: txHash, Code: n.broadcastCode}}, nil
+	}
+	n.txs = append(n.txs, req.TxBytes)
+	n.statuses[txHash] = core.TxStatusPending
+	return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{TxHash: txHash}}, nil
+}
+
+func (n *feeBumpNode) TxStatus(_ context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.queries++
+	status, ok := n.statuses[req.TxId]
+	if !ok {
+		return &tx.TxStatusResponse{Status: core.TxStatusUnknown}, nil
+	}
+	if status == core.TxStatusPending {
+		n.statuses[req.TxId] = core.TxStatusCommitted
+	}
+	if status == core.TxStatusCommitted {
+		return &tx.TxStatusResponse{Status: status, Height: 10}, nil
+	}
+	return &tx.TxStatusResponse{Status: status}, nil
+}
+
+func (n *feeBumpNode) EstimateGasPrice(context.Context, *gasestimation.EstimateGasPriceRequest) (*gasestimation.EstimateGasPriceResponse, error) {
+	return &gasestimation.EstimateGasPriceResponse{EstimatedGasPrice: 0.05}, nil
+}
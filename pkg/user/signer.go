@@ -142,6 +142,54 @@ func (s *Signer) CreatePayForBlobs(accountName string, blobs []*share.Blob, opts
 	return blobTx, sequence, err
 }
 
+// ReplaceTxFee re-signs a previously created transaction, or blob transaction,
+// with a fee derived from the provided gas price while keeping its gas limit,
+// messages and sequence. It is used to bump the fee of a transaction that was
+// evicted from the mempool.
+func (s *Signer) ReplaceTxFee(txBytes []byte, gasPrice float64) ([]byte, error) {
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(txBytes)
+	if isBlob {
+		if err != nil {
+			return nil, err
+		}
+		txBytes = bTx.Tx
+	}
+
+	tx, err := s.DecodeTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != 1 {
+		return nil, fmt.Errorf("expected a single signature, got %d", len(sigs))
+	}
+
+	builder, err := s.enc.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+	builder = SetGasLimitAndGasPrice(tx.GetGas(), gasPrice)(builder)
+	account, err := s.findAccount(builder)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := s.signTransactionWithSequence(builder, account, sigs[0].Sequence); err != nil {
+		return nil, err
+	}
+
+	txBytes, err = s.EncodeTx(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	if isBlob {
+		return blobtx.MarshalBlobTx(txBytes, bTx.Blobs...)
+	}
+	return txBytes, nil
+}
+
 func (s *Signer) EncodeTx(tx sdktypes.Tx) ([]byte, error) {
 	return s.enc.TxEncoder()(tx)
 }
@@ -282,21 +330,26 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 	if err != nil {
 		return "", 0, err
 	}
+	return s.signTransactionWithSequence(builder, account, account.sequence)
+}
 
+// signTransactionWithSequence signs the transaction using the provided
+// sequence instead of the locally tracked sequence of the account.
+func (s *Signer) signTransactionWithSequence(builder client.TxBuilder, account *Account, sequence uint64) (string, uint64, error) {
 	// a dry run of the signing data
-	err = builder.SetSignatures(signing.SignatureV2{
+	err := builder.SetSignatures(signing.SignatureV2{
 		Data: &signing.SingleSignatureData{
 			SignMode:  defaultSignMode,
 			Signature: nil,
 		},
 		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		Sequence: sequence,
 	})
 	if err != nil {
 		return "", 0, fmt.Errorf("error setting draft signatures: %w", err)
 	}
 
-	signature, err := s.createSignature(builder, account, account.sequence)
+	signature, err := s.createSignature(builder, account, sequence)
 	if err != nil {
 		return "", 0, fmt.Errorf("error creating signature: %w", err)
 	}
@@ -307,13 +360,13 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 			Signature: signature,
 		},
 		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		Sequence: sequence,
 	})
 	if err != nil {
 		return "", 0, fmt.Errorf("error setting signatures: %w", err)
 	}
 
-	return account.name, account.sequence, nil
+	return account.name, sequence, nil
 }
 
 func (s *Signer) createSignature(builder client.TxBuilder, account *Account, sequence uint64) ([]byte, error) {
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v6/app/encoding"
 	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
@@ -21,6 +22,7 @@ import (
 	minfeetypes "github.com/celestiaorg/celestia-app/v6/x/minfee/types"
 	"github.com/celestiaorg/go-square/v2/share"
 	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/core"
 	"github.com/cosmos/cosmos-sdk/client"
 	tmservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
@@ -32,6 +34,8 @@ import (
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -83,6 +87,23 @@ func (e *ExecutionError) Error() string {
 	return fmt.Sprintf("tx execution failed with code %d: %s", e.Code, e.ErrorLog)
 }
 
+// TxTrackerStoreError is an error that occurs when a broadcast transaction
+// can't be persisted to the tx tracker store. The transaction is still tracked
+// in memory and can be confirmed, but isn't reloaded after a restart.
+type TxTrackerStoreError struct {
+	// TxResponse is the response of the broadcast transaction
+	TxResponse *sdktypes.TxResponse
+	Err        error
+}
+
+func (e *TxTrackerStoreError) Error() string {
+	return fmt.Sprintf("persisting tx %s to tx tracker store: %v", e.TxResponse.TxHash, e.Err)
+}
+
+func (e *TxTrackerStoreError) Unwrap() error {
+	return e.Err
+}
+
 // WithPollTime sets a custom polling interval with which to check if a transaction has been submitted
 func WithPollTime(time time.Duration) Option {
 	return func(c *TxClient) {
@@ -134,6 +155,37 @@ func WithAdditionalCoreEndpoints(conns []*grpc.ClientConn) Option {
 	}
 }
 
+// WithTxTrackerStore persists the tx client's tx tracker in the provided
+// store. On construction, the TxClient reloads any transactions that were
+// still pending when the previous process stopped, so that ConfirmTx can
+// resume resubmitting evicted txs and rolling back sequences of rejected txs.
+func WithTxTrackerStore(store TxTrackerStore) Option {
+	return func(c *TxClient) {
+		c.txTrackerStore = store
+	}
+}
+
+// WithLogger sets the logger of the TxClient, which reports failures that
+// don't fail a transaction, like deleting a tx from the tx tracker store.
+func WithLogger(logger log.Logger) Option {
+	return func(c *TxClient) {
+		c.logger = logger
+	}
+}
+
+// WithEventSubscription makes ConfirmTx and ConfirmTxs wait for committed tx
+// events from the node's event bus instead of polling the TxStatus endpoint.
+// The TxClient subscribes once to the events of each of its signers that
+// submits a transaction and keeps the subscription for its lifetime, so the
+// node's max_subscriptions_per_client must allow a subscription per signer.
+// The events client, for example a CometBFT HTTP client connected to the
+// node's websocket, must already be started.
+func WithEventSubscription(events rpcclient.EventsClient) Option {
+	return func(c *TxClient) {
+		c.txEvents = newTxEventSubscriber(events)
+	}
+}
+
 // TxClient is an abstraction for building, signing, and broadcasting Celestia transactions
 // It supports multiple accounts. If none is specified, it will
 // try to use the default account.
@@ -152,8 +204,16 @@ type TxClient struct {
 	defaultAddress sdktypes.AccAddress
 	// txTracker maps the tx hash to the Sequence and signer of the transaction
 	// that was submitted to the chain
-	txTracker           map[string]txInfo
+	txTracker map[string]txInfo
+	// txTrackerStore optionally persists the txTracker across restarts
+	txTrackerStore TxTrackerStore
+	// txEvents optionally receives the committed tx events of the signers for
+	// confirmation
+	txEvents *txEventSubscriber
+	// feeBumpPolicy optionally re-signs stuck transactions at a higher gas price
+	feeBumpPolicy       *feeBumpPolicy
 	gasEstimationClient gasestimation.GasEstimatorClient
+	logger              log.Logger
 }
 
 // NewTxClient returns a new signer using the provided keyring
@@ -187,6 +247,7 @@ func NewTxClient(
 		txTracker:           make(map[string]txInfo),
 		cdc:                 cdc,
 		gasEstimationClient: gasestimation.NewGasEstimatorClient(conn),
+		logger:              log.NewNopLogger(),
 	}
 
 	for _, opt := range options {
@@ -198,6 +259,12 @@ func NewTxClient(
 		txClient.conns = txClient.conns[:3]
 	}
 
+	if txClient.txTrackerStore != nil {
+		if err := txClient.loadTxTracker(); err != nil {
+			return nil, fmt.Errorf("loading tx tracker: %w", err)
+		}
+	}
+
 	return txClient, nil
 }
 
@@ -252,28 +319,22 @@ func SetupTxClient(
 // TxOptions may be provided to set the fee and gas limit.
 func (client *TxClient) SubmitPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
 	resp, err := client.BroadcastPayForBlob(ctx, blobs, opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	return client.ConfirmTx(ctx, resp.TxHash)
+	return client.confirmBroadcastTx(ctx, resp, err)
 }
 
 // SubmitPayForBlobWithAccount forms a transaction from the provided blobs, signs it with the provided account, and submits it to the chain.
 // TxOptions may be provided to set the fee and gas limit.
 func (client *TxClient) SubmitPayForBlobWithAccount(ctx context.Context, account string, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
 	resp, err := client.BroadcastPayForBlobWithAccount(ctx, account, blobs, opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	return client.ConfirmTx(ctx, resp.TxHash)
+	return client.confirmBroadcastTx(ctx, resp, err)
 }
 
 // BroadcastPayForBlob signs and broadcasts a transaction to pay for blobs.
 // It does not confirm that the transaction has been committed on chain.
 // If no gas or gas price is set, it will estimate the gas and use
 // the max effective gas price: max(localMinGasPrice, networkMinGasPrice).
+// If the transaction was broadcast but couldn't be persisted to the tx tracker
+// store, its response is returned together with the error.
 func (client *TxClient) BroadcastPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
 	return client.BroadcastPayForBlobWithAccount(ctx, client.defaultAccount, blobs, opts...)
 }
@@ -310,11 +371,25 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 // may be provided to set the fee and gas limit.
 func (client *TxClient) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*TxResponse, error) {
 	resp, err := client.BroadcastTx(ctx, msgs, opts...)
-	if err != nil {
-		return nil, err
-	}
+	return client.confirmBroadcastTx(ctx, resp, err)
+}
 
-	return client.ConfirmTx(ctx, resp.TxHash)
+// confirmBroadcastTx confirms a broadcast transaction. A transaction that was
+// broadcast but couldn't be persisted to the tx tracker store is confirmed as
+// well, as it no longer needs to be tracked once it is confirmed.
+func (client *TxClient) confirmBroadcastTx(ctx context.Context, resp *sdktypes.TxResponse, broadcastErr error) (*TxResponse, error) {
+	var storeErr *TxTrackerStoreError
+	switch {
+	case errors.As(broadcastErr, &storeErr):
+		resp = storeErr.TxResponse
+	case broadcastErr != nil:
+		return nil, broadcastErr
+	}
+	txResp, err := client.ConfirmTx(ctx, resp.TxHash)
+	if err != nil && storeErr != nil {
+		return nil, errors.Join(err, storeErr)
+	}
+	return txResp, err
 }
 
 func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*sdktypes.TxResponse, error) {
@@ -382,6 +457,10 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 	return client.broadcastTxAndIncrementSequence(ctx, client.conns[0], txBytes, account)
 }
 
+// broadcastTxAndIncrementSequence broadcasts the transaction and increments
+// the signer's sequence. If the transaction was broadcast but couldn't be
+// persisted to the tx tracker store, a TxTrackerStoreError carrying its
+// response is returned so that the caller can still confirm it.
 func (client *TxClient) broadcastTxAndIncrementSequence(ctx context.Context, conn *grpc.ClientConn, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
 	resp, err := client.broadcastTx(ctx, conn, txBytes, signer)
 	if err != nil {
@@ -390,13 +469,16 @@ func (client *TxClient) broadcastTxAndIncrementSequence(ctx context.Context, con
 
 	// save the sequence and signer of the transaction in the local txTracker
 	// before the sequence is incremented
-	client.trackTransaction(signer, resp.TxHash, txBytes)
+	trackErr := client.trackTransaction(signer, resp.TxHash, txBytes)
 
 	// after the transaction has been submitted, we can increment the
 	// sequence of the signer
 	if err := client.signer.IncrementSequence(signer); err != nil {
 		return nil, fmt.Errorf("increment sequencing: %w", err)
 	}
+	if trackErr != nil {
+		return nil, &TxTrackerStoreError{TxResponse: resp, Err: trackErr}
+	}
 
 	return resp, nil
 }
@@ -430,7 +512,13 @@ func (client *TxClient) broadcastTx(ctx context.Context, conn *grpc.ClientConn,
 // broadcastMulti broadcasts the transaction to multiple connections concurrently
 // and returns the response from the first successful broadcast.
 func (client *TxClient) broadcastMulti(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
-	respCh := make(chan *sdktypes.TxResponse, 1)
+	// broadcastResult is a successful broadcast, or the TxTrackerStoreError
+	// of a broadcast that couldn't be persisted to the tx tracker store
+	type broadcastResult struct {
+		resp *sdktypes.TxResponse
+		err  error
+	}
+	respCh := make(chan broadcastResult, 1)
 	errCh := make(chan error, len(client.conns))
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -444,14 +532,15 @@ func (client *TxClient) broadcastMulti(ctx context.Context, txBytes []byte, sign
 			defer wg.Done()
 
 			resp, err := client.broadcastTxAndIncrementSequence(ctx, conn, txBytes, signer)
-			if err != nil {
+			var storeErr *TxTrackerStoreError
+			if err != nil && !errors.As(err, &storeErr) {
 				errCh <- err
 				return
 			}
 
 			// On first successful response, send it and cancel others
 			select {
-			case respCh <- resp:
+			case respCh <- broadcastResult{resp: resp, err: err}:
 				cancel()
 			case <-ctx.Done():
 			}
@@ -464,8 +553,8 @@ func (client *TxClient) broadcastMulti(ctx context.Context, txBytes []byte, sign
 	close(errCh)
 
 	// Return first successful response, if any
-	if resp, ok := <-respCh; ok {
-		return resp, nil
+	if result, ok := <-respCh; ok {
+		return result.resp, result.err
 	}
 
 	// Otherwise, return the first error encountered
@@ -480,15 +569,35 @@ func (client *TxClient) broadcastMulti(ctx context.Context, txBytes []byte, sign
 func (client *TxClient) pruneTxTracker() {
 	for hash, txInfo := range client.txTracker {
 		if time.Since(txInfo.timestamp) >= txTrackerPruningInterval {
-			delete(client.txTracker, hash)
+			client.untrackTransaction(hash)
 		}
 	}
 }
 
-// ConfirmTx periodically pings the provided node for the commitment of a transaction by its
-// hash. It will continually loop until the context is cancelled, the tx is found or an error
-// is encountered.
+// ConfirmTx waits for the commitment of a transaction by its hash. By default,
+// it periodically pings the provided node for the status of the transaction.
+// If the TxClient was configured with WithEventSubscription, it instead waits
+// for the committed tx event of its signer and only checks its status if the
+// event doesn't arrive in time. If the TxClient was configured with WithFeeBumpPolicy,
+// it replaces the transaction with one paying a higher fee when it is evicted
+// from the mempool; the returned response then carries the hash of
+// the transaction that landed. It will continually loop until the context is
+// cancelled, the tx is found or an error is encountered.
 func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxResponse, error) {
+	if client.feeBumpPolicy != nil {
+		return client.confirmTxWithFeeBump(ctx, txHash)
+	}
+	if client.txEvents != nil {
+		resps, errs := client.confirmTxsWithEvents(ctx, []string{txHash})
+		return resps[0], errs[0]
+	}
+	return client.pollTxStatus(ctx, txHash)
+}
+
+// pollTxStatus periodically pings the provided node for the commitment of a
+// transaction by its hash until the context is cancelled, the tx reaches a
+// final state or an error is encountered.
+func (client *TxClient) pollTxStatus(ctx context.Context, txHash string) (*TxResponse, error) {
 	txClient := tx.NewTxClient(client.conns[0])
 
 	pollTicker := time.NewTicker(client.pollTime)
@@ -500,69 +609,100 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 			return nil, err
 		}
 
-		switch resp.Status {
-		case core.TxStatusPending:
+		txResponse, done, err := client.handleTxStatus(ctx, txHash, resp)
+		if done {
+			return txResponse, err
+		}
+		if resp.Status == core.TxStatusPending {
 			// Continue polling if the transaction is still pending
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
 			case <-pollTicker.C:
-				continue
-			}
-		case core.TxStatusCommitted:
-			txResponse := &TxResponse{
-				Height: resp.Height,
-				TxHash: txHash,
-				Code:   resp.ExecutionCode,
-			}
-			if resp.ExecutionCode != abci.CodeTypeOK {
-				executionErr := &ExecutionError{
-					TxHash:   txHash,
-					Code:     resp.ExecutionCode,
-					ErrorLog: resp.Error,
-				}
-				client.deleteFromTxTracker(txHash)
-				return nil, executionErr
-			}
-			client.deleteFromTxTracker(txHash)
-			return txResponse, nil
-		case core.TxStatusEvicted:
-			_, signer, exists := client.GetTxFromTxTracker(txHash)
-			if !exists {
-				return nil, fmt.Errorf("tx: %s not found in txTracker; likely failed during broadcast", txHash)
-			}
-			// Resubmit straight away in the event of eviction and keep polling until tx is committed
-			_, err := client.broadcastTx(ctx, client.conns[0], client.txTracker[txHash].txBytes, signer)
-			if err != nil {
-				return nil, fmt.Errorf("resubmission for evicted tx with hash %s failed: %w", txHash, err)
 			}
-		case core.TxStatusRejected:
-			sequence, signer, exists := client.GetTxFromTxTracker(txHash)
-			if !exists {
-				return nil, fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
-			}
-			// Reset sequence to the rejected tx's sequence to enable resubmission
-			// of subsequent transactions.
-			if err := client.signer.SetSequence(signer, sequence); err != nil {
-				return nil, fmt.Errorf("setting sequence: %w", err)
-			}
-			client.deleteFromTxTracker(txHash)
-			return nil, fmt.Errorf("tx with hash %s was rejected by the node", txHash)
-		default:
-			client.deleteFromTxTracker(txHash)
-			if ctx.Err() != nil {
-				return nil, ctx.Err()
-			}
-			return nil, fmt.Errorf("transaction with hash %s not found", txHash)
 		}
 	}
 }
 
+// handleTxStatus acts upon the status of a transaction returned by the node.
+// It returns done once the transaction has reached a final state, together
+// with either its response or the reason it failed. Evicted transactions are
+// resubmitted straight away and are not considered done.
+func (client *TxClient) handleTxStatus(ctx context.Context, txHash string, resp *tx.TxStatusResponse) (txResponse *TxResponse, done bool, err error) {
+	switch resp.Status {
+	case core.TxStatusPending:
+		return nil, false, nil
+	case core.TxStatusCommitted:
+		txResponse, err := client.handleCommittedTx(txHash, resp.Height, resp.ExecutionCode, resp.Error)
+		return txResponse, true, err
+	case core.TxStatusEvicted:
+		_, signer, exists := client.GetTxFromTxTracker(txHash)
+		if !exists {
+			return nil, true, fmt.Errorf("tx: %s not found in txTracker; likely failed during broadcast", txHash)
+		}
+		// Resubmit straight away in the event of eviction and keep polling until tx is committed
+		_, err := client.broadcastTx(ctx, client.conns[0], client.txTracker[txHash].txBytes, signer)
+		if err != nil {
+			return nil, true, fmt.Errorf("resubmission for evicted tx with hash %s failed: %w", txHash, err)
+		}
+		return nil, false, nil
+	case core.TxStatusRejected:
+		sequence, signer, exists := client.GetTxFromTxTracker(txHash)
+		if !exists {
+			return nil, true, fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
+		}
+		// Reset sequence to the rejected tx's sequence to enable resubmission
+		// of subsequent transactions.
+		if err := client.signer.SetSequence(signer, sequence); err != nil {
+			return nil, true, fmt.Errorf("setting sequence: %w", err)
+		}
+		client.deleteFromTxTracker(txHash)
+		return nil, true, fmt.Errorf("tx with hash %s was rejected by the node", txHash)
+	default:
+		client.deleteFromTxTracker(txHash)
+		if ctx.Err() != nil {
+			return nil, true, ctx.Err()
+		}
+		return nil, true, fmt.Errorf("transaction with hash %s not found", txHash)
+	}
+}
+
+// handleCommittedTx removes a committed transaction from the local tx tracker
+// and returns its response, or an ExecutionError if it failed to execute.
+func (client *TxClient) handleCommittedTx(txHash string, height int64, code uint32, errorLog string) (*TxResponse, error) {
+	client.deleteFromTxTracker(txHash)
+	if code != abci.CodeTypeOK {
+		return nil, &ExecutionError{
+			TxHash:   txHash,
+			Code:     code,
+			ErrorLog: errorLog,
+		}
+	}
+	return &TxResponse{
+		Height: height,
+		TxHash: txHash,
+		Code:   code,
+	}, nil
+}
+
 // deleteFromTxTracker safely deletes a transaction from the local tx tracker.
 func (client *TxClient) deleteFromTxTracker(txHash string) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
+	client.untrackTransaction(txHash)
+}
+
+// untrackTransaction removes a transaction from the local tx tracker and its
+// store. A failure to delete from the store is logged but not fatal: stale
+// entries are pruned the next time the tx tracker is loaded.
+func (client *TxClient) untrackTransaction(txHash string) {
 	delete(client.txTracker, txHash)
+	if client.txTrackerStore == nil {
+		return
+	}
+	if err := client.txTrackerStore.Delete(txHash); err != nil {
+		client.logger.Error("failed to delete tx from tx tracker store", "tx_hash", txHash, "err", err)
+	}
 }
 
 // EstimateGasPriceAndUsage returns the estimated gas price based on the provided priority,
@@ -690,7 +830,10 @@ func (client *TxClient) checkAccountLoaded(ctx context.Context, account string)
 	if err != nil {
 		return fmt.Errorf("querying account %s: %w", account, err)
 	}
-	return client.signer.AddAccount(NewAccount(account, accNum, sequence))
+	if err := client.signer.AddAccount(NewAccount(account, accNum, sequence)); err != nil {
+		return err
+	}
+	return client.restoreSequence(account)
 }
 
 func (client *TxClient) getAccountNameFromMsgs(msgs []sdktypes.Msg) (string, error) {
@@ -717,15 +860,95 @@ func (client *TxClient) getAccountNameFromMsgs(msgs []sdktypes.Msg) (string, err
 	return record.Name, nil
 }
 
-// trackTransaction tracks a transaction in the tx client's local tx tracker.
-func (client *TxClient) trackTransaction(signer, txHash string, txBytes []byte) {
+// trackTransaction tracks a transaction in the tx client's local tx tracker
+// and, if configured, persists it to the tx tracker store.
+func (client *TxClient) trackTransaction(signer, txHash string, txBytes []byte) error {
 	sequence := client.signer.Account(signer).Sequence()
-	client.txTracker[txHash] = txInfo{
+	return client.setTxInfo(txHash, txInfo{
 		sequence:  sequence,
 		signer:    signer,
 		timestamp: time.Now(),
 		txBytes:   txBytes,
+	})
+}
+
+// setTxInfo stores the tx info in the local tx tracker and, if configured,
+// persists it to the tx tracker store.
+func (client *TxClient) setTxInfo(txHash string, info txInfo) error {
+	client.txTracker[txHash] = info
+	if client.txTrackerStore == nil {
+		return nil
+	}
+	return client.txTrackerStore.Set(TrackedTx{
+		TxHash:    txHash,
+		Sequence:  info.sequence,
+		Signer:    info.signer,
+		Timestamp: info.timestamp,
+		TxBytes:   info.txBytes,
+	})
+}
+
+// loadTxTracker populates the local tx tracker from the tx tracker store,
+// prunes expired entries and fast-forwards the sequence of every loaded signer
+// past its pending transactions.
+func (client *TxClient) loadTxTracker() error {
+	txs, err := client.txTrackerStore.All()
+	if err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		client.txTracker[tx.TxHash] = txInfo{
+			sequence:  tx.Sequence,
+			signer:    tx.Signer,
+			timestamp: tx.Timestamp,
+			txBytes:   tx.TxBytes,
+		}
+	}
+	client.pruneTxTracker()
+
+	for name := range client.signer.accounts {
+		if err := client.restoreSequence(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreSequence makes sure that the signer's local sequence for the account
+// is ahead of every pending transaction in the tx tracker. Without it, a
+// restarted TxClient would reuse the sequence of a tx that is still in the
+// mempool, as the sequence queried from state only reflects committed txs.
+func (client *TxClient) restoreSequence(account string) error {
+	acc, exists := client.signer.accounts[account]
+	if !exists {
+		return nil
+	}
+	next := acc.Sequence()
+	for _, info := range client.txTracker {
+		if info.signer == account && info.sequence >= next {
+			next = info.sequence + 1
+		}
+	}
+	return client.signer.SetSequence(account, next)
+}
+
+// PendingTxs returns the transactions in the local tx tracker that have been
+// broadcast but not yet confirmed. After a restart, these can be passed to
+// ConfirmTx to resume confirmation.
+func (client *TxClient) PendingTxs() []TrackedTx {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	txs := make([]TrackedTx, 0, len(client.txTracker))
+	for hash, info := range client.txTracker {
+		txs = append(txs, TrackedTx{
+			TxHash:    hash,
+			Sequence:  info.sequence,
+			Signer:    info.signer,
+			Timestamp: info.timestamp,
+			TxBytes:   info.txBytes,
+		})
 	}
+	return txs
 }
 
 // GetTxFromTxTracker gets transaction info from the tx client's local tx tracker by its hash
@@ -770,7 +993,29 @@ func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float
 	return max(localMinPrice, networkMinPrice), nil
 }
 
+// QueryNetworkMinGasPrice queries the network minimum gas price enforced by the
+// ante handler, which is the adjusted price if the dynamic network min gas
+// price of x/minfee is enabled. Networks that don't serve the x/minfee query
+// fall back to the static param of the params subspace.
 func QueryNetworkMinGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
+	// NOTE: that we don't prove that this is the correct value
+	resp, err := minfeetypes.NewQueryClient(grpcConn).NetworkMinGasPrice(ctx, &minfeetypes.QueryNetworkMinGasPrice{})
+	if status.Code(err) == codes.Unimplemented {
+		return queryNetworkMinGasPriceParam(ctx, grpcConn)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("querying network min gas price: %w", err)
+	}
+	networkMinPrice, err := resp.NetworkMinGasPrice.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("parsing network min gas price: %w", err)
+	}
+	return networkMinPrice, nil
+}
+
+// queryNetworkMinGasPriceParam queries the static network minimum gas price
+// param through the legacy params subspace.
+func queryNetworkMinGasPriceParam(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
 	paramsClient := paramtypes.NewQueryClient(grpcConn)
 	// NOTE: that we don't prove that this is the correct value
 	paramResponse, err := paramsClient.Params(ctx, &paramtypes.QueryParamsRequest{Subspace: minfeetypes.ModuleName, Key: string(minfeetypes.KeyNetworkMinGasPrice)})
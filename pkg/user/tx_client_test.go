@@ -22,13 +22,20 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/test/util/grpctest"
 	"github.com/celestiaorg/celestia-app/v6/test/util/random"
 	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
+	minfeetypes "github.com/celestiaorg/celestia-app/v6/x/minfee/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/core"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -266,6 +273,40 @@ func TestRejections(t *testing.T) {
 	require.False(t, exists)
 }
 
+func TestTxTrackerStoreFailure(t *testing.T) {
+	store := failingTxTrackerStore{TxTrackerStore: user.NewDBTxTrackerStore(dbm.NewMemDB())}
+	_, txClient, ctx := setupTxClientWithDefaultParams(t, user.WithTxTrackerStore(store))
+
+	// a tx that was broadcast but not persisted is returned in the error and
+	// can still be confirmed
+	blobs := blobfactory.ManyRandBlobs(random.New(), 1000)
+	resp, err := txClient.BroadcastPayForBlob(ctx.GoContext(), blobs)
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, errStoreFailure)
+	var storeErr *user.TxTrackerStoreError
+	require.ErrorAs(t, err, &storeErr)
+	confirmResp, err := txClient.ConfirmTx(ctx.GoContext(), storeErr.TxResponse.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, confirmResp.Code)
+
+	// submitting confirms it straight away
+	submitResp, err := txClient.SubmitPayForBlob(ctx.GoContext(), blobs)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, submitResp.Code)
+	require.NotZero(t, submitResp.Height)
+}
+
+var errStoreFailure = errors.New("store failure")
+
+// failingTxTrackerStore is a tx tracker store that fails to store txs.
+type failingTxTrackerStore struct {
+	user.TxTrackerStore
+}
+
+func (failingTxTrackerStore) Set(user.TrackedTx) error {
+	return errStoreFailure
+}
+
 func TestEvictions(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping evictions test in short mode")
@@ -320,6 +361,109 @@ func TestEvictions(t *testing.T) {
 	}
 }
 
+// TestEvictionsWithEventSubscription ensures that a tx client configured with an
+// event subscription resubmits evicted transactions, which don't emit events,
+// once their status is checked after the event timeout.
+func TestEvictionsWithEventSubscription(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping evictions test in short mode")
+	}
+
+	ttlNumBlocks := int64(1)
+	blocksize := int64(1048576) // 1 MiB
+	encCfg, _, ctx := setupTxClient(t, ttlNumBlocks, blocksize)
+	events, ok := ctx.Client.(rpcclient.EventsClient)
+	require.True(t, ok)
+	txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg, user.WithEventSubscription(events))
+	require.NoError(t, err)
+
+	fee := user.SetFee(1e6)
+	gas := user.SetGasLimit(10e6)
+
+	// Submit more transactions than a single block can fit with a 1-block TTL
+	// so that most of them are evicted at least once.
+	txHashes := make([]string, 6)
+	for i := range txHashes {
+		blobs := blobfactory.ManyRandBlobs(random.New(), 500000, 500000, 5000) // ~1MiB per transaction
+		resp, err := txClient.BroadcastPayForBlob(ctx.GoContext(), blobs, fee, gas)
+		require.NoError(t, err)
+		txHashes[i] = resp.TxHash
+	}
+
+	confirmCtx, cancel := context.WithTimeout(ctx.GoContext(), 3*time.Minute)
+	defer cancel()
+	resps, err := txClient.ConfirmTxs(confirmCtx, txHashes...)
+	require.NoError(t, err)
+	for _, resp := range resps {
+		require.Equal(t, abci.CodeTypeOK, resp.Code)
+	}
+}
+
+// TestConfirmTxsWithEventSubscription ensures that a tx client configured with
+// an event subscription confirms a batch of transactions, including ones that
+// fail execution.
+func TestConfirmTxsWithEventSubscription(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping event subscription test in short mode")
+	}
+
+	encCfg, _, ctx := setupTxClientWithDefaultParams(t)
+	events, ok := ctx.Client.(rpcclient.EventsClient)
+	require.True(t, ok)
+	txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg, user.WithEventSubscription(events))
+	require.NoError(t, err)
+
+	fee := user.SetFee(1e6)
+	gas := user.SetGasLimit(1e6)
+
+	txHashes := make([]string, 0, 4)
+	for i := 0; i < 3; i++ {
+		msg := bank.NewMsgSend(txClient.DefaultAddress(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+		resp, err := txClient.BroadcastTx(ctx.GoContext(), []sdk.Msg{msg}, fee, gas)
+		require.NoError(t, err)
+		txHashes = append(txHashes, resp.TxHash)
+	}
+	// this tx fails during execution as no authorization was granted
+	innerMsg := bank.NewMsgSend(testnode.RandomAddress().(sdk.AccAddress), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	execMsg := authz.NewMsgExec(txClient.DefaultAddress(), []sdk.Msg{innerMsg})
+	resp, err := txClient.BroadcastTx(ctx.GoContext(), []sdk.Msg{&execMsg}, fee, gas)
+	require.NoError(t, err)
+	txHashes = append(txHashes, resp.TxHash)
+
+	confirmCtx, cancel := context.WithTimeout(ctx.GoContext(), 30*time.Second)
+	defer cancel()
+	resps, err := txClient.ConfirmTxs(confirmCtx, txHashes...)
+	require.Error(t, err)
+	var execErr *user.ExecutionError
+	require.ErrorAs(t, err, &execErr)
+	require.Equal(t, txHashes[3], execErr.TxHash)
+
+	require.Len(t, resps, len(txHashes))
+	for i, txHash := range txHashes[:3] {
+		require.NotNil(t, resps[i])
+		require.Equal(t, txHash, resps[i].TxHash)
+		require.Equal(t, abci.CodeTypeOK, resps[i].Code)
+		require.True(t, wasRemovedFromTxTracker(txHash, txClient))
+	}
+	require.Nil(t, resps[3])
+	require.True(t, wasRemovedFromTxTracker(txHashes[3], txClient))
+
+	// a duplicate hash gets the same response as the first occurrence
+	resps, err = txClient.ConfirmTxs(confirmCtx, txHashes[0], txHashes[1], txHashes[0])
+	require.NoError(t, err)
+	require.Len(t, resps, 3)
+	require.NotNil(t, resps[2])
+	require.Equal(t, resps[0], resps[2])
+
+	// a single tx is confirmed through the same subscription path
+	msg := bank.NewMsgSend(txClient.DefaultAddress(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	resp, err = txClient.BroadcastTx(ctx.GoContext(), []sdk.Msg{msg}, fee, gas)
+	require.NoError(t, err)
+	confirmTxResp, err := txClient.ConfirmTx(confirmCtx, resp.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, confirmTxResp.Code)
+}
+
 // TestWithEstimatorService ensures that if the WithEstimatorService
 // option is provided to the tx client, the separate gas estimator service is
 // used to estimate gas price and usage instead of the default connection.
@@ -336,6 +480,49 @@ func TestWithEstimatorService(t *testing.T) {
 	assert.Equal(t, uint64(70000), used)
 }
 
+// TestQueryMinimumGasPrice ensures that the minimum gas price is the dynamic
+// network min gas price enforced by the ante handler rather than the static
+// param, and that networks without the x/minfee query fall back to the param.
+func TestQueryMinimumGasPrice(t *testing.T) {
+	testCases := []struct {
+		name       string
+		withMinfee bool
+		want       float64
+	}{
+		{name: "dynamic price exceeds the param", withMinfee: true, want: 0.05},
+		{name: "param without the x/minfee query", withMinfee: false, want: 0.004},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lis, err := net.Listen("tcp", "localhost:0")
+			require.NoError(t, err)
+			grpcCodec := codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()
+			grpcServer := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+			nodeservice.RegisterServiceServer(grpcServer, &mockMinGasPriceServer{localMinGasPrice: "0.002utia"})
+			paramsproposal.RegisterQueryServer(grpcServer, &mockParamsServer{paramMinGasPrice: `"0.004000000000000000"`})
+			if tc.withMinfee {
+				minfeetypes.RegisterQueryServer(grpcServer, &mockMinfeeServer{networkMinGasPrice: sdkmath.LegacyMustNewDecFromStr("0.05")})
+			}
+			go func() {
+				if err := grpcServer.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+					panic(err)
+				}
+			}()
+			t.Cleanup(grpcServer.Stop)
+			conn, err := grpc.NewClient(lis.Addr().String(),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+			)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = conn.Close() })
+
+			gasPrice, err := user.QueryMinimumGasPrice(context.Background(), conn)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, gasPrice)
+		})
+	}
+}
+
 func (suite *TxClientTestSuite) TestGasPriceAndUsageEstimation() {
 	addr := suite.txClient.DefaultAddress()
 	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
@@ -476,6 +663,39 @@ func (m *mockEstimatorServer) EstimateGasPriceAndUsage(
 	}, nil
 }
 
+// mockMinGasPriceServer mocks the node's configured minimum gas price.
+type mockMinGasPriceServer struct {
+	nodeservice.UnimplementedServiceServer
+
+	localMinGasPrice string
+}
+
+func (m *mockMinGasPriceServer) Config(context.Context, *nodeservice.ConfigRequest) (*nodeservice.ConfigResponse, error) {
+	return &nodeservice.ConfigResponse{MinimumGasPrice: m.localMinGasPrice}, nil
+}
+
+// mockParamsServer mocks the static network min gas price param.
+type mockParamsServer struct {
+	paramsproposal.UnimplementedQueryServer
+
+	paramMinGasPrice string
+}
+
+func (m *mockParamsServer) Params(context.Context, *paramsproposal.QueryParamsRequest) (*paramsproposal.QueryParamsResponse, error) {
+	return &paramsproposal.QueryParamsResponse{Param: paramsproposal.ParamChange{Value: m.paramMinGasPrice}}, nil
+}
+
+// mockMinfeeServer mocks the dynamic network min gas price of x/minfee.
+type mockMinfeeServer struct {
+	minfeetypes.UnimplementedQueryServer
+
+	networkMinGasPrice sdkmath.LegacyDec
+}
+
+func (m *mockMinfeeServer) NetworkMinGasPrice(context.Context, *minfeetypes.QueryNetworkMinGasPrice) (*minfeetypes.QueryNetworkMinGasPriceResponse, error) {
+	return &minfeetypes.QueryNetworkMinGasPriceResponse{NetworkMinGasPrice: m.networkMinGasPrice}, nil
+}
+
 func (m *mockEstimatorServer) stop() {
 	m.srv.GracefulStop()
 }
@@ -576,14 +796,14 @@ func (suite *TxClientTestSuite) TestMultiConnBroadcast() {
 				conn2 := grpctest.StartMockServer(t, mockSvc2)
 				conn3 := grpctest.StartMockServer(t, mockSvc3)
 				return []*grpctest.MockTxService{
-						mockSvc1,
-						mockSvc2,
-						mockSvc3,
-					}, []*grpc.ClientConn{
-						conn1,
-						conn2,
-						conn3,
-					}
+					mockSvc1,
+					mockSvc2,
+					mockSvc3,
+				}, []*grpc.ClientConn{
+					conn1,
+					conn2,
+					conn3,
+				}
 			},
 			expectError: false,
 		},
@@ -602,14 +822,14 @@ func (suite *TxClientTestSuite) TestMultiConnBroadcast() {
 				conn2 := grpctest.StartMockServer(t, mockSvc2)
 				conn3 := grpctest.StartMockServer(t, mockSvc3)
 				return []*grpctest.MockTxService{
-						mockSvc1,
-						mockSvc2,
-						mockSvc3,
-					}, []*grpc.ClientConn{
-						conn1,
-						conn2,
-						conn3,
-					}
+					mockSvc1,
+					mockSvc2,
+					mockSvc3,
+				}, []*grpc.ClientConn{
+					conn1,
+					conn2,
+					conn3,
+				}
 			},
 			expectError: true,
 		},
@@ -630,14 +850,14 @@ func (suite *TxClientTestSuite) TestMultiConnBroadcast() {
 				conn2 := grpctest.StartMockServer(t, mockSvc2)
 				conn3 := grpctest.StartMockServer(t, mockSvc3)
 				return []*grpctest.MockTxService{
-						mockSvc1,
-						mockSvc2,
-						mockSvc3,
-					}, []*grpc.ClientConn{
-						conn1,
-						conn2,
-						conn3,
-					}
+					mockSvc1,
+					mockSvc2,
+					mockSvc3,
+				}, []*grpc.ClientConn{
+					conn1,
+					conn2,
+					conn3,
+				}
 			},
 			expectError: true,
 		},
@@ -652,12 +872,12 @@ func (suite *TxClientTestSuite) TestMultiConnBroadcast() {
 				conn1 := grpctest.StartMockServer(t, mockSvc1)
 				conn2 := grpctest.StartMockServer(t, mockSvc2)
 				return []*grpctest.MockTxService{
-						mockSvc1,
-						mockSvc2,
-					}, []*grpc.ClientConn{
-						conn1,
-						conn2,
-					}
+					mockSvc1,
+					mockSvc2,
+				}, []*grpc.ClientConn{
+					conn1,
+					conn2,
+				}
 			},
 			expectError: false,
 		},
@@ -0,0 +1,315 @@
+package user
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// eventStatusTimeout is how long a transaction awaits its committed tx
+	// event before its status is checked. Evicted and rejected transactions
+	// don't emit events and a subscription may silently stop delivering
+	// them, e.g. when the websocket connection drops.
+	eventStatusTimeout = 10 * time.Second
+	// eventBufferSize is the capacity of a subscription's channel. Nodes drop
+	// subscribers that don't keep up with the event stream, so it needs to
+	// absorb the events of every tx of a signer in a block.
+	eventBufferSize = 1000
+)
+
+// subscriberCount is used to give every TxClient a unique subscriber name.
+var subscriberCount atomic.Uint64
+
+// txEventSubscriber receives the committed tx events of the TxClient's
+// signers and hands them to the confirmations awaiting them. It keeps one
+// long-lived subscription per signer address, shared by all confirmations,
+// so that concurrent confirmations don't each open their own subscriptions.
+// Nodes limit the number of subscriptions per client, see
+// max_subscriptions_per_client in CometBFT's RPC config.
+type txEventSubscriber struct {
+	events     rpcclient.EventsClient
+	subscriber string
+
+	mtx sync.Mutex
+	// subscribed contains the addresses of the signers whose events are
+	// received.
+	subscribed map[string]bool
+	// waiters maps the normalised hash of every awaited tx to the channels
+	// of the confirmations awaiting it.
+	waiters map[string][]chan<- comettypes.EventDataTx
+}
+
+func newTxEventSubscriber(events rpcclient.EventsClient) *txEventSubscriber {
+	return &txEventSubscriber{
+		events:     events,
+		subscriber: fmt.Sprintf("txclient-%d", subscriberCount.Add(1)),
+		subscribed: make(map[string]bool),
+		waiters:    make(map[string][]chan<- comettypes.EventDataTx),
+	}
+}
+
+// txEventQuery returns the query that matches the committed txs signed by the
+// address, including txs that failed execution.
+func txEventQuery(address sdktypes.AccAddress) string {
+	return fmt.Sprintf("%s AND %s.%s CONTAINS '%s/'", comettypes.EventQueryTx, sdktypes.EventTypeTx, sdktypes.AttributeKeyAccountSequence, address)
+}
+
+// subscribe makes sure that the committed tx events of the address are
+// received. A subscription that was closed is established again.
+func (s *txEventSubscriber) subscribe(ctx context.Context, address sdktypes.AccAddress) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.subscribed[address.String()] {
+		return nil
+	}
+	// the subscription outlives the confirmation that establishes it
+	events, err := s.events.Subscribe(context.WithoutCancel(ctx), s.subscriber, txEventQuery(address), eventBufferSize)
+	if err != nil {
+		return fmt.Errorf("subscribing to the tx events of %s: %w", address, err)
+	}
+	s.subscribed[address.String()] = true
+	go s.dispatch(address.String(), events)
+	return nil
+}
+
+// dispatch hands the events of a subscription to the confirmations awaiting
+// them until the subscription is closed.
+func (s *txEventSubscriber) dispatch(address string, events <-chan coretypes.ResultEvent) {
+	for event := range events {
+		data, ok := event.Data.(comettypes.EventDataTx)
+		if !ok {
+			continue
+		}
+		hash := fmt.Sprintf("%X", comettypes.Tx(data.Tx).Hash())
+		s.mtx.Lock()
+		// the channels have room for every tx they await so this never
+		// blocks
+		for _, ch := range s.waiters[hash] {
+			ch <- data
+		}
+		delete(s.waiters, hash)
+		s.mtx.Unlock()
+	}
+	s.mtx.Lock()
+	delete(s.subscribed, address)
+	s.mtx.Unlock()
+}
+
+// watch hands the committed tx event of the hash to ch, which must have room
+// for every tx it awaits.
+func (s *txEventSubscriber) watch(hash string, ch chan<- comettypes.EventDataTx) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	hash = strings.ToUpper(hash)
+	s.waiters[hash] = append(s.waiters[hash], ch)
+}
+
+// unwatch stops handing the committed tx event of the hash to ch.
+func (s *txEventSubscriber) unwatch(hash string, ch chan<- comettypes.EventDataTx) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	hash = strings.ToUpper(hash)
+	waiters := slices.DeleteFunc(s.waiters[hash], func(w chan<- comettypes.EventDataTx) bool { return w == ch })
+	if len(waiters) == 0 {
+		delete(s.waiters, hash)
+	} else {
+		s.waiters[hash] = waiters
+	}
+}
+
+// ConfirmTxs waits for the commitment of multiple transactions by their
+// hashes. If the TxClient was configured with WithEventSubscription, the
+// hashes are resolved by the committed tx events of their signers, else each
+// hash is polled concurrently. The returned responses are in the same order as
+// the provided hashes; the response of a transaction that failed is nil and
+// its error is joined into the returned error. A hash that is provided more
+// than once is confirmed once and all of its positions get the same response.
+func (client *TxClient) ConfirmTxs(ctx context.Context, txHashes ...string) ([]*TxResponse, error) {
+	// indexes maps every provided hash to its position in uniqueHashes
+	indexes := make([]int, len(txHashes))
+	uniqueHashes := make([]string, 0, len(txHashes))
+	seen := make(map[string]int, len(txHashes))
+	for i, txHash := range txHashes {
+		j, ok := seen[strings.ToUpper(txHash)]
+		if !ok {
+			j = len(uniqueHashes)
+			seen[strings.ToUpper(txHash)] = j
+			uniqueHashes = append(uniqueHashes, txHash)
+		}
+		indexes[i] = j
+	}
+
+	var (
+		uniqueResps []*TxResponse
+		errs        []error
+	)
+	if client.txEvents != nil {
+		uniqueResps, errs = client.confirmTxsWithEvents(ctx, uniqueHashes)
+	} else {
+		uniqueResps, errs = client.pollTxsStatus(ctx, uniqueHashes)
+	}
+
+	resps := make([]*TxResponse, len(txHashes))
+	for i, j := range indexes {
+		resps[i] = uniqueResps[j]
+	}
+	return resps, errors.Join(errs...)
+}
+
+// pollTxsStatus concurrently polls the status of each transaction.
+func (client *TxClient) pollTxsStatus(ctx context.Context, txHashes []string) ([]*TxResponse, []error) {
+	resps := make([]*TxResponse, len(txHashes))
+	errs := make([]error, len(txHashes))
+
+	var wg sync.WaitGroup
+	wg.Add(len(txHashes))
+	for i, txHash := range txHashes {
+		go func(i int, txHash string) {
+			defer wg.Done()
+			resps[i], errs[i] = client.pollTxStatus(ctx, txHash)
+		}(i, txHash)
+	}
+	wg.Wait()
+	return resps, errs
+}
+
+// confirmTxsWithEvents resolves the provided hashes as the committed tx events
+// of their signers arrive. The status of the pending transactions is checked
+// once when they start to be awaited, to resolve txs committed before the
+// subscription, and then every eventStatusTimeout, which resubmits evicted
+// transactions. Transactions that aren't in the local tx tracker, so whose
+// signer is unknown, are polled instead. The hashes must be unique.
+func (client *TxClient) confirmTxsWithEvents(ctx context.Context, txHashes []string) ([]*TxResponse, []error) {
+	resps := make([]*TxResponse, len(txHashes))
+	errs := make([]error, len(txHashes))
+	// pending maps the normalised hash of every unresolved tx to its index
+	pending := make(map[string]int, len(txHashes))
+	addresses := make(map[string]sdktypes.AccAddress)
+	var untracked []int
+	for i, txHash := range txHashes {
+		_, signer, exists := client.GetTxFromTxTracker(txHash)
+		account := client.Account(signer)
+		if !exists || account == nil {
+			untracked = append(untracked, i)
+			continue
+		}
+		pending[strings.ToUpper(txHash)] = i
+		addresses[account.Address().String()] = account.Address()
+	}
+
+	var wg sync.WaitGroup
+	if len(untracked) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hashes := make([]string, len(untracked))
+			for j, i := range untracked {
+				hashes[j] = txHashes[i]
+			}
+			polledResps, polledErrs := client.pollTxsStatus(ctx, hashes)
+			for j, i := range untracked {
+				resps[i], errs[i] = polledResps[j], polledErrs[j]
+			}
+		}()
+	}
+	defer wg.Wait()
+
+	events := make(chan comettypes.EventDataTx, len(pending))
+	for _, i := range pending {
+		client.txEvents.watch(txHashes[i], events)
+		defer client.txEvents.unwatch(txHashes[i], events)
+	}
+
+	// subscribe establishes the subscriptions of the signers of the pending
+	// txs, again if they were closed.
+	subscribe := func() error {
+		for _, address := range addresses {
+			if err := client.txEvents.subscribe(ctx, address); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	txClient := tx.NewTxClient(client.conns[0])
+	// reconcile checks the status of every pending tx. It must run after the
+	// subscriptions are established so that a tx committed in between is not
+	// missed.
+	reconcile := func() {
+		// evicted txs are resubmitted in the order of their sequences so that
+		// a resubmission doesn't skip the sequence of another evicted tx
+		sequences := make(map[string]uint64, len(pending))
+		for hash, i := range pending {
+			sequences[hash], _, _ = client.GetTxFromTxTracker(txHashes[i])
+		}
+		hashes := slices.SortedFunc(maps.Keys(pending), func(a, b string) int {
+			return cmp.Compare(sequences[a], sequences[b])
+		})
+		for _, hash := range hashes {
+			i := pending[hash]
+			resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHashes[i]})
+			if err != nil {
+				errs[i] = err
+				delete(pending, hash)
+				continue
+			}
+			txResponse, done, err := client.handleTxStatus(ctx, txHashes[i], resp)
+			if done {
+				resps[i], errs[i] = txResponse, err
+				delete(pending, hash)
+			}
+		}
+	}
+	// failPending fails every pending tx with the error.
+	failPending := func(err error) {
+		for hash, i := range pending {
+			errs[i] = err
+			delete(pending, hash)
+		}
+	}
+
+	if err := subscribe(); err != nil {
+		failPending(err)
+		return resps, errs
+	}
+	reconcile()
+
+	statusTicker := time.NewTicker(eventStatusTimeout)
+	defer statusTicker.Stop()
+
+	for len(pending) > 0 {
+		select {
+		case <-ctx.Done():
+			failPending(ctx.Err())
+		case data := <-events:
+			hash := fmt.Sprintf("%X", comettypes.Tx(data.Tx).Hash())
+			i, ok := pending[hash]
+			if !ok {
+				continue
+			}
+			resps[i], errs[i] = client.handleCommittedTx(txHashes[i], data.Height, data.Result.Code, data.Result.Log)
+			delete(pending, hash)
+		case <-statusTicker.C:
+			if err := subscribe(); err != nil {
+				failPending(err)
+				break
+			}
+			reconcile()
+		}
+	}
+	return resps, errs
+}
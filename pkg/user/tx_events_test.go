@@ -0,0 +1,170 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestConfirmTxsWithSharedSubscription(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	node := &pendingTxNode{}
+	conn := node.start(t)
+	events := &fakeEventsClient{}
+
+	kr := testfactory.TestKeyring(encCfg.Codec)
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, NewAccount(testfactory.TestAccName, 1, 5))
+	require.NoError(t, err)
+	client, err := NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry, WithEventSubscription(events))
+	require.NoError(t, err)
+
+	txs := make([][]byte, 4)
+	for i := range txs {
+		txs[i] = []byte(fmt.Sprintf("tx-%d", i))
+		require.NoError(t, client.trackTransaction(testfactory.TestAccName, txHashOf(txs[i]), txs[i]))
+	}
+
+	// every tx is confirmed by a separate, concurrent call
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resps := make([]*TxResponse, len(txs))
+	errs := make([]error, len(txs))
+	var wg sync.WaitGroup
+	for i := range txs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resps[i], errs[i] = client.ConfirmTx(ctx, txHashOf(txs[i]))
+		}(i)
+	}
+
+	// the status of every tx is checked once after it started to be awaited
+	require.Eventually(t, func() bool { return node.statusQueries() == len(txs) }, 5*time.Second, 10*time.Millisecond)
+	for i, txBytes := range txs {
+		events.publish(comettypes.EventDataTx{TxResult: abci.TxResult{Height: int64(10 + i), Tx: txBytes}})
+	}
+	wg.Wait()
+
+	for i := range txs {
+		require.NoError(t, errs[i])
+		require.Equal(t, txHashOf(txs[i]), resps[i].TxHash)
+		require.EqualValues(t, 10+i, resps[i].Height)
+	}
+	require.Empty(t, client.PendingTxs())
+	require.Equal(t, len(txs), node.statusQueries())
+
+	// all confirmations share one subscription to the signer's txs
+	address := signer.Account(testfactory.TestAccName).Address()
+	require.Equal(t, []string{txEventQuery(address)}, events.queries())
+}
+
+// fakeEventsClient publishes the events passed to publish to every
+// subscription.
+type fakeEventsClient struct {
+	mtx  sync.Mutex
+	subs map[string]chan coretypes.ResultEvent
+	qs   []string
+}
+
+func (c *fakeEventsClient) Subscribe(_ context.Context, _, query string, outCapacity ...int) (<-chan coretypes.ResultEvent, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.subs == nil {
+		c.subs = make(map[string]chan coretypes.ResultEvent)
+	}
+	ch := make(chan coretypes.ResultEvent, outCapacity[0])
+	c.subs[query] = ch
+	c.qs = append(c.qs, query)
+	return ch, nil
+}
+
+func (c *fakeEventsClient) Unsubscribe(_ context.Context, _, query string) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	close(c.subs[query])
+	delete(c.subs, query)
+	return nil
+}
+
+func (c *fakeEventsClient) UnsubscribeAll(ctx context.Context, subscriber string) error {
+	for _, query := range c.queries() {
+		if err := c.Unsubscribe(ctx, subscriber, query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *fakeEventsClient) publish(data comettypes.EventDataTx) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for _, ch := range c.subs {
+		ch <- coretypes.ResultEvent{Data: data}
+	}
+}
+
+func (c *fakeEventsClient) queries() []string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return append([]string(nil), c.qs...)
+}
+
+// pendingTxNode mocks the TxStatus endpoint of a node where every tx stays
+// pending.
+type pendingTxNode struct {
+	tx.UnimplementedTxServer
+
+	mtx     sync.Mutex
+	queries int
+}
+
+func (n *pendingTxNode) start(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	tx.RegisterTxServer(srv, n)
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			panic(err)
+		}
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+	return conn
+}
+
+func (n *pendingTxNode) statusQueries() int {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.queries
+}
+
+func (n *pendingTxNode) TxStatus(context.Context, *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.queries++
+	return &tx.TxStatusResponse{Status: core.TxStatusPending}, nil
+}
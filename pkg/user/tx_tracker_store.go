@@ -0,0 +1,108 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// txTrackerKeyPrefix is prepended to the hash of every tx persisted by the
+// DBTxTrackerStore.
+var txTrackerKeyPrefix = []byte("tx/")
+
+// TrackedTx is a transaction that was broadcast by the TxClient but has not
+// yet been confirmed. It holds everything the TxClient needs to resubmit the
+// transaction on eviction or to roll back the signer's sequence on rejection.
+type TrackedTx struct {
+	TxHash    string    `json:"tx_hash"`
+	Sequence  uint64    `json:"sequence"`
+	Signer    string    `json:"signer"`
+	Timestamp time.Time `json:"timestamp"`
+	TxBytes   []byte    `json:"tx_bytes"`
+}
+
+// TxTrackerStore persists the transactions tracked by the TxClient so that
+// they survive a restart of the process. Implementations must be safe for
+// concurrent use.
+type TxTrackerStore interface {
+	// Set stores the tracked tx, overwriting any existing entry with the same hash.
+	Set(tx TrackedTx) error
+	// Delete removes the tracked tx with the given hash. Deleting a hash that
+	// is not stored is not an error.
+	Delete(txHash string) error
+	// All returns every tracked tx in the store.
+	All() ([]TrackedTx, error)
+}
+
+var _ TxTrackerStore = (*DBTxTrackerStore)(nil)
+
+// DBTxTrackerStore is a TxTrackerStore backed by a key-value database.
+type DBTxTrackerStore struct {
+	db dbm.DB
+}
+
+// NewDBTxTrackerStore returns a TxTrackerStore that persists tracked txs in
+// the provided database.
+func NewDBTxTrackerStore(db dbm.DB) *DBTxTrackerStore {
+	return &DBTxTrackerStore{db: db}
+}
+
+// NewFileTxTrackerStore opens (or creates) an on-disk tx tracker store in the
+// provided directory. The caller is responsible for calling Close once the
+// TxClient is no longer in use.
+func NewFileTxTrackerStore(dir string) (*DBTxTrackerStore, error) {
+	db, err := dbm.NewGoLevelDB("tx_tracker", dir, dbm.OptionsMap{})
+	if err != nil {
+		return nil, fmt.Errorf("opening tx tracker db in %s: %w", dir, err)
+	}
+	return NewDBTxTrackerStore(db), nil
+}
+
+// Set implements TxTrackerStore. Writes are synced to disk so that a tracked
+// tx is not lost if the process crashes right after broadcasting it.
+func (s *DBTxTrackerStore) Set(tx TrackedTx) error {
+	if tx.TxHash == "" {
+		return errors.New("tracked tx hash cannot be empty")
+	}
+	bz, err := json.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("marshalling tracked tx %s: %w", tx.TxHash, err)
+	}
+	return s.db.SetSync(txTrackerKey(tx.TxHash), bz)
+}
+
+// Delete implements TxTrackerStore.
+func (s *DBTxTrackerStore) Delete(txHash string) error {
+	return s.db.DeleteSync(txTrackerKey(txHash))
+}
+
+// All implements TxTrackerStore.
+func (s *DBTxTrackerStore) All() ([]TrackedTx, error) {
+	iter, err := dbm.IteratePrefix(s.db, txTrackerKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var txs []TrackedTx
+	for ; iter.Valid(); iter.Next() {
+		var tx TrackedTx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return nil, fmt.Errorf("unmarshalling tracked tx %s: %w", iter.Key(), err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs, iter.Error()
+}
+
+// Close closes the underlying database.
+func (s *DBTxTrackerStore) Close() error {
+	return s.db.Close()
+}
+
+func txTrackerKey(txHash string) []byte {
+	return append(append([]byte{}, txTrackerKeyPrefix...), txHash...)
+}
@@ -0,0 +1,83 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBTxTrackerStore(t *testing.T) {
+	store, err := NewFileTxTrackerStore(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, store.Close()) })
+
+	txs, err := store.All()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	tx := TrackedTx{
+		TxHash:    "hash",
+		Sequence:  7,
+		Signer:    "signer",
+		Timestamp: time.Now().UTC().Truncate(time.Second),
+		TxBytes:   []byte{1, 2, 3},
+	}
+	require.NoError(t, store.Set(tx))
+
+	txs, err = store.All()
+	require.NoError(t, err)
+	require.Equal(t, []TrackedTx{tx}, txs)
+
+	require.NoError(t, store.Delete(tx.TxHash))
+	txs, err = store.All()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	// deleting a tx that is not tracked is a no-op
+	require.NoError(t, store.Delete("unknown"))
+	require.Error(t, store.Set(TrackedTx{}))
+}
+
+func TestLoadTxTracker(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	kr := testfactory.TestKeyring(encCfg.Codec)
+	// the sequence queried from state lags behind the pending txs
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, NewAccount(testfactory.TestAccName, 1, 3))
+	require.NoError(t, err)
+
+	store := NewDBTxTrackerStore(dbm.NewMemDB())
+	for _, tx := range []TrackedTx{
+		{TxHash: "pending-3", Sequence: 3, Signer: testfactory.TestAccName, Timestamp: time.Now()},
+		{TxHash: "pending-4", Sequence: 4, Signer: testfactory.TestAccName, Timestamp: time.Now()},
+		{TxHash: "expired", Sequence: 10, Signer: testfactory.TestAccName, Timestamp: time.Now().Add(-txTrackerPruningInterval)},
+	} {
+		require.NoError(t, store.Set(tx))
+	}
+
+	txClient := &TxClient{
+		signer:         signer,
+		txTracker:      make(map[string]txInfo),
+		txTrackerStore: store,
+	}
+	require.NoError(t, txClient.loadTxTracker())
+
+	require.Len(t, txClient.PendingTxs(), 2)
+	seq, name, exists := txClient.GetTxFromTxTracker("pending-4")
+	require.True(t, exists)
+	require.Equal(t, testfactory.TestAccName, name)
+	require.EqualValues(t, 4, seq)
+
+	// the expired tx is pruned from both the tracker and the store
+	_, _, exists = txClient.GetTxFromTxTracker("expired")
+	require.False(t, exists)
+	stored, err := store.All()
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+
+	// the next tx must not reuse the sequence of a pending tx
+	require.EqualValues(t, 5, signer.Account(testfactory.TestAccName).Sequence())
+}
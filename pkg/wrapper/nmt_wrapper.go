@@ -46,6 +46,7 @@ type Tree interface {
 	Root() ([]byte, error)
 	Push(namespacedData namespace.PrefixedData) error
 	ProveRange(start, end int) (nmt.Proof, error)
+	ProveNamespace(nID namespace.ID) (nmt.Proof, error)
 }
 
 // NewErasuredNamespacedMerkleTree creates a new ErasuredNamespacedMerkleTree
@@ -128,6 +129,13 @@ func (w *ErasuredNamespacedMerkleTree) ProveRange(start, end int) (nmt.Proof, er
 	return w.tree.ProveRange(start, end)
 }
 
+// ProveNamespace returns a Merkle proof of the leaves of the namespace. If the
+// namespace is within the namespace range of the tree but has no leaves, an
+// absence proof is returned.
+func (w *ErasuredNamespacedMerkleTree) ProveNamespace(nID namespace.ID) (nmt.Proof, error) {
+	return w.tree.ProveNamespace(nID)
+}
+
 // incrementShareIndex increments the share index by one.
 func (w *ErasuredNamespacedMerkleTree) incrementShareIndex() {
 	w.shareIndex++
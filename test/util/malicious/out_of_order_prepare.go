@@ -40,6 +40,7 @@ func (a *App) OutOfOrderPrepareProposal(req *abci.RequestPrepareProposal) (*abci
 		ante.DefaultSigVerificationGasConsumer,
 		a.IBCKeeper,
 		a.MinFeeKeeper,
+		a.NsRegistryKeeper,
 		&a.CircuitKeeper,
 		a.GovParamFilters(),
 	)
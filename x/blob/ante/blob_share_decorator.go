@@ -6,7 +6,6 @@ import (
 	"cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
-	"github.com/celestiaorg/go-square/v2/share"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
@@ -60,7 +59,7 @@ func (d BlobShareDecorator) validateMsgs(msgs []sdk.Msg, txSize uint32, maxBlobS
 		}
 
 		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
-			if sharesNeeded := getSharesNeeded(txSize, pfb.BlobSizes); sharesNeeded > maxBlobShares {
+			if sharesNeeded := blobtypes.SharesNeeded(txSize, pfb.BlobSizes); sharesNeeded > maxBlobShares {
 				return errors.Wrapf(blobtypes.ErrBlobsTooLarge, "the number of shares occupied by blobs in this MsgPayForBlobs %d exceeds the max number of shares available for blob data %d", sharesNeeded, maxBlobShares)
 			}
 		}
@@ -72,7 +71,7 @@ func (d BlobShareDecorator) validateMsgs(msgs []sdk.Msg, txSize uint32, maxBlobS
 func (d BlobShareDecorator) getMaxBlobShares(ctx sdk.Context) int {
 	squareSize := d.getMaxSquareSize(ctx)
 	totalShares := squareSize * squareSize
-	// the shares used up by the tx are calculated in `getSharesNeeded`
+	// the shares used up by the tx are calculated in `blobtypes.SharesNeeded`
 	return totalShares
 }
 
@@ -82,13 +81,3 @@ func (d BlobShareDecorator) getMaxSquareSize(ctx sdk.Context) int {
 	hardMax := appconsts.GetSquareSizeUpperBound(ctx.ChainID())
 	return min(int(govMax), hardMax)
 }
-
-// getSharesNeeded returns the total number of shares needed to represent all of
-// the blobs described by blobSizes along with the shares used by the tx
-func getSharesNeeded(txSize uint32, blobSizes []uint32) (sum int) {
-	sum = share.CompactSharesNeeded(txSize)
-	for _, blobSize := range blobSizes {
-		sum += share.SparseSharesNeeded(blobSize)
-	}
-	return sum
-}
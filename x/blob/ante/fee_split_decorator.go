@@ -0,0 +1,64 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeSplitKeeper routes a fraction of the fee of a PayForBlobs away from the
+// fee collector.
+type FeeSplitKeeper interface {
+	SplitFee(ctx sdk.Context, feePayer sdk.AccAddress, fee sdk.Coins) error
+}
+
+// FeeSplitDecorator routes the fractions of the fee of a tx containing a
+// MsgPayForBlobs set by the blob params to a burn, to the community pool and to
+// the fee recipient. It must run after the fee is deducted from the fee payer
+// and after the signatures are verified. It only splits fees from
+// types.FeeSplitAppVersion onwards.
+type FeeSplitDecorator struct {
+	k FeeSplitKeeper
+}
+
+func NewFeeSplitDecorator(k FeeSplitKeeper) FeeSplitDecorator {
+	return FeeSplitDecorator{k}
+}
+
+// AnteHandle implements the AnteHandler interface. It splits the fee of the tx
+// if it contains a MsgPayForBlobs.
+func (d FeeSplitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App < types.FeeSplitAppVersion || !containsPFB(tx.GetMsgs()) {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+	if feeTx.GetFee().IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	if granter := feeTx.FeeGranter(); len(granter) != 0 {
+		feePayer = granter
+	}
+	if err := d.k.SplitFee(ctx, feePayer, feeTx.GetFee()); err != nil {
+		return ctx, errors.Wrap(err, "failed to split the fee of the PayForBlobs")
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// containsPFB returns true if one of the msgs is a MsgPayForBlobs. PayForBlobs
+// can't be nested in an authz MsgExec.
+func containsPFB(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if _, ok := msg.(*types.MsgPayForBlobs); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proto/tendermint/version"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeSplitDecorator(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer := sdk.AccAddress(make([]byte, 20))
+	granter := sdk.AccAddress(append(make([]byte, 19), 1))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000))
+
+	testCases := []struct {
+		name         string
+		msg          sdk.Msg
+		fee          sdk.Coins
+		granter      sdk.AccAddress
+		appVersion   uint64
+		wantSplit    bool
+		wantFeePayer sdk.AccAddress
+	}{
+		{
+			name:         "PFB",
+			msg:          &blob.MsgPayForBlobs{Signer: signer.String()},
+			fee:          fee,
+			wantSplit:    true,
+			wantFeePayer: signer,
+		},
+		{
+			name:       "PFB before the fee split app version",
+			msg:        &blob.MsgPayForBlobs{Signer: signer.String()},
+			fee:        fee,
+			appVersion: blob.FeeSplitAppVersion - 1,
+		},
+		{
+			name:         "PFB paid by a fee granter",
+			msg:          &blob.MsgPayForBlobs{Signer: signer.String()},
+			fee:          fee,
+			granter:      granter,
+			wantSplit:    true,
+			wantFeePayer: granter,
+		},
+		{
+			name: "PFB without fee",
+			msg:  &blob.MsgPayForBlobs{Signer: signer.String()},
+		},
+		{
+			name: "not a PFB",
+			msg:  banktypes.NewMsgSend(signer, granter, fee),
+			fee:  fee,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := enc.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg))
+			builder.SetFeeAmount(tc.fee)
+			builder.SetFeeGranter(tc.granter)
+
+			appVersion := tc.appVersion
+			if appVersion == 0 {
+				appVersion = blob.FeeSplitAppVersion
+			}
+			ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Version: version.Consensus{App: appVersion}})
+
+			keeper := &mockFeeSplitKeeper{}
+			decorator := ante.NewFeeSplitDecorator(keeper)
+			_, err := decorator.AnteHandle(ctx, builder.GetTx(), false, mockNext)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantSplit, keeper.called)
+			if tc.wantSplit {
+				require.Equal(t, tc.wantFeePayer, keeper.feePayer)
+				require.Equal(t, tc.fee, keeper.fee)
+			}
+		})
+	}
+}
+
+type mockFeeSplitKeeper struct {
+	called   bool
+	feePayer sdk.AccAddress
+	fee      sdk.Coins
+}
+
+func (k *mockFeeSplitKeeper) SplitFee(_ sdk.Context, feePayer sdk.AccAddress, fee sdk.Coins) error {
+	k.called = true
+	k.feePayer = feePayer
+	k.fee = fee
+	return nil
+}
@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryFeeSplitTotals())
 
 	return cmd
 }
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryFeeSplitTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-split-totals",
+		Short: "shows the cumulative PayForBlobs fees burned, sent to the community pool and sent to the fee recipient",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeSplitTotals(context.Background(), &types.QueryFeeSplitTotalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SplitFee routes the fractions of the fee of a PayForBlobs set by the params
+// from the fee collector to a burn, to the community pool and to the fee
+// recipient. The rest of the fee stays in the fee collector and is
+// distributed to stakers. The fee must already be deducted from the fee
+// payer.
+func (k Keeper) SplitFee(ctx sdk.Context, feePayer sdk.AccAddress, fee sdk.Coins) error {
+	params := k.GetParams(ctx)
+	burned := splitCoins(fee, params.FeeBurnFraction)
+	communityPool := splitCoins(fee, params.FeeCommunityPoolFraction)
+	recipient := splitCoins(fee, params.FeeRecipientFraction)
+	if burned.IsZero() && communityPool.IsZero() && recipient.IsZero() {
+		return nil
+	}
+
+	if !burned.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burned); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return err
+		}
+	}
+	if !communityPool.IsZero() {
+		feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, feeCollector); err != nil {
+			return err
+		}
+	}
+	if !recipient.IsZero() {
+		recipientAddr, err := sdk.AccAddressFromBech32(params.FeeRecipient)
+		if err != nil {
+			return err
+		}
+		// the params reject blocked addresses but the blocked addresses can
+		// change in an upgrade. The fraction of a blocked fee recipient stays
+		// in the fee collector instead of failing every PFB.
+		if k.isBlockedAddr(recipientAddr) {
+			recipient = sdk.NewCoins()
+		} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipientAddr, recipient); err != nil {
+			return err
+		}
+	}
+
+	totals := k.GetFeeSplitTotals(ctx)
+	totals.Burned = totals.Burned.Add(burned...)
+	totals.CommunityPool = totals.CommunityPool.Add(communityPool...)
+	totals.Recipient = totals.Recipient.Add(recipient...)
+	k.SetFeeSplitTotals(ctx, totals)
+
+	return ctx.EventManager().EmitTypedEvent(
+		types.NewFeeSplitEvent(feePayer.String(), burned, communityPool, recipient, params.FeeRecipient),
+	)
+}
+
+// ValidateFeeRecipient returns an error if the fee recipient of the params is a
+// blocked address. Sending coins to a blocked address fails so it would fail
+// the fee split of every PFB.
+func (k Keeper) ValidateFeeRecipient(params types.Params) error {
+	if params.FeeRecipient == "" {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(params.FeeRecipient)
+	if err != nil {
+		return err
+	}
+	if k.isBlockedAddr(addr) {
+		return fmt.Errorf("fee recipient %s is not allowed to receive funds", params.FeeRecipient)
+	}
+	return nil
+}
+
+// isBlockedAddr returns true if the address is not allowed to be the fee
+// recipient.
+func (k Keeper) isBlockedAddr(addr sdk.AccAddress) bool {
+	if k.blockedAddrs[addr.String()] {
+		return true
+	}
+	return k.bankKeeper != nil && k.bankKeeper.BlockedAddr(addr)
+}
+
+// GetFeeSplitTotals returns the cumulative amounts of the fees of PayForBlobs
+// burned, sent to the community pool and sent to the fee recipient.
+func (k Keeper) GetFeeSplitTotals(ctx sdk.Context) types.FeeSplitTotals {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.FeeSplitTotalsKey))
+	var totals types.FeeSplitTotals
+	if len(bz) == 0 {
+		return totals
+	}
+	k.cdc.MustUnmarshal(bz, &totals)
+	return totals
+}
+
+// SetFeeSplitTotals sets the cumulative amounts of the fees of PayForBlobs
+// routed away from the fee collector.
+func (k Keeper) SetFeeSplitTotals(ctx sdk.Context, totals types.FeeSplitTotals) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.FeeSplitTotalsKey), k.cdc.MustMarshal(&totals))
+}
+
+// splitCoins returns the fraction of the coins rounded down. An unset
+// fraction is treated as zero.
+func splitCoins(coins sdk.Coins, fraction math.LegacyDec) sdk.Coins {
+	if !types.IsPositiveFraction(fraction) {
+		return sdk.NewCoins()
+	}
+	split := sdk.NewCoins()
+	for _, coin := range coins {
+		amount := coin.Amount.ToLegacyDec().Mul(fraction).TruncateInt()
+		split = split.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return split
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitFee(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false)
+	k := testApp.BlobKeeper
+	feePayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	feeCollector := testApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fee := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(1001)))
+
+	fundFeeCollector := func() {
+		require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+		require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fee))
+	}
+
+	// by default the whole fee stays in the fee collector
+	fundFeeCollector()
+	before := testApp.BankKeeper.GetBalance(ctx, feeCollector, appconsts.BondDenom)
+	require.NoError(t, k.SplitFee(ctx, feePayer, fee))
+	require.Equal(t, before, testApp.BankKeeper.GetBalance(ctx, feeCollector, appconsts.BondDenom))
+	require.Equal(t, types.FeeSplitTotals{}, k.GetFeeSplitTotals(ctx))
+
+	params := k.GetParams(ctx)
+	params.FeeBurnFraction = math.LegacyMustNewDecFromStr("0.5")
+	params.FeeCommunityPoolFraction = math.LegacyMustNewDecFromStr("0.2")
+	params.FeeRecipientFraction = math.LegacyMustNewDecFromStr("0.1")
+	params.FeeRecipient = recipient.String()
+	require.NoError(t, params.Validate())
+	k.SetParams(ctx, params)
+
+	supplyBefore := testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom)
+	feePoolBefore, err := testApp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+
+	fundFeeCollector()
+	before = testApp.BankKeeper.GetBalance(ctx, feeCollector, appconsts.BondDenom)
+	require.NoError(t, k.SplitFee(ctx, feePayer, fee))
+
+	// the fractions are rounded down
+	burned := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(500)))
+	communityPool := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(200)))
+	recipientAmount := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(100)))
+	require.Equal(t, before.Amount.SubRaw(800), testApp.BankKeeper.GetBalance(ctx, feeCollector, appconsts.BondDenom).Amount)
+	require.Equal(t, supplyBefore.Amount.AddRaw(1001).SubRaw(500), testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom).Amount)
+	require.Equal(t, recipientAmount, testApp.BankKeeper.GetAllBalances(ctx, recipient))
+	feePoolAfter, err := testApp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, feePoolBefore.CommunityPool.Add(sdk.NewDecCoinsFromCoins(communityPool...)...), feePoolAfter.CommunityPool)
+
+	totals := k.GetFeeSplitTotals(ctx)
+	require.Equal(t, burned, totals.Burned)
+	require.Equal(t, communityPool, totals.CommunityPool)
+	require.Equal(t, recipientAmount, totals.Recipient)
+
+	resp, err := k.FeeSplitTotals(ctx, &types.QueryFeeSplitTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, totals, resp.Totals)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventFeeSplit{}) {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestBlockedFeeRecipient(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false)
+	k := testApp.BlobKeeper
+	feePayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	feeCollector := testApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	distribution := authtypes.NewModuleAddress("distribution")
+	fee := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(1000)))
+
+	params := k.GetParams(ctx)
+	params.FeeRecipientFraction = math.LegacyMustNewDecFromStr("0.5")
+	params.FeeRecipient = distribution.String()
+	require.NoError(t, params.Validate())
+
+	_, err := k.UpdateBlobParams(ctx, types.NewMsgUpdateBlobParams(k.GetAuthority(), params))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Error(t, k.InitGenesis(ctx, types.GenesisState{Params: params}))
+
+	// a fee recipient that became blocked after the params were set, e.g. in
+	// an upgrade, doesn't fail the fee split.
+	k.SetParams(ctx, params)
+	require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fee))
+	before := testApp.BankKeeper.GetBalance(ctx, feeCollector, appconsts.BondDenom)
+	distributionBefore := testApp.BankKeeper.GetBalance(ctx, distribution, appconsts.BondDenom)
+	require.NoError(t, k.SplitFee(ctx, feePayer, fee))
+	require.Equal(t, before, testApp.BankKeeper.GetBalance(ctx, feeCollector, appconsts.BondDenom))
+	require.Equal(t, distributionBefore, testApp.BankKeeper.GetBalance(ctx, distribution, appconsts.BondDenom))
+}
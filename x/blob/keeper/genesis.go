@@ -14,7 +14,11 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	if err := genState.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid blob genesis state parameters: %w", err)
 	}
+	if err := k.ValidateFeeRecipient(genState.Params); err != nil {
+		return fmt.Errorf("invalid blob genesis state parameters: %w", err)
+	}
 	k.SetParams(sdkCtx, genState.Params)
+	k.SetFeeSplitTotals(sdkCtx, genState.FeeSplitTotals)
 	return nil
 }
 
@@ -23,5 +27,6 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(sdkCtx)
+	genesis.FeeSplitTotals = k.GetFeeSplitTotals(sdkCtx)
 	return genesis
 }
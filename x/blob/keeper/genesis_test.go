@@ -3,14 +3,19 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		FeeSplitTotals: types.FeeSplitTotals{
+			Burned: sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(100))),
+		},
 	}
 
 	k, _, ctx := CreateKeeper(t, appconsts.Version)
@@ -19,4 +24,5 @@ func TestGenesis(t *testing.T) {
 	got := k.ExportGenesis(ctx)
 	require.NotNil(t, got)
 	require.Equal(t, types.DefaultParams(), got.Params)
+	require.Equal(t, genesisState.FeeSplitTotals, got.FeeSplitTotals)
 }
@@ -1,7 +1,23 @@
 package keeper
 
 import (
+	"context"
+
 	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
+
+// FeeSplitTotals returns the cumulative amounts of the fees of PayForBlobs
+// routed away from the fee collector.
+func (k Keeper) FeeSplitTotals(c context.Context, req *types.QueryFeeSplitTotalsRequest) (*types.QueryFeeSplitTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeSplitTotalsResponse{Totals: k.GetFeeSplitTotals(ctx)}, nil
+}
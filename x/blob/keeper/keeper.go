@@ -22,14 +22,22 @@ type Keeper struct {
 	cdc            codec.Codec
 	storeKey       storetypes.StoreKey
 	legacySubspace paramtypes.Subspace
+	bankKeeper     types.BankKeeper
+	distrKeeper    types.DistributionKeeper
 	authority      string
+	// blockedAddrs are the addresses that can't be the fee recipient, e.g.
+	// module accounts.
+	blockedAddrs map[string]bool
 }
 
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
 	legacySubspace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 	authority string,
+	blockedAddrs map[string]bool,
 ) *Keeper {
 	if !legacySubspace.HasKeyTable() {
 		legacySubspace = legacySubspace.WithKeyTable(types.ParamKeyTable())
@@ -39,7 +47,10 @@ func NewKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		legacySubspace: legacySubspace,
+		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
 		authority:      authority,
+		blockedAddrs:   blockedAddrs,
 	}
 }
 
@@ -76,6 +87,9 @@ func (k Keeper) UpdateBlobParams(goCtx context.Context, msg *types.MsgUpdateBlob
 	if err := msg.Params.Validate(); err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
 	}
+	if err := k.ValidateFeeRecipient(msg.Params); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
+	}
 
 	k.SetParams(ctx, msg.Params)
 
@@ -106,7 +106,10 @@ func CreateKeeper(t *testing.T, version uint64) (*keeper.Keeper, store.CommitMul
 		cdc,
 		blobStoreKey,
 		paramsSubspace,
+		nil,
+		nil,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
 	)
 
 	// TODO: this should be changed to k.SetParams after migrations have been run.
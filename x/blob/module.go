@@ -72,7 +72,10 @@ func (am AppModule) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
 
-	return genState.Validate()
+	if err := genState.Validate(); err != nil {
+		return err
+	}
+	return am.keeper.ValidateFeeRecipient(genState.Params)
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -142,33 +144,125 @@ func (m *EventUpdateBlobParams) GetParams() Params {
 	return Params{}
 }
 
+// EventFeeSplit defines an event that is emitted when a fraction of the fee of
+// a PayForBlobs transaction is routed away from the fee collector.
+type EventFeeSplit struct {
+	// fee_payer is the address that paid the fee.
+	FeePayer      string                                   `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	Burned        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	Recipient     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=recipient,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recipient"`
+	// fee_recipient is the address that received the recipient amount.
+	FeeRecipient string `protobuf:"bytes,5,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+}
+
+func (m *EventFeeSplit) Reset()         { *m = EventFeeSplit{} }
+func (m *EventFeeSplit) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSplit) ProtoMessage()    {}
+func (*EventFeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{2}
+}
+func (m *EventFeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSplit.Merge(m, src)
+}
+func (m *EventFeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSplit proto.InternalMessageInfo
+
+func (m *EventFeeSplit) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *EventFeeSplit) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *EventFeeSplit) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *EventFeeSplit) GetRecipient() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *EventFeeSplit) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventPayForBlobs)(nil), "celestia.blob.v1.EventPayForBlobs")
 	proto.RegisterType((*EventUpdateBlobParams)(nil), "celestia.blob.v1.EventUpdateBlobParams")
+	proto.RegisterType((*EventFeeSplit)(nil), "celestia.blob.v1.EventFeeSplit")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/event.proto", fileDescriptor_9d90f0a63835a06e) }
 
 var fileDescriptor_9d90f0a63835a06e = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x2c, 0x86, 0x29, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x10, 0x69, 0xa5, 0x4c, 0x2e, 0x01, 0x57,
-	0x90, 0xa9, 0x01, 0x89, 0x95, 0x6e, 0xf9, 0x45, 0x4e, 0x39, 0xf9, 0x49, 0xc5, 0x42, 0x62, 0x5c,
-	0x6c, 0xc5, 0x99, 0xe9, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x50, 0x9e,
-	0x90, 0x2c, 0x17, 0x17, 0xc8, 0x8c, 0xf8, 0xe2, 0xcc, 0xaa, 0xd4, 0x62, 0x09, 0x26, 0x05, 0x66,
-	0x0d, 0xde, 0x20, 0x4e, 0x90, 0x48, 0x30, 0x48, 0x40, 0x48, 0x8e, 0x8b, 0x2b, 0x2f, 0x31, 0x37,
-	0xb5, 0xb8, 0x20, 0x31, 0x39, 0xb5, 0x58, 0x82, 0x59, 0x81, 0x59, 0x83, 0x27, 0x08, 0x49, 0x44,
-	0x29, 0x9d, 0x4b, 0x14, 0x6c, 0x55, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x2a, 0xc8, 0xaa, 0x00, 0xb0,
-	0x4b, 0x70, 0xda, 0x67, 0xc6, 0xc5, 0x06, 0x71, 0xab, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0x84, 0x1e, 0xba, 0x9f, 0xf5, 0x20, 0x26, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55,
-	0xed, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30, 0xb3, 0xf2, 0x8b, 0xd2, 0xe1, 0x6c,
-	0xdd, 0xc4, 0x82, 0x02, 0xfd, 0x0a, 0x48, 0x48, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x83, 0xc9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x10, 0x54, 0xa1, 0x8a, 0x8d, 0x01, 0x00, 0x00,
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x75, 0x54, 0xd4, 0x5b, 0xd1, 0x64, 0x01, 0x0a, 0x83, 0x65, 0x55, 0xb9, 0xe4,
+	0x32, 0x7b, 0x1d, 0x12, 0x0f, 0x50, 0xc4, 0x0e, 0x9c, 0xaa, 0x4c, 0x5c, 0xb8, 0x54, 0x4e, 0xfa,
+	0x35, 0x58, 0x24, 0xf9, 0x2c, 0xdb, 0xad, 0x08, 0x4f, 0xc1, 0x85, 0x97, 0xe0, 0x49, 0x76, 0xdc,
+	0x91, 0x13, 0xa0, 0xf6, 0x45, 0x90, 0x9d, 0xac, 0x9b, 0x40, 0xdc, 0x7a, 0x8a, 0xfd, 0xfd, 0x9d,
+	0xef, 0x67, 0xff, 0x6c, 0xf2, 0x22, 0x83, 0x02, 0x8c, 0x95, 0x82, 0xa7, 0x05, 0xa6, 0x7c, 0x35,
+	0xe6, 0xb0, 0x82, 0xca, 0x32, 0xa5, 0xd1, 0x22, 0x3d, 0xba, 0x4d, 0x99, 0x4b, 0xd9, 0x6a, 0x7c,
+	0xfc, 0x38, 0xc7, 0x1c, 0x7d, 0xc8, 0xdd, 0xa8, 0x59, 0x77, 0x7c, 0xf2, 0x4f, 0x17, 0x25, 0xb4,
+	0x28, 0x4d, 0x1b, 0x47, 0x19, 0x9a, 0x12, 0x0d, 0x4f, 0x85, 0x01, 0xbe, 0x1a, 0xa7, 0x60, 0xc5,
+	0x98, 0x67, 0x28, 0xab, 0x26, 0x1f, 0x49, 0x72, 0xf4, 0xd6, 0x51, 0xa7, 0xa2, 0xbe, 0x44, 0x3d,
+	0x29, 0x30, 0x35, 0xf4, 0x29, 0xe9, 0x19, 0x99, 0x57, 0xa0, 0xc3, 0x60, 0x18, 0xc4, 0xfd, 0xa4,
+	0x9d, 0xd1, 0x13, 0x42, 0x1c, 0x63, 0x66, 0xe4, 0x17, 0x30, 0xe1, 0xde, 0xb0, 0x1b, 0x0f, 0x92,
+	0xbe, 0xab, 0x5c, 0xb9, 0x02, 0x8d, 0x08, 0xa9, 0x44, 0x09, 0x46, 0x89, 0x0c, 0x4c, 0xd8, 0x1d,
+	0x76, 0xe3, 0xc3, 0xe4, 0x5e, 0x65, 0x94, 0x93, 0x27, 0x1e, 0xf5, 0x5e, 0xcd, 0x85, 0x05, 0x87,
+	0x9a, 0xfa, 0x9d, 0xfe, 0x97, 0xf7, 0x9a, 0xf4, 0x9a, 0xb3, 0x84, 0x7b, 0xc3, 0x20, 0x3e, 0xb8,
+	0x08, 0xd9, 0xdf, 0x4e, 0x58, 0xd3, 0x61, 0xb2, 0x7f, 0xfd, 0xf3, 0xb4, 0x93, 0xb4, 0xab, 0x47,
+	0xdf, 0xba, 0x64, 0xe0, 0x49, 0x97, 0x00, 0x57, 0xaa, 0x90, 0x96, 0x3e, 0x27, 0xfd, 0x05, 0xc0,
+	0x4c, 0x89, 0x7a, 0x0b, 0x79, 0xb8, 0x00, 0x98, 0xba, 0x39, 0xcd, 0x48, 0x2f, 0x5d, 0xea, 0x0a,
+	0xe6, 0xfe, 0x48, 0x07, 0x17, 0xcf, 0x58, 0xe3, 0x8c, 0x39, 0x67, 0xac, 0x75, 0xc6, 0xde, 0xa0,
+	0xac, 0x26, 0xe7, 0x8e, 0xf3, 0xfd, 0xd7, 0x69, 0x9c, 0x4b, 0xfb, 0x71, 0x99, 0xb2, 0x0c, 0x4b,
+	0xde, 0x0a, 0x6e, 0x3e, 0x67, 0x66, 0xfe, 0x89, 0xdb, 0x5a, 0x81, 0xf1, 0x3f, 0x98, 0xa4, 0x6d,
+	0x4d, 0x35, 0x79, 0x94, 0x61, 0x59, 0x2e, 0x2b, 0x69, 0xeb, 0x99, 0x42, 0x2c, 0xbc, 0xa0, 0x1d,
+	0xc3, 0x06, 0x5b, 0xc4, 0x14, 0xb1, 0xa0, 0x92, 0xf4, 0x35, 0x64, 0x52, 0x49, 0xa8, 0x6c, 0xb8,
+	0xbf, 0x7b, 0xdc, 0x5d, 0x77, 0xfa, 0x92, 0x0c, 0x9c, 0xe0, 0x3b, 0xdc, 0x03, 0x2f, 0xf9, 0x70,
+	0x01, 0x90, 0xdc, 0xd6, 0x26, 0xef, 0xae, 0xd7, 0x51, 0x70, 0xb3, 0x8e, 0x82, 0xdf, 0xeb, 0x28,
+	0xf8, 0xba, 0x89, 0x3a, 0x37, 0x9b, 0xa8, 0xf3, 0x63, 0x13, 0x75, 0x3e, 0x9c, 0xdf, 0x67, 0xb6,
+	0x77, 0x8c, 0x3a, 0xdf, 0x8e, 0xcf, 0x84, 0x52, 0xfc, 0x73, 0xf3, 0xc2, 0xfd, 0x0e, 0xd2, 0x9e,
+	0x7f, 0xbe, 0xaf, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x04, 0xbc, 0xee, 0x1b, 0x45, 0x03, 0x00,
+	0x00,
 }
 
 func (m *EventPayForBlobs) Marshal() (dAtA []byte, err error) {
@@ -268,6 +362,85 @@ func (m *EventUpdateBlobParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipient) > 0 {
+		for iNdEx := len(m.Recipient) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -320,6 +493,41 @@ func (m *EventUpdateBlobParams) Size() (n int) {
 	return n
 }
 
+func (m *EventFeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Recipient) > 0 {
+		for _, e := range m.Recipient {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -631,6 +839,222 @@ func (m *EventUpdateBlobParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient, types.Coin{})
+			if err := m.Recipient[len(m.Recipient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -25,3 +26,14 @@ func NewUpdateBlobParamsEvent(authority string, params Params) *EventUpdateBlobP
 		Params: params,
 	}
 }
+
+// NewFeeSplitEvent returns a new EventFeeSplit
+func NewFeeSplitEvent(feePayer string, burned, communityPool, recipient sdk.Coins, feeRecipient string) *EventFeeSplit {
+	return &EventFeeSplit{
+		FeePayer:      feePayer,
+		Burned:        burned,
+		CommunityPool: communityPool,
+		Recipient:     recipient,
+		FeeRecipient:  feeRecipient,
+	}
+}
@@ -1,17 +1,37 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:         DefaultParams(),
+		FeeSplitTotals: FeeSplitTotals{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	return gs.FeeSplitTotals.Validate()
+}
+
+// Validate checks that the totals are valid coins.
+func (t FeeSplitTotals) Validate() error {
+	if err := t.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned fees: %w", err)
+	}
+	if err := t.CommunityPool.Validate(); err != nil {
+		return fmt.Errorf("invalid community pool fees: %w", err)
+	}
+	if err := t.Recipient.Validate(); err != nil {
+		return fmt.Errorf("invalid fee recipient fees: %w", err)
+	}
+	return nil
 }
@@ -12,6 +12,10 @@ const (
 
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
+
+	// FeeSplitTotalsKey defines the key used for storing the cumulative
+	// amounts of the fees of PayForBlobs routed away from the fee collector.
+	FeeSplitTotalsKey = "fee_split_totals"
 )
 
 func KeyPrefix(p string) []byte {
@@ -3,8 +3,10 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/go-square/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -25,11 +27,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams creates a new Params instance
+// NewParams creates a new Params instance. All the fees of PayForBlobs go to
+// the fee collector.
 func NewParams(gasPerBlobByte uint32, govMaxSquareSize uint64) Params {
 	return Params{
-		GasPerBlobByte:   gasPerBlobByte,
-		GovMaxSquareSize: govMaxSquareSize,
+		GasPerBlobByte:           gasPerBlobByte,
+		GovMaxSquareSize:         govMaxSquareSize,
+		FeeBurnFraction:          math.LegacyZeroDec(),
+		FeeCommunityPoolFraction: math.LegacyZeroDec(),
+		FeeRecipientFraction:     math.LegacyZeroDec(),
 	}
 }
 
@@ -52,7 +58,57 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
-	return validateGovMaxSquareSize(p.GovMaxSquareSize)
+	err = validateGovMaxSquareSize(p.GovMaxSquareSize)
+	if err != nil {
+		return err
+	}
+	return p.validateFeeSplit()
+}
+
+// FeeSplitAppVersion is the first app version in which the fee of PayForBlobs
+// is split. It is activated by the upgrade to it signalled through x/signal.
+// Earlier app versions leave the whole fee in the fee collector regardless of
+// the fee split params so that their state transitions don't change.
+const FeeSplitAppVersion uint64 = 7
+
+// validateFeeSplit validates the fee split params. Unset fractions, e.g. of
+// params read from the legacy subspace, are treated as zero.
+func (p Params) validateFeeSplit() error {
+	total := math.LegacyZeroDec()
+	fractions := []struct {
+		name  string
+		value math.LegacyDec
+	}{
+		{"fee burn fraction", p.FeeBurnFraction},
+		{"fee community pool fraction", p.FeeCommunityPoolFraction},
+		{"fee recipient fraction", p.FeeRecipientFraction},
+	}
+	for _, fraction := range fractions {
+		if fraction.value.IsNil() {
+			continue
+		}
+		if fraction.value.IsNegative() || fraction.value.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("%s must be in the range [0, 1]: %s", fraction.name, fraction.value)
+		}
+		total = total.Add(fraction.value)
+	}
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("sum of the fee fractions cannot exceed 1: %s", total)
+	}
+
+	if p.FeeRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(p.FeeRecipient); err != nil {
+			return fmt.Errorf("invalid fee recipient: %w", err)
+		}
+	} else if IsPositiveFraction(p.FeeRecipientFraction) {
+		return fmt.Errorf("fee recipient must be set if the fee recipient fraction is positive")
+	}
+	return nil
+}
+
+// IsPositiveFraction returns true if the fraction is set and positive.
+func IsPositiveFraction(fraction math.LegacyDec) bool {
+	return !fraction.IsNil() && fraction.IsPositive()
 }
 
 // String implements the Stringer interface.
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +31,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	GasPerBlobByte   uint32 `protobuf:"varint,1,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty" yaml:"gas_per_blob_byte"`
 	GovMaxSquareSize uint64 `protobuf:"varint,2,opt,name=gov_max_square_size,json=govMaxSquareSize,proto3" json:"gov_max_square_size,omitempty" yaml:"gov_max_square_size"`
+	// fee_burn_fraction is the fraction, in the range [0, 1], of the fees of
+	// PayForBlobs transactions that is burned.
+	FeeBurnFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_fraction" yaml:"fee_burn_fraction"`
+	// fee_community_pool_fraction is the fraction, in the range [0, 1], of the
+	// fees of PayForBlobs transactions that is sent to the community pool.
+	FeeCommunityPoolFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee_community_pool_fraction,json=feeCommunityPoolFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_community_pool_fraction" yaml:"fee_community_pool_fraction"`
+	// fee_recipient_fraction is the fraction, in the range [0, 1], of the fees
+	// of PayForBlobs transactions that is sent to fee_recipient.
+	FeeRecipientFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=fee_recipient_fraction,json=feeRecipientFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_recipient_fraction" yaml:"fee_recipient_fraction"`
+	// fee_recipient is the address that receives fee_recipient_fraction of the
+	// fees of PayForBlobs transactions.
+	FeeRecipient string `protobuf:"bytes,6,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty" yaml:"fee_recipient"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,31 +91,123 @@ func (m *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+// FeeSplitTotals are the cumulative amounts of the fees of PayForBlobs
+// transactions routed away from the fee collector. The rest of the fees are
+// distributed to stakers.
+type FeeSplitTotals struct {
+	Burned        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	Recipient     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=recipient,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recipient"`
+}
+
+func (m *FeeSplitTotals) Reset()         { *m = FeeSplitTotals{} }
+func (m *FeeSplitTotals) String() string { return proto.CompactTextString(m) }
+func (*FeeSplitTotals) ProtoMessage()    {}
+func (*FeeSplitTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2145b82d3e5371c6, []int{1}
+}
+func (m *FeeSplitTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplitTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplitTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplitTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplitTotals.Merge(m, src)
+}
+func (m *FeeSplitTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplitTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplitTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplitTotals proto.InternalMessageInfo
+
+func (m *FeeSplitTotals) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *FeeSplitTotals) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *FeeSplitTotals) GetRecipient() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.blob.v1.Params")
+	proto.RegisterType((*FeeSplitTotals)(nil), "celestia.blob.v1.FeeSplitTotals")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/params.proto", fileDescriptor_2145b82d3e5371c6) }
 
 var fileDescriptor_2145b82d3e5371c6 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d,
-	0xd2, 0x32, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x77, 0x2e, 0xc1, 0xf4, 0xc4, 0xe2, 0xf8,
-	0x82, 0xd4, 0xa2, 0x78, 0x90, 0x9e, 0xf8, 0xa4, 0xca, 0x92, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x99, 0x4f, 0xf7, 0xe4, 0x25, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0x94, 0x28,
-	0x05, 0xf1, 0xa5, 0x27, 0x16, 0x07, 0xa4, 0x16, 0x39, 0xe5, 0xe4, 0x27, 0x39, 0x55, 0x96, 0xa4,
-	0x0a, 0xf9, 0x72, 0x09, 0xa7, 0xe7, 0x97, 0xc5, 0xe7, 0x26, 0x56, 0xc4, 0x17, 0x17, 0x96, 0x26,
-	0x16, 0xa5, 0xc6, 0x17, 0x67, 0x56, 0xa5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0xc9, 0x7d,
-	0xba, 0x27, 0x2f, 0x05, 0x35, 0x0a, 0x53, 0x91, 0x52, 0x90, 0x40, 0x7a, 0x7e, 0x99, 0x6f, 0x62,
-	0x45, 0x30, 0x58, 0x2c, 0x38, 0xb3, 0x2a, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xaf,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xf9, 0x3a, 0xbf, 0x28, 0x1d, 0xce, 0xd6, 0x4d, 0x2c,
-	0x28, 0xd0, 0xaf, 0x80, 0x04, 0x53, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x2d, 0x7d, 0x23, 0x44, 0x01, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xe3, 0xbe, 0x44, 0xea, 0x41, 0x4b, 0x6b, 0x22, 0xe4, 0xb6, 0xd4, 0x8e, 0x3c, 0x65,
+	0xa9, 0xdd, 0xc0, 0xd6, 0x0d, 0xb7, 0x2a, 0x12, 0xa2, 0x52, 0xe5, 0x80, 0x90, 0x58, 0xac, 0xf3,
+	0xe5, 0x89, 0x7b, 0xaa, 0xed, 0x33, 0x77, 0x97, 0xa8, 0xee, 0x27, 0x60, 0x64, 0x42, 0x0c, 0x0c,
+	0xcc, 0xcc, 0x7c, 0x88, 0x8e, 0x15, 0x62, 0x40, 0x0c, 0x01, 0xb5, 0xdf, 0x20, 0x9f, 0x00, 0xf9,
+	0x25, 0x71, 0xda, 0x80, 0xd4, 0xa1, 0x53, 0xee, 0xf2, 0x7f, 0xee, 0xf7, 0xfc, 0x72, 0x77, 0x39,
+	0xb4, 0x45, 0x20, 0x04, 0x21, 0x29, 0xb6, 0xfd, 0x90, 0xf9, 0xf6, 0xa0, 0x6d, 0x27, 0x98, 0xe3,
+	0x48, 0x58, 0x09, 0x67, 0x92, 0xa9, 0xab, 0xe3, 0xd8, 0xca, 0x62, 0x6b, 0xd0, 0xde, 0x68, 0x04,
+	0x2c, 0x60, 0x79, 0x68, 0x67, 0xa3, 0xa2, 0x6e, 0x63, 0x9d, 0x30, 0x11, 0x31, 0xe1, 0x15, 0x41,
+	0x31, 0x29, 0x23, 0xbd, 0x98, 0xd9, 0x3e, 0x16, 0x60, 0x0f, 0xda, 0x3e, 0x48, 0xdc, 0xb6, 0x09,
+	0xa3, 0x71, 0x91, 0x9b, 0x9f, 0x17, 0x51, 0xfd, 0x28, 0xef, 0xa9, 0x3e, 0x47, 0x6b, 0x01, 0x16,
+	0x5e, 0x02, 0xdc, 0xcb, 0xda, 0x79, 0x7e, 0x2a, 0x41, 0x53, 0x9a, 0x4a, 0x6b, 0xd9, 0x79, 0x3c,
+	0x1a, 0x1a, 0x5a, 0x8a, 0xa3, 0x70, 0xd7, 0x9c, 0x29, 0x31, 0xdd, 0x95, 0x00, 0x8b, 0x23, 0xe0,
+	0x4e, 0xc8, 0x7c, 0x27, 0x95, 0xa0, 0x1e, 0xa2, 0x87, 0x01, 0x1b, 0x78, 0x11, 0x3e, 0xf5, 0xc4,
+	0xbb, 0x3e, 0xe6, 0xe0, 0x09, 0x7a, 0x06, 0xda, 0x5c, 0x53, 0x69, 0x2d, 0x38, 0xfa, 0x68, 0x68,
+	0x6c, 0x94, 0xa8, 0xd9, 0x22, 0xd3, 0x5d, 0x0d, 0xd8, 0xe0, 0x10, 0x9f, 0x76, 0xf2, 0xef, 0x3a,
+	0xf4, 0x0c, 0xd4, 0x14, 0xad, 0xf5, 0x00, 0x3c, 0xbf, 0xcf, 0x63, 0xaf, 0xc7, 0x31, 0x91, 0x94,
+	0xc5, 0xda, 0x7c, 0x53, 0x69, 0x2d, 0x39, 0x87, 0xe7, 0x43, 0xa3, 0xf6, 0x6b, 0x68, 0x6c, 0x16,
+	0xbf, 0x52, 0x74, 0x4f, 0x2c, 0xca, 0xec, 0x08, 0xcb, 0x63, 0xeb, 0x25, 0x04, 0x98, 0xa4, 0xfb,
+	0x40, 0x2a, 0xf5, 0x19, 0x8a, 0xf9, 0xfd, 0xdb, 0x36, 0x2a, 0xb7, 0x6b, 0x1f, 0x88, 0xfb, 0xa0,
+	0x07, 0xe0, 0xf4, 0x79, 0x7c, 0x50, 0xe6, 0xea, 0x47, 0x05, 0x6d, 0x66, 0xab, 0x08, 0x8b, 0xa2,
+	0x7e, 0x4c, 0x65, 0xea, 0x25, 0x8c, 0x85, 0x95, 0xc5, 0x42, 0x6e, 0xf1, 0xe6, 0x76, 0x16, 0x66,
+	0x65, 0xf1, 0x1f, 0xde, 0x4d, 0x1f, 0xad, 0x07, 0xb0, 0x37, 0x2e, 0x3d, 0x62, 0x2c, 0x9c, 0x88,
+	0xbd, 0x57, 0xd0, 0xa3, 0x0c, 0xc4, 0x81, 0xd0, 0x84, 0x42, 0x2c, 0x2b, 0xa7, 0xc5, 0xdc, 0xc9,
+	0xbd, 0x9d, 0xd3, 0x56, 0xe5, 0x34, 0x8b, 0xba, 0xa9, 0xd3, 0xe8, 0x01, 0xb8, 0xe3, 0xaa, 0x89,
+	0xca, 0x6b, 0xb4, 0x7c, 0x6d, 0xb9, 0x56, 0xcf, 0x05, 0x76, 0x46, 0x43, 0xa3, 0xf1, 0x0f, 0x7a,
+	0x06, 0x6d, 0x94, 0xd0, 0x67, 0xdd, 0x2e, 0x07, 0x21, 0x3a, 0x92, 0xd3, 0x38, 0x70, 0xef, 0x4f,
+	0xe3, 0x77, 0x17, 0x3e, 0x7d, 0x31, 0x6a, 0xe6, 0x8f, 0x39, 0xb4, 0x72, 0x00, 0xd0, 0x49, 0x42,
+	0x2a, 0x5f, 0x31, 0x89, 0x43, 0xa1, 0x12, 0x54, 0xcf, 0x0e, 0x11, 0xba, 0x9a, 0xd2, 0x9c, 0x6f,
+	0xdd, 0x7b, 0xb2, 0x6e, 0x95, 0xb4, 0xec, 0x8a, 0x5b, 0xe5, 0x15, 0xb7, 0xf6, 0x18, 0x8d, 0x9d,
+	0x9d, 0x6c, 0x13, 0xbe, 0xfe, 0x36, 0x5a, 0x01, 0x95, 0xc7, 0x7d, 0xdf, 0x22, 0x2c, 0x2a, 0xff,
+	0x1d, 0xe5, 0xc7, 0xb6, 0xe8, 0x9e, 0xd8, 0x32, 0x4d, 0x40, 0xe4, 0x0b, 0x84, 0x5b, 0xa2, 0x55,
+	0x8e, 0x56, 0xae, 0x9f, 0x91, 0x36, 0x77, 0xf7, 0xcd, 0x96, 0xc9, 0xf4, 0xd9, 0xaa, 0x14, 0x2d,
+	0x55, 0x9b, 0x38, 0x7f, 0xf7, 0xed, 0x2a, 0xba, 0xf3, 0xe2, 0xfc, 0x52, 0x57, 0x2e, 0x2e, 0x75,
+	0xe5, 0xcf, 0xa5, 0xae, 0x7c, 0xb8, 0xd2, 0x6b, 0x17, 0x57, 0x7a, 0xed, 0xe7, 0x95, 0x5e, 0x7b,
+	0xbb, 0x33, 0x8d, 0x2b, 0x5f, 0x1f, 0xc6, 0x83, 0xc9, 0x78, 0x1b, 0x27, 0x89, 0x7d, 0x5a, 0x3c,
+	0x57, 0x39, 0xdc, 0xaf, 0xe7, 0x0f, 0xc9, 0xd3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xa7,
+	0xc6, 0xdf, 0xcc, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +230,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.FeeRecipientFraction.Size()
+		i -= size
+		if _, err := m.FeeRecipientFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeeCommunityPoolFraction.Size()
+		i -= size
+		if _, err := m.FeeCommunityPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeeBurnFraction.Size()
+		i -= size
+		if _, err := m.FeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.GovMaxSquareSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GovMaxSquareSize))
 		i--
@@ -135,6 +280,71 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSplitTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplitTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplitTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		for iNdEx := len(m.Recipient) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -158,6 +368,43 @@ func (m *Params) Size() (n int) {
 	if m.GovMaxSquareSize != 0 {
 		n += 1 + sovParams(uint64(m.GovMaxSquareSize))
 	}
+	l = m.FeeBurnFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeCommunityPoolFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeRecipientFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeSplitTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.Recipient) > 0 {
+		for _, e := range m.Recipient {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -234,6 +481,292 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCommunityPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCommunityPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipientFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRecipientFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplitTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplitTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplitTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient, types.Coin{})
+			if err := m.Recipient[len(m.Recipient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
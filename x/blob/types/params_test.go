@@ -3,7 +3,9 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,3 +44,75 @@ func Test_validateGovMaxSquareSize(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateFeeSplit(t *testing.T) {
+	recipient := sdk.AccAddress(make([]byte, 20)).String()
+	tests := []struct {
+		name      string
+		modify    func(*Params)
+		expectErr bool
+	}{
+		{
+			name:   "default",
+			modify: func(*Params) {},
+		},
+		{
+			name: "unset fractions",
+			modify: func(p *Params) {
+				p.FeeBurnFraction = math.LegacyDec{}
+				p.FeeCommunityPoolFraction = math.LegacyDec{}
+				p.FeeRecipientFraction = math.LegacyDec{}
+			},
+		},
+		{
+			name: "all fractions",
+			modify: func(p *Params) {
+				p.FeeBurnFraction = math.LegacyMustNewDecFromStr("0.5")
+				p.FeeCommunityPoolFraction = math.LegacyMustNewDecFromStr("0.25")
+				p.FeeRecipientFraction = math.LegacyMustNewDecFromStr("0.25")
+				p.FeeRecipient = recipient
+			},
+		},
+		{
+			name: "negative fraction",
+			modify: func(p *Params) {
+				p.FeeBurnFraction = math.LegacyMustNewDecFromStr("-0.1")
+			},
+			expectErr: true,
+		},
+		{
+			name: "fractions above one",
+			modify: func(p *Params) {
+				p.FeeBurnFraction = math.LegacyMustNewDecFromStr("0.6")
+				p.FeeCommunityPoolFraction = math.LegacyMustNewDecFromStr("0.6")
+			},
+			expectErr: true,
+		},
+		{
+			name: "recipient fraction without recipient",
+			modify: func(p *Params) {
+				p.FeeRecipientFraction = math.LegacyMustNewDecFromStr("0.1")
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid recipient",
+			modify: func(p *Params) {
+				p.FeeRecipient = "invalid"
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			tt.modify(&params)
+			err := params.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -151,6 +151,18 @@ func GasToConsume(blobSizes []uint32, gasPerByte uint32) uint64 {
 	return totalSharesUsed * share.ShareSize * uint64(gasPerByte)
 }
 
+// SharesNeeded returns the total number of shares needed to represent all of
+// the blobs described by blobSizes along with the shares used by a tx of
+// txSize bytes. It doesn't include the padding the square builder may insert
+// before each blob.
+func SharesNeeded(txSize uint32, blobSizes []uint32) (sum int) {
+	sum = share.CompactSharesNeeded(txSize)
+	for _, blobSize := range blobSizes {
+		sum += share.SparseSharesNeeded(blobSize)
+	}
+	return sum
+}
+
 // EstimateGas estimates the total gas required to pay for a set of blobs in a PFB.
 // It is based on a linear model that is dependent on the governance parameters:
 // gasPerByte and txSizeCost. It assumes other variables are constant. This includes
@@ -111,15 +111,101 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryFeeSplitTotalsRequest is the request type for the Query/FeeSplitTotals
+// RPC method.
+type QueryFeeSplitTotalsRequest struct {
+}
+
+func (m *QueryFeeSplitTotalsRequest) Reset()         { *m = QueryFeeSplitTotalsRequest{} }
+func (m *QueryFeeSplitTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitTotalsRequest) ProtoMessage()    {}
+func (*QueryFeeSplitTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{2}
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitTotalsRequest.Merge(m, src)
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitTotalsRequest proto.InternalMessageInfo
+
+// QueryFeeSplitTotalsResponse is the response type for the
+// Query/FeeSplitTotals RPC method.
+type QueryFeeSplitTotalsResponse struct {
+	Totals FeeSplitTotals `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals"`
+}
+
+func (m *QueryFeeSplitTotalsResponse) Reset()         { *m = QueryFeeSplitTotalsResponse{} }
+func (m *QueryFeeSplitTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitTotalsResponse) ProtoMessage()    {}
+func (*QueryFeeSplitTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{3}
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitTotalsResponse.Merge(m, src)
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSplitTotalsResponse) GetTotals() FeeSplitTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return FeeSplitTotals{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.blob.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.blob.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeeSplitTotalsRequest)(nil), "celestia.blob.v1.QueryFeeSplitTotalsRequest")
+	proto.RegisterType((*QueryFeeSplitTotalsResponse)(nil), "celestia.blob.v1.QueryFeeSplitTotalsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/query.proto", fileDescriptor_29ba8a4248383b64) }
 
 var fileDescriptor_29ba8a4248383b64 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
+	// 363 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
 	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
 	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
@@ -129,15 +215,20 @@ var fileDescriptor_29ba8a4248383b64 = []byte{
 	0x5c, 0xa8, 0xb4, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xce, 0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61,
 	0x69, 0x6a, 0x71, 0x89, 0x92, 0x2f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
 	0x90, 0x19, 0x17, 0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba,
-	0x13, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x36, 0x2a, 0xe7,
-	0x62, 0x05, 0x1b, 0x27, 0x94, 0xc7, 0xc5, 0x06, 0x51, 0x20, 0xa4, 0x82, 0xa9, 0x15, 0xd3, 0x1d,
-	0x52, 0xaa, 0x04, 0x54, 0x41, 0xdc, 0xa5, 0x24, 0xde, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x41, 0x21,
-	0x7e, 0x34, 0x3f, 0x3a, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0x8e, 0xfc, 0xa2,
-	0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0x62, 0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x38, 0xc0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x7e, 0x20, 0x5f, 0xb5,
-	0x01, 0x00, 0x00,
+	0x13, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x56, 0x92, 0xe1,
+	0x92, 0x02, 0x1b, 0xe7, 0x96, 0x9a, 0x1a, 0x5c, 0x90, 0x93, 0x59, 0x12, 0x92, 0x5f, 0x92, 0x98,
+	0x03, 0xb7, 0x2c, 0x96, 0x4b, 0x1a, 0xab, 0x2c, 0xd4, 0x52, 0x3b, 0x2e, 0xb6, 0x12, 0xb0, 0x08,
+	0xd4, 0x52, 0x05, 0x4c, 0x4b, 0x51, 0x75, 0xc2, 0x2c, 0x87, 0xe8, 0x32, 0xea, 0x62, 0xe2, 0x62,
+	0x05, 0x9b, 0x2f, 0x94, 0xc7, 0xc5, 0x06, 0x71, 0x9e, 0x90, 0x0a, 0xa6, 0x19, 0x98, 0xa1, 0x20,
+	0xa5, 0x4a, 0x40, 0x15, 0xc4, 0x81, 0x4a, 0xe2, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x12, 0x14, 0xe2,
+	0x47, 0x0b, 0x61, 0xa1, 0x09, 0x8c, 0x5c, 0x7c, 0xa8, 0x4e, 0x13, 0xd2, 0xc1, 0x61, 0x24, 0xd6,
+	0x90, 0x91, 0xd2, 0x25, 0x52, 0x35, 0xd4, 0x21, 0x8a, 0x60, 0x87, 0x48, 0x0b, 0x49, 0xc2, 0x1d,
+	0x92, 0x96, 0x9a, 0x1a, 0x5f, 0x0c, 0x52, 0x19, 0x0f, 0x09, 0x0c, 0x27, 0xaf, 0x13, 0x8f, 0xe4,
+	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
+	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
+	0xce, 0xcf, 0xd5, 0x87, 0xd9, 0x9a, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26, 0x16, 0x14, 0xe8, 0x57,
+	0x40, 0x4c, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xa7, 0x20, 0x63, 0x40, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xcb, 0x8e, 0xe1, 0xce, 0xc6, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +245,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeSplitTotals queries the cumulative amounts of the fees of PayForBlobs
+	// transactions burned, sent to the community pool and sent to the fee
+	// recipient.
+	FeeSplitTotals(ctx context.Context, in *QueryFeeSplitTotalsRequest, opts ...grpc.CallOption) (*QueryFeeSplitTotalsResponse, error)
 }
 
 type queryClient struct {
@@ -173,10 +268,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FeeSplitTotals(ctx context.Context, in *QueryFeeSplitTotalsRequest, opts ...grpc.CallOption) (*QueryFeeSplitTotalsResponse, error) {
+	out := new(QueryFeeSplitTotalsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/FeeSplitTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeSplitTotals queries the cumulative amounts of the fees of PayForBlobs
+	// transactions burned, sent to the community pool and sent to the fee
+	// recipient.
+	FeeSplitTotals(context.Context, *QueryFeeSplitTotalsRequest) (*QueryFeeSplitTotalsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +294,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) FeeSplitTotals(ctx context.Context, req *QueryFeeSplitTotalsRequest) (*QueryFeeSplitTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplitTotals not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +320,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeSplitTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplitTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/FeeSplitTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplitTotals(ctx, req.(*QueryFeeSplitTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.blob.v1.Query",
@@ -218,6 +347,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "FeeSplitTotals",
+			Handler:    _Query_FeeSplitTotals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/query.proto",
@@ -279,6 +412,62 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeSplitTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -310,6 +499,26 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeSplitTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSplitTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Totals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,6 +658,139 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeSplitTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSplitTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSplitTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSplitTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSplitTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSplitTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNetworkMinGasPrice returns the network min gas price that transactions
+// must pay. If the dynamic adjustment is enabled, it is the adjusted price.
+// Otherwise, it is the NetworkMinGasPrice param.
+func (k Keeper) GetNetworkMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	params := k.GetParams(ctx)
+	if !params.DynamicEnabled {
+		return params.NetworkMinGasPrice
+	}
+	return k.getDynamicMinGasPrice(ctx, params)
+}
+
+// getDynamicMinGasPrice returns the last adjusted network min gas price or,
+// if the price wasn't adjusted yet, the NetworkMinGasPrice param bounded by
+// the floor and ceiling.
+func (k Keeper) getDynamicMinGasPrice(ctx sdk.Context, params types.Params) math.LegacyDec {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.DynamicMinGasPriceKey))
+	if len(bz) == 0 {
+		return clampDec(params.NetworkMinGasPrice, params.MinGasPriceFloor, params.MinGasPriceCeiling)
+	}
+	var price math.LegacyDec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return price
+}
+
+// resetDynamicMinGasPrice removes the adjusted network min gas price so that
+// the adjustment starts over from the NetworkMinGasPrice param.
+func (k Keeper) resetDynamicMinGasPrice(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete([]byte(types.DynamicMinGasPriceKey))
+}
+
+// AdjustNetworkMinGasPrice adjusts the network min gas price based on the
+// fraction of the max square, in the range [0, 1], filled by the current
+// block. The price changes proportionally to the distance of the square
+// fullness from the target, by at most the max change rate, and stays within
+// the floor and ceiling. It is a no-op unless the dynamic adjustment is
+// enabled. The adjusted price is recorded in the history.
+func (k Keeper) AdjustNetworkMinGasPrice(ctx sdk.Context, squareFullness math.LegacyDec) {
+	params := k.GetParams(ctx)
+	if !params.DynamicEnabled {
+		return
+	}
+	squareFullness = clampDec(squareFullness, math.LegacyZeroDec(), math.LegacyOneDec())
+	current := k.getDynamicMinGasPrice(ctx, params)
+	next := NextNetworkMinGasPrice(params, current, squareFullness)
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := next.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(types.DynamicMinGasPriceKey), bz)
+
+	record := types.MinGasPriceRecord{
+		Height:             ctx.BlockHeight(),
+		NetworkMinGasPrice: next,
+		SquareFullness:     squareFullness,
+	}
+	store.Set(types.MinGasPriceHistoryKey(ctx.BlockHeight()), k.cdc.MustMarshal(&record))
+	k.pruneMinGasPriceHistory(ctx)
+}
+
+// pruneMinGasPriceHistory removes the records of the dynamic network min gas
+// price older than MinGasPriceHistoryLength blocks. All of them are removed,
+// not just the one that just left the window, so that records left behind
+// while the dynamic adjustment was disabled are pruned too.
+func (k Keeper) pruneMinGasPriceHistory(ctx sdk.Context) {
+	oldest := ctx.BlockHeight() - types.MinGasPriceHistoryLength + 1
+	if oldest <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator([]byte(types.MinGasPriceHistoryKeyPrefix), types.MinGasPriceHistoryKey(oldest))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// NextNetworkMinGasPrice returns the network min gas price following the
+// current one given the square fullness of a block.
+func NextNetworkMinGasPrice(params types.Params, current, squareFullness math.LegacyDec) math.LegacyDec {
+	// the relative distance from the target, capped to [-1, 1] so that the
+	// change never exceeds the max change rate.
+	delta := squareFullness.Sub(params.TargetSquareFullness).Quo(params.TargetSquareFullness)
+	delta = clampDec(delta, math.LegacyOneDec().Neg(), math.LegacyOneDec())
+	next := current.Mul(math.LegacyOneDec().Add(params.MaxChangeRate.Mul(delta)))
+	return clampDec(next, params.MinGasPriceFloor, params.MinGasPriceCeiling)
+}
+
+// GetMinGasPriceHistory returns the records of the dynamic network min gas
+// price ordered by height.
+func (k Keeper) GetMinGasPriceHistory(ctx sdk.Context) []types.MinGasPriceRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MinGasPriceHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var records []types.MinGasPriceRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MinGasPriceRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+func clampDec(value, lower, upper math.LegacyDec) math.LegacyDec {
+	if value.LT(lower) {
+		return lower
+	}
+	if value.GT(upper) {
+		return upper
+	}
+	return value
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/keeper"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextNetworkMinGasPrice(t *testing.T) {
+	params := types.NewParams(sdkmath.LegacyMustNewDecFromStr("0.002"))
+	params.MinGasPriceFloor = sdkmath.LegacyMustNewDecFromStr("0.001")
+	params.MinGasPriceCeiling = sdkmath.LegacyMustNewDecFromStr("0.004")
+	current := sdkmath.LegacyMustNewDecFromStr("0.002")
+
+	tests := []struct {
+		name           string
+		current        sdkmath.LegacyDec
+		squareFullness sdkmath.LegacyDec
+		want           sdkmath.LegacyDec
+	}{
+		{"at target", current, sdkmath.LegacyMustNewDecFromStr("0.5"), current},
+		{"full square", current, sdkmath.LegacyOneDec(), sdkmath.LegacyMustNewDecFromStr("0.00225")},
+		{"empty square", current, sdkmath.LegacyZeroDec(), sdkmath.LegacyMustNewDecFromStr("0.00175")},
+		{"half way above target", current, sdkmath.LegacyMustNewDecFromStr("0.75"), sdkmath.LegacyMustNewDecFromStr("0.000125").Add(current)},
+		{"bounded by floor", sdkmath.LegacyMustNewDecFromStr("0.001"), sdkmath.LegacyZeroDec(), sdkmath.LegacyMustNewDecFromStr("0.001")},
+		{"bounded by ceiling", sdkmath.LegacyMustNewDecFromStr("0.004"), sdkmath.LegacyOneDec(), sdkmath.LegacyMustNewDecFromStr("0.004")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := keeper.NextNetworkMinGasPrice(params, tc.current, tc.squareFullness)
+			require.True(t, tc.want.Equal(got), "want %s, got %s", tc.want, got)
+		})
+	}
+}
+
+func TestAdjustNetworkMinGasPrice(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	ctx := testApp.NewContext(false)
+
+	// the adjustment is a no-op while disabled
+	k.AdjustNetworkMinGasPrice(ctx.WithBlockHeight(1), sdkmath.LegacyOneDec())
+	require.Equal(t, types.DefaultNetworkMinGasPrice, k.GetNetworkMinGasPrice(ctx))
+	require.Empty(t, k.GetMinGasPriceHistory(ctx))
+
+	params := types.DefaultParams()
+	params.DynamicEnabled = true
+	params.MinGasPriceCeiling = sdkmath.LegacyMustNewDecFromStr("0.01")
+	k.SetParams(ctx, params)
+
+	price := types.DefaultNetworkMinGasPrice
+	for height := int64(1); height <= types.MinGasPriceHistoryLength+10; height++ {
+		k.AdjustNetworkMinGasPrice(ctx.WithBlockHeight(height), sdkmath.LegacyOneDec())
+		price = keeper.NextNetworkMinGasPrice(params, price, sdkmath.LegacyOneDec())
+		require.True(t, price.Equal(k.GetNetworkMinGasPrice(ctx)))
+	}
+	require.True(t, price.Equal(params.MinGasPriceCeiling))
+
+	resp, err := k.NetworkMinGasPriceHistory(ctx, &types.QueryNetworkMinGasPriceHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, types.MinGasPriceHistoryLength)
+	require.EqualValues(t, 11, resp.Records[0].Height)
+	require.EqualValues(t, types.MinGasPriceHistoryLength+10, resp.Records[len(resp.Records)-1].Height)
+	require.True(t, resp.Records[0].SquareFullness.Equal(sdkmath.LegacyOneDec()))
+
+	queryResp, err := k.NetworkMinGasPrice(ctx, &types.QueryNetworkMinGasPrice{})
+	require.NoError(t, err)
+	require.True(t, price.Equal(queryResp.NetworkMinGasPrice))
+
+	// updating the params restarts the adjustment from the new price
+	params.NetworkMinGasPrice = sdkmath.LegacyMustNewDecFromStr("0.005")
+	_, err = k.UpdateMinfeeParams(ctx, &types.MsgUpdateMinfeeParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params.NetworkMinGasPrice, k.GetNetworkMinGasPrice(ctx))
+}
+
+func TestPruneMinGasPriceHistoryAfterReenabling(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	ctx := testApp.NewContext(false)
+
+	params := types.DefaultParams()
+	params.DynamicEnabled = true
+	k.SetParams(ctx, params)
+	for height := int64(1); height <= 10; height++ {
+		k.AdjustNetworkMinGasPrice(ctx.WithBlockHeight(height), sdkmath.LegacyOneDec())
+	}
+	require.Len(t, k.GetMinGasPriceHistory(ctx), 10)
+
+	// the records left while the adjustment was disabled are pruned once it
+	// is enabled again.
+	height := int64(10 + 2*types.MinGasPriceHistoryLength)
+	k.AdjustNetworkMinGasPrice(ctx.WithBlockHeight(height), sdkmath.LegacyOneDec())
+	history := k.GetMinGasPriceHistory(ctx)
+	require.Len(t, history, 1)
+	require.Equal(t, height, history[0].Height)
+}
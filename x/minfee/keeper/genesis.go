@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,14 +18,35 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	}
 
 	k.SetParams(sdkCtx, genState.Params)
+
+	store := sdkCtx.KVStore(k.storeKey)
+	if !genState.DynamicMinGasPrice.IsNil() && genState.DynamicMinGasPrice.IsPositive() {
+		bz, err := genState.DynamicMinGasPrice.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set([]byte(types.DynamicMinGasPriceKey), bz)
+	}
+	for _, record := range genState.MinGasPriceHistory {
+		store.Set(types.MinGasPriceHistoryKey(record.Height), k.cdc.MustMarshal(&record))
+	}
 	return nil
 }
 
 // ExportGenesis returns the minfee module's exported genesis.
 func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(sdkCtx)
 	genesis := types.DefaultGenesis()
 	// TODO: genesis should hold params not this field.
-	genesis.NetworkMinGasPrice = k.GetParams(sdkCtx).NetworkMinGasPrice
+	genesis.NetworkMinGasPrice = params.NetworkMinGasPrice
+	genesis.Params = params
+	genesis.DynamicMinGasPrice = math.LegacyZeroDec()
+	if bz := sdkCtx.KVStore(k.storeKey).Get([]byte(types.DynamicMinGasPriceKey)); len(bz) != 0 {
+		if err := genesis.DynamicMinGasPrice.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	genesis.MinGasPriceHistory = k.GetMinGasPriceHistory(sdkCtx)
 	return genesis
 }
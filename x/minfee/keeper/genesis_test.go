@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	ctx := testApp.NewContext(false)
+
+	params := types.NewParams(sdkmath.LegacyMustNewDecFromStr("0.004"))
+	params.DynamicEnabled = true
+	params.MaxChangeRate = sdkmath.LegacyMustNewDecFromStr("0.25")
+	k.SetParams(ctx, params)
+	for height := int64(1); height <= 3; height++ {
+		k.AdjustNetworkMinGasPrice(ctx.WithBlockHeight(height), sdkmath.LegacyOneDec())
+	}
+	price := k.GetNetworkMinGasPrice(ctx)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(genesis))
+	require.Equal(t, params, genesis.Params)
+	require.True(t, price.Equal(genesis.DynamicMinGasPrice))
+	require.Len(t, genesis.MinGasPriceHistory, 3)
+
+	imported, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	importedCtx := imported.NewContext(false)
+	require.NoError(t, imported.MinFeeKeeper.InitGenesis(importedCtx, *genesis))
+	require.Equal(t, params, imported.MinFeeKeeper.GetParams(importedCtx))
+	require.True(t, price.Equal(imported.MinFeeKeeper.GetNetworkMinGasPrice(importedCtx)))
+	require.Equal(t, genesis.MinGasPriceHistory, imported.MinFeeKeeper.GetMinGasPriceHistory(importedCtx))
+}
@@ -11,13 +11,23 @@ import (
 
 var _ types.QueryServer = &Keeper{}
 
-// NetworkMinGasPrice returns the network minimum gas price.
+// NetworkMinGasPrice returns the network minimum gas price. If the dynamic
+// adjustment is enabled, it is the adjusted price.
 func (k *Keeper) NetworkMinGasPrice(ctx context.Context, _ *types.QueryNetworkMinGasPrice) (*types.QueryNetworkMinGasPriceResponse, error) {
-	// delegate to the self managed params.
-	networkMinGasPrice := k.GetParams(sdk.UnwrapSDKContext(ctx)).NetworkMinGasPrice
+	networkMinGasPrice := k.GetNetworkMinGasPrice(sdk.UnwrapSDKContext(ctx))
 	return &types.QueryNetworkMinGasPriceResponse{NetworkMinGasPrice: networkMinGasPrice}, nil
 }
 
+// NetworkMinGasPriceHistory returns the recent adjustments of the dynamic
+// network minimum gas price.
+func (k *Keeper) NetworkMinGasPriceHistory(ctx context.Context, req *types.QueryNetworkMinGasPriceHistoryRequest) (*types.QueryNetworkMinGasPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	records := k.GetMinGasPriceHistory(sdk.UnwrapSDKContext(ctx))
+	return &types.QueryNetworkMinGasPriceHistoryResponse{Records: records}, nil
+}
+
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
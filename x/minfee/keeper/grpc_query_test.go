@@ -50,22 +50,27 @@ func TestMsgUpdateParams(t *testing.T) {
 		expectedMinGasPrice sdkmath.LegacyDec
 	}{
 		{
-			name:      "valid update with gov authority",
-			authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-			newParams: types.Params{
-				NetworkMinGasPrice: sdkmath.LegacyMustNewDecFromStr("0.0005"),
-			},
+			name:                "valid update with gov authority",
+			authority:           authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			newParams:           types.NewParams(sdkmath.LegacyMustNewDecFromStr("0.0005")),
 			expectedErr:         nil,
 			expectedMinGasPrice: sdkmath.LegacyMustNewDecFromStr("0.0005"),
 		},
 		{
-			name:      "invalid update with incorrect authority",
-			authority: "invalid-authority",
+			name:                "invalid update with incorrect authority",
+			authority:           "invalid-authority",
+			newParams:           types.NewParams(sdkmath.LegacyMustNewDecFromStr("0.0005")),
+			expectedErr:         sdkerrors.ErrUnauthorized,
+			expectedMinGasPrice: types.DefaultNetworkMinGasPrice, // should remain unchanged in case of error
+		},
+		{
+			name:      "invalid update without the dynamic params",
+			authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			newParams: types.Params{
 				NetworkMinGasPrice: sdkmath.LegacyMustNewDecFromStr("0.0005"),
 			},
-			expectedErr:         sdkerrors.ErrUnauthorized,
-			expectedMinGasPrice: types.DefaultNetworkMinGasPrice, // should remain unchanged in case of error
+			expectedErr:         sdkerrors.ErrInvalidRequest,
+			expectedMinGasPrice: types.DefaultNetworkMinGasPrice,
 		},
 	}
 
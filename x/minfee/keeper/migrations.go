@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
 	minfeetypes "github.com/celestiaorg/celestia-app/v6/x/minfee/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -20,9 +21,41 @@ func NewMigrator(keeper *Keeper) Migrator {
 func (m *Migrator) MigrateParams(ctx sdk.Context) error {
 	var params minfeetypes.Params
 	m.keeper.legacySubspace.GetParamSet(ctx, &params)
+	params = minfeetypes.NewParams(params.NetworkMinGasPrice)
 	if err := params.Validate(); err != nil {
 		return err
 	}
-	m.keeper.SetParams(ctx, minfeetypes.NewParams(params.NetworkMinGasPrice))
+	m.keeper.SetParams(ctx, params)
 	return nil
 }
+
+// MigrateDynamicParams sets the params of the dynamic network min gas price,
+// which are unset in params stored before they were added, to their defaults.
+// The dynamic adjustment stays disabled.
+func (m *Migrator) MigrateDynamicParams(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := minfeetypes.NewParams(params.NetworkMinGasPrice)
+	if isUnsetDec(params.TargetSquareFullness) {
+		params.TargetSquareFullness = defaults.TargetSquareFullness
+	}
+	if isUnsetDec(params.MaxChangeRate) {
+		params.MaxChangeRate = defaults.MaxChangeRate
+	}
+	if isUnsetDec(params.MinGasPriceFloor) {
+		params.MinGasPriceFloor = defaults.MinGasPriceFloor
+	}
+	if isUnsetDec(params.MinGasPriceCeiling) {
+		params.MinGasPriceCeiling = defaults.MinGasPriceCeiling
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// isUnsetDec returns true if the decimal is missing from the stored params.
+// A missing decimal decodes as nil, or as zero if the params were re-encoded.
+func isUnsetDec(d math.LegacyDec) bool {
+	return d.IsNil() || d.IsZero()
+}
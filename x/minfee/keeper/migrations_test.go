@@ -42,3 +42,17 @@ func TestMigrateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateDynamicParams(t *testing.T) {
+	testApp, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.GetBaseApp().NewContext(true)
+
+	// params stored before the dynamic network min gas price was added.
+	networkMinGasPrice := math.LegacyMustNewDecFromStr("0.000005")
+	testApp.MinFeeKeeper.SetParams(ctx, minfeetypes.Params{NetworkMinGasPrice: networkMinGasPrice})
+	require.Error(t, testApp.MinFeeKeeper.GetParams(ctx).Validate())
+
+	migrator := keeper.NewMigrator(testApp.MinFeeKeeper)
+	require.NoError(t, migrator.MigrateDynamicParams(ctx))
+	require.Equal(t, minfeetypes.NewParams(networkMinGasPrice), testApp.MinFeeKeeper.GetParams(ctx))
+}
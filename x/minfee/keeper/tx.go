@@ -25,6 +25,8 @@ func (k Keeper) UpdateMinfeeParams(goCtx context.Context, msg *types.MsgUpdateMi
 	}
 
 	k.SetParams(ctx, msg.Params)
+	// restart the dynamic adjustment from the new network min gas price.
+	k.resetDynamicMinGasPrice(ctx)
 
 	// Emit an event indicating successful parameter update.
 	if err := ctx.EventManager().EmitTypedEvent(
@@ -8,9 +8,7 @@ import (
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		NetworkMinGasPrice: DefaultNetworkMinGasPrice, // TODO: remove this field
-		Params: Params{
-			NetworkMinGasPrice: DefaultNetworkMinGasPrice,
-		},
+		Params:             DefaultParams(),
 	}
 }
 
@@ -20,5 +18,19 @@ func ValidateGenesis(genesis *GenesisState) error {
 		return fmt.Errorf("network min gas price cannot be negative or zero: %g", genesis.NetworkMinGasPrice)
 	}
 
+	if !genesis.DynamicMinGasPrice.IsNil() && genesis.DynamicMinGasPrice.IsNegative() {
+		return fmt.Errorf("dynamic min gas price cannot be negative: %s", genesis.DynamicMinGasPrice)
+	}
+	heights := make(map[int64]bool, len(genesis.MinGasPriceHistory))
+	for _, record := range genesis.MinGasPriceHistory {
+		if record.Height <= 0 {
+			return fmt.Errorf("min gas price record height must be positive: %d", record.Height)
+		}
+		if heights[record.Height] {
+			return fmt.Errorf("duplicate min gas price record at height %d", record.Height)
+		}
+		heights[record.Height] = true
+	}
+
 	return genesis.Params.Validate()
 }
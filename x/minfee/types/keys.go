@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "minfee"
@@ -9,4 +11,22 @@ const (
 
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
+
+	// DynamicMinGasPriceKey defines the key used for storing the network min
+	// gas price set by the dynamic adjustment.
+	DynamicMinGasPriceKey = "dynamic_min_gas_price"
+
+	// MinGasPriceHistoryKeyPrefix defines the prefix of the keys used for
+	// storing the records of the dynamic network min gas price by height.
+	MinGasPriceHistoryKeyPrefix = "min_gas_price_history/"
+
+	// MinGasPriceHistoryLength is the number of blocks for which the records
+	// of the dynamic network min gas price are kept.
+	MinGasPriceHistoryLength = 100
 )
+
+// MinGasPriceHistoryKey returns the key of the record of the dynamic network
+// min gas price at the height.
+func MinGasPriceHistoryKey(height int64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(MinGasPriceHistoryKeyPrefix), uint64(height))
+}
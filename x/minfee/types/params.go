@@ -17,21 +17,50 @@ func init() {
 	DefaultNetworkMinGasPrice = DefaultNetworkMinGasPriceDec
 }
 
-// Validate validates the set of params
+var (
+	// DefaultTargetSquareFullness is the default square fullness targeted by
+	// the dynamic network min gas price.
+	DefaultTargetSquareFullness = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultMaxChangeRate is the default max fraction by which the dynamic
+	// network min gas price changes in a single block.
+	DefaultMaxChangeRate = math.LegacyNewDecWithPrec(125, 3)
+	// DefaultMinGasPriceCeiling is the default highest dynamic network min gas
+	// price.
+	DefaultMinGasPriceCeiling = math.LegacyOneDec()
+)
+
+// Validate validates the set of params. The params of the dynamic network min
+// gas price are validated even if it is disabled so that enabling it later
+// can't pick up invalid values.
 func (p Params) Validate() error {
+	if p.TargetSquareFullness.IsNil() || !p.TargetSquareFullness.IsPositive() || p.TargetSquareFullness.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("target square fullness must be in the range (0, 1]: %s", p.TargetSquareFullness)
+	}
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max change rate must be in the range (0, 1]: %s", p.MaxChangeRate)
+	}
+	if p.MinGasPriceFloor.IsNil() || !p.MinGasPriceFloor.IsPositive() {
+		return fmt.Errorf("min gas price floor must be positive: %s", p.MinGasPriceFloor)
+	}
+	if p.MinGasPriceCeiling.IsNil() || p.MinGasPriceCeiling.LT(p.MinGasPriceFloor) {
+		return fmt.Errorf("min gas price ceiling %s must not be lower than the floor %s", p.MinGasPriceCeiling, p.MinGasPriceFloor)
+	}
 	return nil
 }
 
 // DefaultParams returns the default parameters for the module.
 func DefaultParams() Params {
-	return Params{
-		NetworkMinGasPrice: DefaultNetworkMinGasPrice,
-	}
+	return NewParams(DefaultNetworkMinGasPrice)
 }
 
-// NewParams creates a new instance of Params with the provided NetworkMinGasPrice.
+// NewParams creates a new instance of Params with the provided NetworkMinGasPrice
+// and the dynamic network min gas price disabled.
 func NewParams(networkMinGasPrice math.LegacyDec) Params {
 	return Params{
-		NetworkMinGasPrice: networkMinGasPrice,
+		NetworkMinGasPrice:   networkMinGasPrice,
+		TargetSquareFullness: DefaultTargetSquareFullness,
+		MaxChangeRate:        DefaultMaxChangeRate,
+		MinGasPriceFloor:     DefaultNetworkMinGasPrice,
+		MinGasPriceCeiling:   DefaultMinGasPriceCeiling,
 	}
 }
@@ -28,6 +28,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
+	// dynamic_enabled enables adjusting the network min gas price at the end of
+	// every block based on how full the square of the block was relative to the
+	// target square fullness. The adjusted price starts at
+	// network_min_gas_price.
+	DynamicEnabled bool `protobuf:"varint,2,opt,name=dynamic_enabled,json=dynamicEnabled,proto3" json:"dynamic_enabled,omitempty"`
+	// target_square_fullness is the fraction of the max square, in the range
+	// (0, 1], above which the network min gas price increases and below which it
+	// decreases.
+	TargetSquareFullness cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=target_square_fullness,json=targetSquareFullness,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_square_fullness"`
+	// max_change_rate is the max fraction, in the range (0, 1], by which the
+	// network min gas price changes in a single block. It is reached when the
+	// square is full or empty.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// min_gas_price_floor is the lowest network min gas price the adjustment
+	// can reach.
+	MinGasPriceFloor cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_gas_price_floor,json=minGasPriceFloor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price_floor"`
+	// min_gas_price_ceiling is the highest network min gas price the adjustment
+	// can reach.
+	MinGasPriceCeiling cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_gas_price_ceiling,json=minGasPriceCeiling,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price_ceiling"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -63,30 +82,100 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDynamicEnabled() bool {
+	if m != nil {
+		return m.DynamicEnabled
+	}
+	return false
+}
+
+// MinGasPriceRecord is the network min gas price set at the end of a block
+// by the dynamic adjustment.
+type MinGasPriceRecord struct {
+	Height             int64                       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
+	// square_fullness is the fraction of the max square filled by the block
+	// that drove the adjustment.
+	SquareFullness cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=square_fullness,json=squareFullness,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"square_fullness"`
+}
+
+func (m *MinGasPriceRecord) Reset()         { *m = MinGasPriceRecord{} }
+func (m *MinGasPriceRecord) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceRecord) ProtoMessage()    {}
+func (*MinGasPriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_821eedeb4e2f93bf, []int{1}
+}
+func (m *MinGasPriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceRecord.Merge(m, src)
+}
+func (m *MinGasPriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinGasPriceRecord proto.InternalMessageInfo
+
+func (m *MinGasPriceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.minfee.v1.Params")
+	proto.RegisterType((*MinGasPriceRecord)(nil), "celestia.minfee.v1.MinGasPriceRecord")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/params.proto", fileDescriptor_821eedeb4e2f93bf) }
 
 var fileDescriptor_821eedeb4e2f93bf = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x29, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
-	0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22,
-	0xa5, 0x94, 0xc7, 0xc5, 0x16, 0x00, 0x36, 0x54, 0x28, 0x85, 0x4b, 0x34, 0x2f, 0xb5, 0xa4, 0x3c,
-	0xbf, 0x28, 0x3b, 0x3e, 0x37, 0x33, 0x2f, 0x3e, 0x3d, 0x11, 0xa4, 0x3e, 0x33, 0x39, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xf0, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0xa5, 0x21,
-	0xda, 0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0xf4, 0x7c, 0x52, 0xd3,
-	0x13, 0x93, 0x2b, 0x5d, 0x52, 0x93, 0x2f, 0x6d, 0xd1, 0xe5, 0x82, 0x9a, 0xee, 0x92, 0x9a, 0x1c,
-	0x24, 0x04, 0x35, 0xcf, 0x37, 0x33, 0xcf, 0x3d, 0xb1, 0x38, 0x00, 0x64, 0x98, 0x93, 0xcf, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x7c, 0x96, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26, 0x16, 0x14,
-	0xe8, 0x57, 0xc0, 0x02, 0xa3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x09, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x21, 0xaa, 0xfb, 0x2c, 0x01, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0xb6, 0x44, 0xb0, 0x12, 0x0d, 0x98, 0x52, 0x99, 0x22, 0x39, 0x55, 0x2f, 0xf4,
+	0x52, 0x5b, 0x81, 0x37, 0x68, 0x4b, 0xb9, 0x14, 0xa9, 0x32, 0x27, 0x7a, 0x59, 0x26, 0xeb, 0xc9,
+	0x7a, 0x55, 0xef, 0xae, 0xd9, 0xdd, 0x94, 0xe4, 0x2d, 0x78, 0x07, 0x5e, 0x81, 0x87, 0xe8, 0xb1,
+	0xe2, 0x84, 0x38, 0x54, 0x28, 0x39, 0xf3, 0x0e, 0xc8, 0x5e, 0x07, 0x9a, 0x43, 0x2f, 0x51, 0x6f,
+	0x3b, 0x5f, 0xbf, 0x59, 0xcd, 0xfc, 0x87, 0xf4, 0x19, 0x96, 0x68, 0x9d, 0x80, 0x54, 0x0a, 0x35,
+	0x42, 0x4c, 0x2f, 0x07, 0x69, 0x05, 0x06, 0xa4, 0x4d, 0x2a, 0xa3, 0x9d, 0x0e, 0xc3, 0x45, 0x42,
+	0xe2, 0x13, 0x92, 0xcb, 0xc1, 0xce, 0x16, 0xd7, 0x5c, 0x37, 0xe1, 0xb4, 0x7e, 0xf9, 0xcc, 0x9d,
+	0x17, 0x4c, 0x5b, 0xa9, 0x2d, 0xf5, 0x01, 0x6f, 0xf8, 0xd0, 0xde, 0xb7, 0x0d, 0xd2, 0x3d, 0x6b,
+	0xa8, 0x61, 0x4e, 0x9e, 0x2b, 0x74, 0x5f, 0xb4, 0xb9, 0xa0, 0x52, 0x28, 0xca, 0xa1, 0x2e, 0x10,
+	0x0c, 0xa3, 0x60, 0x37, 0xd8, 0x7f, 0x74, 0x38, 0xb8, 0xba, 0xe9, 0x77, 0x7e, 0xdd, 0xf4, 0x5f,
+	0xfa, 0x7a, 0x9b, 0x5f, 0x24, 0x42, 0xa7, 0x12, 0x5c, 0x91, 0x9c, 0x22, 0x07, 0x36, 0x3d, 0x46,
+	0xf6, 0xe3, 0xfb, 0x01, 0x69, 0xf1, 0xc7, 0xc8, 0xb2, 0xb0, 0xe5, 0xbd, 0x17, 0xea, 0x1d, 0xd8,
+	0xb3, 0x1a, 0x16, 0xbe, 0x22, 0xbd, 0x7c, 0xaa, 0x40, 0x0a, 0x46, 0x51, 0xc1, 0xb0, 0xc4, 0x3c,
+	0x5a, 0xdb, 0x0d, 0xf6, 0x1f, 0x66, 0x9b, 0xad, 0xfb, 0xad, 0xf7, 0x86, 0x9c, 0x6c, 0x3b, 0x30,
+	0x1c, 0x1d, 0xb5, 0x9f, 0xc7, 0x60, 0x90, 0x8e, 0xc6, 0x65, 0xa9, 0xd0, 0xda, 0x68, 0x7d, 0xd5,
+	0xff, 0x6c, 0x79, 0xe0, 0x87, 0x86, 0x77, 0xd2, 0xe2, 0xc2, 0x8f, 0xa4, 0x27, 0x61, 0x42, 0x59,
+	0x01, 0x8a, 0x23, 0x35, 0xe0, 0x30, 0xda, 0x58, 0xb5, 0xc3, 0x63, 0x09, 0x93, 0xa3, 0x06, 0x94,
+	0x81, 0xc3, 0xf0, 0x13, 0x79, 0xb6, 0x34, 0x4a, 0x3a, 0x2a, 0xb5, 0x36, 0xd1, 0x83, 0x55, 0xf1,
+	0x4f, 0xe4, 0xff, 0x49, 0x9e, 0xd4, 0xa8, 0x7a, 0x69, 0xcb, 0x1d, 0x18, 0x8a, 0x52, 0x28, 0x1e,
+	0x75, 0x57, 0x5e, 0xda, 0xad, 0x1e, 0x47, 0x1e, 0xb6, 0xf7, 0x27, 0x20, 0x4f, 0x6f, 0x2d, 0x31,
+	0x43, 0xa6, 0x4d, 0x1e, 0x6e, 0x93, 0x6e, 0x81, 0x82, 0x17, 0xae, 0x51, 0xc8, 0x7a, 0xd6, 0x5a,
+	0x77, 0x0b, 0x69, 0xed, 0x3e, 0x85, 0x74, 0x4e, 0x7a, 0xf7, 0x26, 0x8c, 0x4d, 0xbb, 0x24, 0x89,
+	0xc3, 0xd3, 0xab, 0x59, 0x1c, 0x5c, 0xcf, 0xe2, 0xe0, 0xf7, 0x2c, 0x0e, 0xbe, 0xce, 0xe3, 0xce,
+	0xf5, 0x3c, 0xee, 0xfc, 0x9c, 0xc7, 0x9d, 0xf3, 0xd7, 0x5c, 0xb8, 0x62, 0x3c, 0x4c, 0x98, 0x96,
+	0xe9, 0xe2, 0xfe, 0xb4, 0xe1, 0xff, 0xde, 0x07, 0x50, 0x55, 0xe9, 0x64, 0x71, 0xb2, 0x6e, 0x5a,
+	0xa1, 0x1d, 0x76, 0x9b, 0x53, 0x7b, 0xf3, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x02, 0x5d, 0x29,
+	0xd2, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +198,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinGasPriceCeiling.Size()
+		i -= size
+		if _, err := m.MinGasPriceCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinGasPriceFloor.Size()
+		i -= size
+		if _, err := m.MinGasPriceFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TargetSquareFullness.Size()
+		i -= size
+		if _, err := m.TargetSquareFullness.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DynamicEnabled {
+		i--
+		if m.DynamicEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.NetworkMinGasPrice.Size()
 		i -= size
@@ -122,6 +261,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MinGasPriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SquareFullness.Size()
+		i -= size
+		if _, err := m.SquareFullness.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.NetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -141,6 +328,33 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.NetworkMinGasPrice.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicEnabled {
+		n += 2
+	}
+	l = m.TargetSquareFullness.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinGasPriceFloor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinGasPriceCeiling.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *MinGasPriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	l = m.NetworkMinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SquareFullness.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -213,6 +427,299 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSquareFullness", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSquareFullness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareFullness", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SquareFullness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -190,44 +190,138 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryNetworkMinGasPriceHistoryRequest is the request type for the
+// Query/NetworkMinGasPriceHistory RPC method.
+type QueryNetworkMinGasPriceHistoryRequest struct {
+}
+
+func (m *QueryNetworkMinGasPriceHistoryRequest) Reset()         { *m = QueryNetworkMinGasPriceHistoryRequest{} }
+func (m *QueryNetworkMinGasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkMinGasPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryNetworkMinGasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{4}
+}
+func (m *QueryNetworkMinGasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkMinGasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkMinGasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkMinGasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkMinGasPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryNetworkMinGasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkMinGasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkMinGasPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkMinGasPriceHistoryRequest proto.InternalMessageInfo
+
+// QueryNetworkMinGasPriceHistoryResponse is the response type for the
+// Query/NetworkMinGasPriceHistory RPC method.
+type QueryNetworkMinGasPriceHistoryResponse struct {
+	// records are ordered by height, the oldest first.
+	Records []MinGasPriceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) Reset() {
+	*m = QueryNetworkMinGasPriceHistoryResponse{}
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkMinGasPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryNetworkMinGasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{5}
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkMinGasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkMinGasPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkMinGasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkMinGasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) GetRecords() []MinGasPriceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryNetworkMinGasPrice)(nil), "celestia.minfee.v1.QueryNetworkMinGasPrice")
 	proto.RegisterType((*QueryNetworkMinGasPriceResponse)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.minfee.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.minfee.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryNetworkMinGasPriceHistoryRequest)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceHistoryRequest")
+	proto.RegisterType((*QueryNetworkMinGasPriceHistoryResponse)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceHistoryResponse")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/query.proto", fileDescriptor_4c41d9a8b7bf8984) }
 
 var fileDescriptor_4c41d9a8b7bf8984 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
-	0x10, 0xc6, 0x93, 0x15, 0x54, 0xc2, 0x9c, 0xf0, 0x2e, 0x82, 0x06, 0x94, 0x40, 0x90, 0xf8, 0x23,
-	0xb4, 0xb6, 0xda, 0xbd, 0x70, 0xae, 0x56, 0xe2, 0xb2, 0xc0, 0x92, 0x23, 0x97, 0xca, 0xeb, 0x1d,
-	0xbc, 0x56, 0x1b, 0x3b, 0x8d, 0xdd, 0x42, 0xaf, 0x5c, 0xb8, 0x22, 0xf1, 0x04, 0x7d, 0x07, 0x1e,
-	0xa2, 0xc7, 0x0a, 0x2e, 0x88, 0x43, 0x85, 0x5a, 0x1e, 0x04, 0x25, 0x4e, 0x2a, 0x55, 0x69, 0x85,
-	0xb8, 0x4d, 0xf2, 0xcd, 0x37, 0xf3, 0xf3, 0xa7, 0x41, 0x21, 0x87, 0x21, 0x18, 0x2b, 0x19, 0x4d,
-	0xa5, 0x7a, 0x0f, 0x40, 0x27, 0x1d, 0x3a, 0x1a, 0x43, 0x3e, 0x25, 0x59, 0xae, 0xad, 0xc6, 0xb8,
-	0xd6, 0x89, 0xd3, 0xc9, 0xa4, 0x13, 0x44, 0x3b, 0x3c, 0x19, 0xcb, 0x59, 0x6a, 0x9c, 0x29, 0x38,
-	0x12, 0x5a, 0xe8, 0xb2, 0xa4, 0x45, 0x55, 0xfd, 0xbd, 0x2f, 0xb4, 0x16, 0x43, 0xa0, 0x2c, 0x93,
-	0x94, 0x29, 0xa5, 0x2d, 0xb3, 0x52, 0xab, 0xda, 0xd3, 0xe6, 0xda, 0xa4, 0xda, 0xf4, 0x9d, 0xcd,
-	0x7d, 0x38, 0x29, 0x6e, 0xa3, 0x3b, 0x6f, 0x0b, 0xa4, 0xd7, 0x60, 0x3f, 0xe8, 0x7c, 0xf0, 0x4a,
-	0xaa, 0x97, 0xcc, 0x9c, 0xe7, 0x92, 0x43, 0xfc, 0xd9, 0x47, 0xd1, 0x1e, 0x2d, 0x01, 0x93, 0x69,
-	0x65, 0x00, 0x5f, 0xa2, 0xdb, 0xca, 0xa9, 0xfd, 0x54, 0xaa, 0xbe, 0x60, 0xc5, 0x12, 0xc9, 0xe1,
-	0xae, 0xff, 0xc0, 0x7f, 0x7a, 0xa3, 0xd7, 0x99, 0x2f, 0x23, 0xef, 0xd7, 0x32, 0xba, 0xe7, 0x76,
-	0x9a, 0xcb, 0x01, 0x91, 0x9a, 0xa6, 0xcc, 0x5e, 0x91, 0x33, 0x10, 0x8c, 0x4f, 0x4f, 0x81, 0x7f,
-	0xff, 0x76, 0x8c, 0x2a, 0xa4, 0x53, 0xe0, 0x09, 0x56, 0x4d, 0x92, 0x23, 0x84, 0x4b, 0x90, 0xf3,
-	0x32, 0x88, 0x04, 0x46, 0x63, 0x30, 0x36, 0x7e, 0x83, 0x0e, 0xb7, 0xfe, 0x56, 0x48, 0x2f, 0x50,
-	0xcb, 0x05, 0x56, 0x32, 0xdc, 0xec, 0x06, 0xa4, 0x19, 0x33, 0x71, 0x9e, 0xde, 0xb5, 0x82, 0x2f,
-	0xa9, 0xfa, 0xbb, 0xb3, 0x03, 0x74, 0xbd, 0x9c, 0x88, 0x67, 0x3e, 0xc2, 0xcd, 0x57, 0xe3, 0xe7,
-	0xbb, 0x46, 0xed, 0x89, 0x28, 0x38, 0xf9, 0x8f, 0xe6, 0x1a, 0x3e, 0x7e, 0xf6, 0xe9, 0xc7, 0x9f,
-	0xaf, 0x07, 0x8f, 0xf0, 0x43, 0xba, 0xe3, 0x0e, 0xb6, 0x12, 0xc6, 0x16, 0xb5, 0xdc, 0x2b, 0xf0,
-	0xe3, 0xbd, 0x9b, 0xb6, 0x02, 0x0b, 0x9e, 0xfc, 0xb3, 0xaf, 0xa2, 0x68, 0x97, 0x14, 0x87, 0xf8,
-	0x56, 0xe3, 0x08, 0x7b, 0x67, 0xf3, 0x55, 0xe8, 0x2f, 0x56, 0xa1, 0xff, 0x7b, 0x15, 0xfa, 0x5f,
-	0xd6, 0xa1, 0xb7, 0x58, 0x87, 0xde, 0xcf, 0x75, 0xe8, 0xbd, 0xeb, 0x0a, 0x69, 0xaf, 0xc6, 0x17,
-	0x84, 0xeb, 0x74, 0x03, 0xaf, 0x73, 0xb1, 0xa9, 0x8f, 0x59, 0x96, 0xd1, 0x8f, 0xf5, 0x44, 0x3b,
-	0xcd, 0xc0, 0x5c, 0xb4, 0xca, 0x23, 0x3c, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x89, 0x37, 0xfc,
-	0x9c, 0x2a, 0x03, 0x00, 0x00,
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x06, 0x45, 0x78, 0x27, 0xbc, 0x21, 0x68, 0x40, 0x29, 0x04, 0xed, 0x9f, 0xa6,
+	0xc5, 0x6a, 0x77, 0x01, 0x8e, 0xd5, 0x10, 0x1c, 0x06, 0x8c, 0x1e, 0xb9, 0x54, 0x5e, 0xfa, 0x92,
+	0x5a, 0x5b, 0xf2, 0x66, 0xb6, 0x3b, 0xe8, 0x95, 0x0b, 0x57, 0x24, 0x3e, 0x01, 0xdf, 0x81, 0xaf,
+	0x80, 0xb4, 0xe3, 0x04, 0x17, 0xc4, 0x61, 0x42, 0x2d, 0x17, 0xbe, 0x05, 0x4a, 0xec, 0x4c, 0x8a,
+	0xda, 0xa8, 0x2a, 0x37, 0xc7, 0xef, 0xfb, 0xf8, 0xf9, 0xf9, 0xc9, 0x6b, 0xe2, 0x85, 0x70, 0x0c,
+	0x4a, 0x0b, 0xce, 0x62, 0x91, 0xbc, 0x05, 0x60, 0xa7, 0x2d, 0x76, 0x32, 0x04, 0x39, 0x0a, 0x52,
+	0x89, 0x1a, 0x29, 0x2d, 0xea, 0x81, 0xa9, 0x07, 0xa7, 0x2d, 0xb7, 0x39, 0x43, 0x93, 0x72, 0xc9,
+	0x63, 0x65, 0x44, 0xee, 0x6a, 0x84, 0x11, 0xe6, 0x4b, 0x96, 0xad, 0xec, 0xee, 0xbd, 0x08, 0x31,
+	0x3a, 0x06, 0xc6, 0x53, 0xc1, 0x78, 0x92, 0xa0, 0xe6, 0x5a, 0x60, 0x52, 0x68, 0x1a, 0x21, 0xaa,
+	0x18, 0x55, 0xcf, 0xc8, 0xcc, 0x87, 0x29, 0xf9, 0x0d, 0x72, 0xfb, 0x75, 0x86, 0xf4, 0x12, 0xf4,
+	0x3b, 0x94, 0x47, 0x2f, 0x44, 0xf2, 0x8c, 0xab, 0x03, 0x29, 0x42, 0xf0, 0x3f, 0x3a, 0xa4, 0x59,
+	0x51, 0xeb, 0x82, 0x4a, 0x31, 0x51, 0x40, 0xfb, 0xe4, 0x56, 0x62, 0xaa, 0xbd, 0x58, 0x24, 0xbd,
+	0x88, 0x67, 0x26, 0x22, 0x84, 0x3b, 0xce, 0x7d, 0x67, 0xf3, 0x46, 0xa7, 0x75, 0x76, 0xd1, 0xac,
+	0xfd, 0xba, 0x68, 0xde, 0x35, 0x9e, 0xaa, 0x7f, 0x14, 0x08, 0x64, 0x31, 0xd7, 0x83, 0x60, 0x1f,
+	0x22, 0x1e, 0x8e, 0xf6, 0x20, 0xfc, 0xfe, 0x75, 0x87, 0x58, 0xa4, 0x3d, 0x08, 0xbb, 0x34, 0x99,
+	0x26, 0x59, 0x25, 0x34, 0x07, 0x39, 0xc8, 0x83, 0xe8, 0xc2, 0xc9, 0x10, 0x94, 0xf6, 0x5f, 0x91,
+	0x95, 0xd2, 0xae, 0x45, 0x7a, 0x44, 0xea, 0x26, 0xb0, 0x9c, 0x61, 0xb9, 0xed, 0x06, 0xd3, 0x31,
+	0x07, 0x46, 0xd3, 0xb9, 0x9a, 0xf1, 0x75, 0x6d, 0xbf, 0xbf, 0x41, 0xd6, 0x2a, 0xee, 0xfb, 0x5c,
+	0x28, 0x8d, 0x72, 0x54, 0x38, 0x23, 0x59, 0x9f, 0xd7, 0x68, 0x61, 0x9e, 0x92, 0xeb, 0x12, 0x42,
+	0x94, 0xfd, 0x8c, 0x66, 0x69, 0x73, 0xb9, 0xbd, 0x36, 0x8b, 0xa6, 0x94, 0x6c, 0xd6, 0x6d, 0xc1,
+	0x0a, 0x6d, 0xfb, 0xef, 0x12, 0xb9, 0x96, 0x3b, 0xd2, 0x2f, 0x0e, 0xa1, 0xd3, 0xb6, 0x74, 0x7b,
+	0xd6, 0xb1, 0x15, 0x8c, 0xee, 0xee, 0x02, 0xcd, 0xc5, 0x4d, 0xfc, 0xad, 0x0f, 0x3f, 0xfe, 0x7c,
+	0xbe, 0xf2, 0x90, 0x3e, 0x60, 0x33, 0x26, 0xb4, 0xf4, 0xef, 0xe9, 0x37, 0x87, 0x34, 0x2a, 0xa3,
+	0xa1, 0x8f, 0x17, 0x70, 0x2f, 0xe7, 0xee, 0x3e, 0xf9, 0x1f, 0xa9, 0xe5, 0x6f, 0xe5, 0xfc, 0xdb,
+	0x74, 0x6b, 0x2e, 0x3f, 0x1b, 0x58, 0x52, 0x4d, 0xea, 0x66, 0x4e, 0xe8, 0x7a, 0xa5, 0x71, 0x69,
+	0x24, 0xdd, 0x8d, 0xb9, 0x7d, 0x96, 0xa6, 0x91, 0xd3, 0xac, 0xd0, 0x9b, 0x53, 0xcf, 0xbc, 0xb3,
+	0x7f, 0x36, 0xf6, 0x9c, 0xf3, 0xb1, 0xe7, 0xfc, 0x1e, 0x7b, 0xce, 0xa7, 0x89, 0x57, 0x3b, 0x9f,
+	0x78, 0xb5, 0x9f, 0x13, 0xaf, 0xf6, 0xa6, 0x1d, 0x09, 0x3d, 0x18, 0x1e, 0x06, 0x21, 0xc6, 0x97,
+	0x97, 0x40, 0x19, 0x5d, 0xae, 0x77, 0x78, 0x9a, 0xb2, 0xf7, 0xc5, 0x89, 0x7a, 0x94, 0x82, 0x3a,
+	0xac, 0xe7, 0xcf, 0x7c, 0xf7, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x42, 0x72, 0x4c, 0x8c,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -242,8 +336,12 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
-	// NetworkMinGasPrice queries the network wide minimum gas price.
+	// NetworkMinGasPrice queries the network wide minimum gas price. If the
+	// dynamic adjustment is enabled, it is the adjusted price.
 	NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMinGasPrice, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceResponse, error)
+	// NetworkMinGasPriceHistory queries the network min gas prices set by the
+	// dynamic adjustment in the most recent blocks.
+	NetworkMinGasPriceHistory(ctx context.Context, in *QueryNetworkMinGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceHistoryResponse, error)
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -265,6 +363,15 @@ func (c *queryClient) NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMi
 	return out, nil
 }
 
+func (c *queryClient) NetworkMinGasPriceHistory(ctx context.Context, in *QueryNetworkMinGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceHistoryResponse, error) {
+	out := new(QueryNetworkMinGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/NetworkMinGasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/Params", in, out, opts...)
@@ -276,8 +383,12 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 
 // QueryServer is the server API for Query service.
 type QueryServer interface {
-	// NetworkMinGasPrice queries the network wide minimum gas price.
+	// NetworkMinGasPrice queries the network wide minimum gas price. If the
+	// dynamic adjustment is enabled, it is the adjusted price.
 	NetworkMinGasPrice(context.Context, *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error)
+	// NetworkMinGasPriceHistory queries the network min gas prices set by the
+	// dynamic adjustment in the most recent blocks.
+	NetworkMinGasPriceHistory(context.Context, *QueryNetworkMinGasPriceHistoryRequest) (*QueryNetworkMinGasPriceHistoryResponse, error)
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -289,6 +400,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) NetworkMinGasPrice(ctx context.Context, req *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkMinGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) NetworkMinGasPriceHistory(ctx context.Context, req *QueryNetworkMinGasPriceHistoryRequest) (*QueryNetworkMinGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkMinGasPriceHistory not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -315,6 +429,24 @@ func _Query_NetworkMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NetworkMinGasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkMinGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetworkMinGasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/NetworkMinGasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetworkMinGasPriceHistory(ctx, req.(*QueryNetworkMinGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +474,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NetworkMinGasPrice",
 			Handler:    _Query_NetworkMinGasPrice_Handler,
 		},
+		{
+			MethodName: "NetworkMinGasPriceHistory",
+			Handler:    _Query_NetworkMinGasPriceHistory_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -463,6 +599,66 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNetworkMinGasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkMinGasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkMinGasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -514,6 +710,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryNetworkMinGasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -787,6 +1007,140 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNetworkMinGasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, MinGasPriceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
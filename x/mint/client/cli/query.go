@@ -1,7 +1,14 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"github.com/celestiaorg/celestia-app/v6/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryInflationRate(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmdQueryGenesisTime(),
+		GetCmdQueryParams(),
+		GetCmdQuerySupplyProjection(),
 	)
 
 	return mintQueryCmd
@@ -113,3 +122,121 @@ func GetCmdQueryGenesisTime() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryParams implements a command to return the mint params.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current mint params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryParamsRequest{}
+			res, err := queryClient.Params(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySupplyProjection implements a command to return the projected
+// inflation rate, annual provisions and minted supply at a future time or
+// height.
+func GetCmdQuerySupplyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-projection [time|height]",
+		Short: "Query the projected supply at a future time or height",
+		Long: "Query the projected inflation rate, annual provisions and minted supply at a future time (RFC 3339) or height. " +
+			"The text output is a year-by-year schedule from the latest block until the time or height.",
+		Example: "supply-projection 2030-01-01T00:00:00Z\nsupply-projection 10000000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request, err := parseSupplyProjectionRequest(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.SupplyProjection(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == flags.OutputFormatJSON {
+				return clientCtx.PrintProto(res)
+			}
+			return clientCtx.PrintString(formatSupplyProjection(res))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseSupplyProjectionRequest parses a height or a time in RFC 3339 format.
+func parseSupplyProjectionRequest(arg string) (*types.QuerySupplyProjectionRequest, error) {
+	if height, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		return &types.QuerySupplyProjectionRequest{Height: height}, nil
+	}
+	t, err := time.Parse(time.RFC3339, arg)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither a height nor a time in RFC 3339 format", arg)
+	}
+	return &types.QuerySupplyProjectionRequest{Time: &t}, nil
+}
+
+// formatSupplyProjection formats the schedule of a supply projection as a
+// table followed by the projection at the requested time.
+func formatSupplyProjection(res *types.QuerySupplyProjectionResponse) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "YEAR\tSTART\tEND\tINFLATION\tANNUAL PROVISIONS\tMINTED\tTOTAL SUPPLY")
+	for _, year := range res.Schedule {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			year.Year,
+			year.StartTime.UTC().Format(time.DateOnly),
+			year.EndTime.UTC().Format(time.DateOnly),
+			formatPercent(year.InflationRate),
+			year.AnnualProvisions.TruncateInt(),
+			year.MintedSupply,
+			year.TotalSupply,
+		)
+	}
+	_ = w.Flush()
+
+	fmt.Fprintf(&buf, "\nprojection at %s (height %d): inflation %s, annual provisions %s, minted %s, total supply %s\n",
+		res.Time.UTC().Format(time.RFC3339),
+		res.Height,
+		formatPercent(res.InflationRate),
+		res.AnnualProvisions.TruncateInt(),
+		res.MintedSupply,
+		res.TotalSupply,
+	)
+	return buf.String()
+}
+
+// formatPercent formats a rate as a percentage without trailing zeros.
+func formatPercent(rate math.LegacyDec) string {
+	percent := rate.MulInt64(100).String()
+	if strings.Contains(percent, ".") {
+		percent = strings.TrimRight(strings.TrimRight(percent, "0"), ".")
+	}
+	return percent + "%"
+}
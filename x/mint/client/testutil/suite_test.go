@@ -149,6 +149,51 @@ func (s *IntegrationTestSuite) TestGetCmdQueryGenesisTime() {
 	}
 }
 
+// TestGetCmdQueryParams tests that the CLI command for params returns the
+// default params. The CLI command to query params looks like:
+// `celestia-appd query mint params`
+func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
+	cmd := cli.GetCmdQueryParams()
+	out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, s.jsonArgs())
+	s.Require().NoError(err)
+
+	var params mint.Params
+	s.Require().NoError(s.cctx.Codec.UnmarshalJSON(out.Bytes(), &params))
+	want := mint.DefaultParams()
+	s.Assert().Equal(want.InitialInflationRate, params.InitialInflationRate)
+	s.Assert().Equal(want.DisinflationRate, params.DisinflationRate)
+	s.Assert().Equal(want.TargetInflationRate, params.TargetInflationRate)
+	s.Assert().Empty(params.BlockProvisionRecipients)
+}
+
+// TestGetCmdQuerySupplyProjection tests that the CLI command for the supply
+// projection prints the year-by-year schedule. The CLI command to query the
+// supply projection looks like:
+// `celestia-appd query mint supply-projection 10000000`
+func (s *IntegrationTestSuite) TestGetCmdQuerySupplyProjection() {
+	cmd := cli.GetCmdQuerySupplyProjection()
+	out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, append([]string{"10000000"}, s.jsonArgs()...))
+	s.Require().NoError(err)
+
+	var res mint.QuerySupplyProjectionResponse
+	s.Require().NoError(s.cctx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Assert().Equal(int64(10000000), res.Height)
+	s.Assert().NotEmpty(res.Schedule)
+	s.Assert().True(res.MintedSupply.IsPositive())
+
+	end := time.Now().Add(2 * time.Duration(mint.NanosecondsPerYear)).UTC().Format(time.RFC3339)
+	cmd = cli.GetCmdQuerySupplyProjection()
+	out, err = clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, append([]string{end}, s.textArgs()...))
+	s.Require().NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	s.Require().Len(lines, 6)
+	s.Assert().True(strings.HasPrefix(lines[0], "YEAR"))
+	s.Assert().True(strings.HasPrefix(lines[1], "0 "))
+	s.Assert().True(strings.HasPrefix(lines[3], "2 "))
+	s.Assert().True(strings.HasPrefix(lines[5], "projection at "+end))
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestMintIntegrationTestSuite(t *testing.T) {
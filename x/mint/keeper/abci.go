@@ -33,7 +33,7 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 func maybeUpdateMinter(ctx sdk.Context, k Keeper) {
 	minter := k.GetMinter(ctx)
 	genesisTime := k.GetGenesisTime(ctx).GenesisTime
-	newInflationRate := minter.CalculateInflationRate(ctx, *genesisTime)
+	newInflationRate := minter.CalculateInflationRate(ctx, *genesisTime, k.GetParams(ctx))
 
 	isNonZeroAnnualProvisions := !minter.AnnualProvisions.IsZero()
 	if newInflationRate.Equal(minter.InflationRate) && isNonZeroAnnualProvisions {
@@ -70,7 +70,30 @@ func mintBlockProvision(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 
-	err = k.SendCoinsToFeeCollector(ctx, toMintCoins)
+	// send the fraction of each block provision recipient and the remainder
+	// to the fee collector.
+	remaining := toMintCoin.Amount
+	for _, recipient := range k.GetParams(ctx).BlockProvisionRecipients {
+		amount := recipient.Fraction.MulInt(toMintCoin.Amount).TruncateInt()
+		if amount.IsZero() {
+			continue
+		}
+		addr := sdk.MustAccAddressFromBech32(recipient.Address)
+		// the params reject blocked addresses but the blocked addresses can
+		// change in an upgrade. Their fraction goes to the fee collector
+		// instead of halting the chain.
+		if k.isBlockedAddr(addr) {
+			ctx.Logger().Error("skipping blocked block provision recipient", "address", recipient.Address)
+			continue
+		}
+		err = k.SendCoinsToAccount(ctx, addr, sdk.NewCoins(sdk.NewCoin(toMintCoin.Denom, amount)))
+		if err != nil {
+			return err
+		}
+		remaining = remaining.Sub(amount)
+	}
+
+	err = k.SendCoinsToFeeCollector(ctx, sdk.NewCoins(sdk.NewCoin(toMintCoin.Denom, remaining)))
 	if err != nil {
 		return err
 	}
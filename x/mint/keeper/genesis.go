@@ -11,7 +11,17 @@ import (
 func (k Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, data *types.GenesisState) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	params := types.DefaultParams()
+	if data.Params != nil {
+		params = *data.Params
+	}
+	if err := k.ValidateBlockProvisionRecipients(params); err != nil {
+		return err
+	}
+	k.SetParams(sdkCtx, params)
+
 	minter := types.DefaultMinter()
+	minter.InflationRate = params.InitialInflationRate
 	minter.BondDenom = data.BondDenom
 	k.SetMinter(sdkCtx, minter)
 	// override the genesis time with the actual genesis time supplied in `InitChain`
@@ -32,5 +42,8 @@ func (k Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, data *t
 func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	bondDenom := k.GetMinter(sdkCtx).BondDenom
-	return types.NewGenesisState(bondDenom)
+	params := k.GetParams(sdkCtx)
+	genesis := types.NewGenesisState(bondDenom)
+	genesis.Params = &params
+	return genesis
 }
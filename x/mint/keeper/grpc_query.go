@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"context"
+	"time"
 
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -32,3 +36,68 @@ func (k Keeper) GenesisTime(c context.Context, _ *types.QueryGenesisTimeRequest)
 
 	return &types.QueryGenesisTimeResponse{GenesisTime: genesisTime}, nil
 }
+
+// Params returns the params of the mint module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// SupplyProjection projects the inflation rate, the annual provisions and the
+// minted supply at a future time or height starting from the latest block.
+func (k Keeper) SupplyProjection(c context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if (req.Time == nil) == (req.Height == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of time and height must be set")
+	}
+
+	minter := k.GetMinter(ctx)
+	genesisTime := *k.GetGenesisTime(ctx).GenesisTime
+	start := ctx.BlockTime()
+	if minter.PreviousBlockTime != nil {
+		start = *minter.PreviousBlockTime
+	}
+
+	var end time.Time
+	height := req.Height
+	if req.Time != nil {
+		end = *req.Time
+		height = ctx.BlockHeight() + int64(end.Sub(start)/averageBlockTime(ctx, genesisTime, start))
+	} else {
+		if height <= ctx.BlockHeight() {
+			return nil, status.Errorf(codes.InvalidArgument, "height %d must be after the latest height %d", height, ctx.BlockHeight())
+		}
+		end = start.Add(time.Duration(height-ctx.BlockHeight()) * averageBlockTime(ctx, genesisTime, start))
+	}
+
+	supply := k.StakingTokenSupply(ctx)
+	schedule, err := minter.ProjectSupply(ctx, genesisTime, start, end, supply, k.GetParams(ctx))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	last := schedule[len(schedule)-1]
+	return &types.QuerySupplyProjectionResponse{
+		Time:             end,
+		Height:           height,
+		InflationRate:    last.InflationRate,
+		AnnualProvisions: last.AnnualProvisions,
+		MintedSupply:     last.TotalSupply.Sub(supply),
+		TotalSupply:      last.TotalSupply,
+		Schedule:         schedule,
+	}, nil
+}
+
+// averageBlockTime returns the average block time since genesis. It returns
+// appconsts.GoalBlockTime if no block has been produced after genesis.
+func averageBlockTime(ctx sdk.Context, genesisTime, latest time.Time) time.Duration {
+	blocks := ctx.BlockHeight() - 1
+	elapsed := latest.Sub(genesisTime)
+	if blocks <= 0 || elapsed <= 0 {
+		return appconsts.GoalBlockTime
+	}
+	return elapsed / time.Duration(blocks)
+}
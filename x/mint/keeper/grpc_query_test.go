@@ -3,11 +3,16 @@ package keeper_test //nolint:all
 import (
 	gocontext "context"
 	"testing"
+	"time"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v6/app"
 	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
 	"github.com/celestiaorg/celestia-app/v6/x/mint/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,4 +35,57 @@ func TestGRPC(t *testing.T) {
 	genesisTime, err := queryClient.GenesisTime(gocontext.Background(), &types.QueryGenesisTimeRequest{})
 	require.NoError(t, err)
 	require.Equal(t, genesisTime.GenesisTime, testApp.MintKeeper.GetGenesisTime(ctx).GenesisTime)
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params.Params, testApp.MintKeeper.GetParams(ctx))
+}
+
+func TestSupplyProjection(t *testing.T) {
+	a, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), tmproto.Header{Height: 2}, false, log.NewNopLogger())
+	start := *a.MintKeeper.GetMinter(ctx).PreviousBlockTime
+	blockInterval := time.Hour
+	blocks := 24
+
+	t.Run("matches the minted supply", func(t *testing.T) {
+		end := start.Add(time.Duration(blocks) * blockInterval)
+		projection, err := a.MintKeeper.SupplyProjection(ctx, &types.QuerySupplyProjectionRequest{Time: &end})
+		require.NoError(t, err)
+		require.Equal(t, end, projection.Time)
+		require.Len(t, projection.Schedule, 1)
+
+		cacheCtx, _ := ctx.CacheContext()
+		initialSupply := a.MintKeeper.StakingTokenSupply(cacheCtx)
+		for i := 1; i <= blocks; i++ {
+			blockCtx := cacheCtx.WithBlockHeight(ctx.BlockHeight() + int64(i)).WithBlockTime(start.Add(time.Duration(i) * blockInterval))
+			require.NoError(t, a.MintKeeper.BeginBlocker(blockCtx))
+		}
+		minted := a.MintKeeper.StakingTokenSupply(cacheCtx).Sub(initialSupply)
+
+		// the block provisions are truncated every block.
+		require.True(t, projection.MintedSupply.GTE(minted))
+		require.True(t, projection.MintedSupply.Sub(minted).LTE(math.NewInt(int64(blocks))))
+		require.Equal(t, initialSupply.Add(projection.MintedSupply), projection.TotalSupply)
+		require.Equal(t, a.MintKeeper.GetMinter(cacheCtx).InflationRate, projection.InflationRate)
+	})
+
+	t.Run("height", func(t *testing.T) {
+		projection, err := a.MintKeeper.SupplyProjection(ctx, &types.QuerySupplyProjectionRequest{Height: 1000})
+		require.NoError(t, err)
+		require.Equal(t, int64(1000), projection.Height)
+		require.True(t, projection.Time.After(start))
+		require.True(t, projection.MintedSupply.IsPositive())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := a.MintKeeper.SupplyProjection(ctx, &types.QuerySupplyProjectionRequest{})
+		require.Error(t, err)
+		_, err = a.MintKeeper.SupplyProjection(ctx, &types.QuerySupplyProjectionRequest{Height: 1, Time: &start})
+		require.Error(t, err)
+		_, err = a.MintKeeper.SupplyProjection(ctx, &types.QuerySupplyProjectionRequest{Height: 1})
+		require.Error(t, err)
+		_, err = a.MintKeeper.SupplyProjection(ctx, &types.QuerySupplyProjectionRequest{Time: &start})
+		require.Error(t, err)
+	})
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v6/x/mint/types"
@@ -15,6 +17,12 @@ type Keeper struct {
 	stakingKeeper    types.StakingKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+	// blockedAddrs are the addresses that can't be block provision
+	// recipients, e.g. module accounts.
+	blockedAddrs map[string]bool
 }
 
 // NewKeeper creates a new mint Keeper instance.
@@ -25,6 +33,8 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	feeCollectorName string,
+	authority string,
+	blockedAddrs map[string]bool,
 ) Keeper {
 	// Ensure the mint module account has been set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -37,9 +47,16 @@ func NewKeeper(
 		stakingKeeper:    stakingKeeper,
 		bankKeeper:       bankKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		blockedAddrs:     blockedAddrs,
 	}
 }
 
+// GetAuthority returns the address that is allowed to update the params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetMinter returns the minter.
 func (k Keeper) GetMinter(ctx sdk.Context) (minter types.Minter) {
 	store := ctx.KVStore(k.storeKey)
@@ -96,6 +113,37 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, newCoins)
 }
 
+// isBlockedAddr returns true if the address is not allowed to receive block
+// provisions.
+func (k Keeper) isBlockedAddr(addr sdk.AccAddress) bool {
+	if k.blockedAddrs[addr.String()] {
+		return true
+	}
+	return k.bankKeeper != nil && k.bankKeeper.BlockedAddr(addr)
+}
+
+// ValidateBlockProvisionRecipients returns an error if a block provision
+// recipient is a blocked address. Sending coins to a blocked address fails so
+// it would halt minting.
+func (k Keeper) ValidateBlockProvisionRecipients(params types.Params) error {
+	for _, recipient := range params.BlockProvisionRecipients {
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+		if k.isBlockedAddr(addr) {
+			return fmt.Errorf("block provision recipient %s is not allowed to receive funds", recipient.Address)
+		}
+	}
+	return nil
+}
+
+// SendCoinsToAccount sends newly minted coins from the x/mint module to the
+// recipient account.
+func (k Keeper) SendCoinsToAccount(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
 // SendCoinsToFeeCollector sends newly minted coins from the x/mint module to
 // the x/auth fee collector module account.
 func (k Keeper) SendCoinsToFeeCollector(ctx sdk.Context, coins sdk.Coins) error {
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v6/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is responsible for handling migrations related to the mint module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator creates a new Migrator instance using the provided Keeper for
+// handling migrations in the mint module.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateParams stores the default params which are the previously hard-coded
+// rates so that existing networks keep their inflation schedule.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	if store.Has(types.KeyParams) {
+		return nil
+	}
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
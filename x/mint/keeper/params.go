@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v6/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the params of the module. The default params are returned
+// if they haven't been set, e.g. before the params migration.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.KeyParams) {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(store.Get(types.KeyParams), &params)
+	return params
+}
+
+// SetParams sets the params of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.KeyParams, bz)
+}
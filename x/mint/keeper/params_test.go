@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/x/mint/keeper"
+	minttypes "github.com/celestiaorg/celestia-app/v6/x/mint/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParams(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), tmproto.Header{}, false, log.NewNopLogger())
+	authority := a.MintKeeper.GetAuthority()
+
+	params := minttypes.NewParams(math.LegacyNewDecWithPrec(8, 2), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 2), nil)
+
+	t.Run("rejects an invalid authority", func(t *testing.T) {
+		_, err := a.MintKeeper.UpdateParams(ctx, minttypes.NewMsgUpdateParams(sdk.AccAddress("invalid").String(), params))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("rejects invalid params", func(t *testing.T) {
+		invalid := params
+		invalid.TargetInflationRate = math.LegacyOneDec()
+		_, err := a.MintKeeper.UpdateParams(ctx, minttypes.NewMsgUpdateParams(authority, invalid))
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+
+	t.Run("rejects a module account recipient", func(t *testing.T) {
+		withModuleAccount := params
+		withModuleAccount.BlockProvisionRecipients = []minttypes.BlockProvisionRecipient{
+			{Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(), Fraction: math.LegacyNewDecWithPrec(1, 1)},
+		}
+		_, err := a.MintKeeper.UpdateParams(ctx, minttypes.NewMsgUpdateParams(authority, withModuleAccount))
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		require.Equal(t, minttypes.DefaultParams(), a.MintKeeper.GetParams(ctx))
+	})
+
+	t.Run("updates the params", func(t *testing.T) {
+		_, err := a.MintKeeper.UpdateParams(ctx, minttypes.NewMsgUpdateParams(authority, params))
+		require.NoError(t, err)
+		require.Equal(t, params, a.MintKeeper.GetParams(ctx))
+
+		genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+		ctx = ctx.WithBlockTime(genesisTime.Add(oneYear))
+		require.NoError(t, a.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.072"), a.MintKeeper.GetMinter(ctx).InflationRate)
+	})
+}
+
+func TestBlockProvisionRecipients(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), tmproto.Header{}, false, log.NewNopLogger())
+	genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+	ctx = ctx.WithBlockTime(*genesisTime)
+
+	recipient := sdk.AccAddress("recipient")
+	params := minttypes.DefaultParams()
+	params.BlockProvisionRecipients = []minttypes.BlockProvisionRecipient{
+		{Address: recipient.String(), Fraction: math.LegacyNewDecWithPrec(25, 2)},
+	}
+	_, err := a.MintKeeper.UpdateParams(ctx, minttypes.NewMsgUpdateParams(a.MintKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+
+	minter := a.MintKeeper.GetMinter(ctx)
+	minter.PreviousBlockTime = genesisTime
+	a.MintKeeper.SetMinter(ctx, minter)
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	bondDenom := a.MintKeeper.GetMinter(ctx).BondDenom
+	feeCollectorBefore := a.BankKeeper.GetBalance(ctx, feeCollector, bondDenom).Amount
+
+	ctx = ctx.WithBlockTime(genesisTime.Add(15 * time.Second))
+	require.NoError(t, a.MintKeeper.BeginBlocker(ctx))
+
+	provision, err := minter.CalculateBlockProvision(ctx.BlockTime(), *genesisTime)
+	require.NoError(t, err)
+	require.True(t, provision.IsPositive())
+
+	recipientAmount := math.LegacyNewDecWithPrec(25, 2).MulInt(provision.Amount).TruncateInt()
+	require.Equal(t, recipientAmount, a.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+	feeCollectorAmount := a.BankKeeper.GetBalance(ctx, feeCollector, bondDenom).Amount.Sub(feeCollectorBefore)
+	require.Equal(t, provision.Amount.Sub(recipientAmount), feeCollectorAmount)
+}
+
+func TestMigrateParams(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), tmproto.Header{}, false, log.NewNopLogger())
+
+	// remove the params to mimic the state before the migration.
+	ctx.KVStore(a.GetKey(minttypes.StoreKey)).Delete(minttypes.KeyParams)
+	require.Equal(t, minttypes.DefaultParams(), a.MintKeeper.GetParams(ctx))
+
+	migrator := keeper.NewMigrator(a.MintKeeper)
+	require.NoError(t, migrator.MigrateParams(ctx))
+	require.True(t, ctx.KVStore(a.GetKey(minttypes.StoreKey)).Has(minttypes.KeyParams))
+	require.Equal(t, minttypes.DefaultParams(), a.MintKeeper.GetParams(ctx))
+
+	// the migration doesn't override params that are already set.
+	params := minttypes.NewParams(math.LegacyNewDecWithPrec(8, 2), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 2), nil)
+	a.MintKeeper.SetParams(ctx, params)
+	require.NoError(t, migrator.MigrateParams(ctx))
+	require.Equal(t, params, a.MintKeeper.GetParams(ctx))
+}
+
+func TestGenesisParams(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), tmproto.Header{}, false, log.NewNopLogger())
+
+	params := minttypes.NewParams(math.LegacyNewDecWithPrec(8, 2), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 2), nil)
+	genesis := minttypes.NewGenesisState("utia")
+	genesis.Params = &params
+	require.NoError(t, a.MintKeeper.InitGenesis(ctx, a.AccountKeeper, genesis))
+	require.Equal(t, params, a.MintKeeper.GetParams(ctx))
+	require.Equal(t, params.InitialInflationRate, a.MintKeeper.GetMinter(ctx).InflationRate)
+	require.Equal(t, genesis, a.MintKeeper.ExportGenesis(ctx))
+
+	// module accounts can't be block provision recipients.
+	withModuleAccount := params
+	withModuleAccount.BlockProvisionRecipients = []minttypes.BlockProvisionRecipient{
+		{Address: authtypes.NewModuleAddress(minttypes.ModuleName).String(), Fraction: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	genesis.Params = &withModuleAccount
+	require.Error(t, a.MintKeeper.InitGenesis(ctx, a.AccountKeeper, genesis))
+
+	// nil params fall back to the default params.
+	require.NoError(t, a.MintKeeper.InitGenesis(ctx, a.AccountKeeper, minttypes.NewGenesisState("utia")))
+	require.Equal(t, minttypes.DefaultParams(), a.MintKeeper.GetParams(ctx))
+}
+
+func TestBlockedBlockProvisionRecipient(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), tmproto.Header{}, false, log.NewNopLogger())
+	genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+
+	// a recipient that became blocked after the params were set, e.g. in an
+	// upgrade, doesn't halt minting.
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	distribution := authtypes.NewModuleAddress("distribution")
+	params := minttypes.DefaultParams()
+	params.BlockProvisionRecipients = []minttypes.BlockProvisionRecipient{
+		{Address: distribution.String(), Fraction: math.LegacyNewDecWithPrec(5, 1)},
+	}
+	a.MintKeeper.SetParams(ctx, params)
+
+	minter := a.MintKeeper.GetMinter(ctx)
+	minter.PreviousBlockTime = genesisTime
+	a.MintKeeper.SetMinter(ctx, minter)
+
+	bondDenom := minter.BondDenom
+	feeCollectorBefore := a.BankKeeper.GetBalance(ctx, feeCollector, bondDenom).Amount
+	distributionBefore := a.BankKeeper.GetBalance(ctx, distribution, bondDenom).Amount
+
+	ctx = ctx.WithBlockTime(genesisTime.Add(15 * time.Second))
+	require.NoError(t, a.MintKeeper.BeginBlocker(ctx))
+
+	provision, err := minter.CalculateBlockProvision(ctx.BlockTime(), *genesisTime)
+	require.NoError(t, err)
+	require.Equal(t, provision.Amount, a.BankKeeper.GetBalance(ctx, feeCollector, bondDenom).Amount.Sub(feeCollectorBefore))
+	require.Equal(t, distributionBefore, a.BankKeeper.GetBalance(ctx, distribution, bondDenom).Amount)
+}
@@ -1,8 +1,10 @@
 package types
 
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint         = ModuleName
+	EventTypeUpdateParams = "mint_update_params"
 
 	AttributeKeyInflationRate    = "inflation_rate"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyAuthority        = "authority"
 )
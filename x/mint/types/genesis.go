@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/celestiaorg/celestia-app/v6/app/params"
 )
@@ -15,8 +16,10 @@ func NewGenesisState(bondDenom string) *GenesisState {
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
+	defaultParams := DefaultParams()
 	return &GenesisState{
 		BondDenom: params.BondDenom,
+		Params:    &defaultParams,
 	}
 }
 
@@ -26,5 +29,10 @@ func ValidateGenesis(data GenesisState) error {
 	if data.BondDenom == "" {
 		return errors.New("bond denom cannot be empty")
 	}
+	if data.Params != nil {
+		if err := data.Params.Validate(); err != nil {
+			return fmt.Errorf("invalid params: %w", err)
+		}
+	}
 	return nil
 }
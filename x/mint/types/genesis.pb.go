@@ -26,6 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// BondDenom is the denomination of the token that should be minted.
 	BondDenom string `protobuf:"bytes,2,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// Params are the params of the module. The default params are used if they
+	// are unset.
+	Params *Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +71,13 @@ func (m *GenesisState) GetBondDenom() string {
 	return ""
 }
 
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.mint.v1.GenesisState")
 }
@@ -75,18 +85,21 @@ func init() {
 func init() { proto.RegisterFile("celestia/mint/v1/genesis.proto", fileDescriptor_1932cb996a3161e7) }
 
 var fileDescriptor_1932cb996a3161e7 = []byte{
-	// 176 bytes of a gzipped FileDescriptorProto
+	// 212 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
 	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xeb, 0x81,
-	0xe4, 0xf5, 0xca, 0x0c, 0x95, 0x8c, 0xb9, 0x78, 0xdc, 0x21, 0x4a, 0x82, 0x4b, 0x12, 0x4b, 0x52,
-	0x85, 0x64, 0xb9, 0xb8, 0x92, 0xf2, 0xf3, 0x52, 0xe2, 0x53, 0x52, 0xf3, 0xf2, 0x73, 0x25, 0x98,
-	0x14, 0x18, 0x35, 0x38, 0x83, 0x38, 0x41, 0x22, 0x2e, 0x20, 0x01, 0x2f, 0x16, 0x0e, 0x46, 0x01,
-	0x26, 0x27, 0xaf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xd9, 0x95, 0x5f, 0x94, 0x0e, 0x67,
-	0xeb, 0x26, 0x16, 0x14, 0xe8, 0x57, 0x40, 0x5c, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x76, 0x99, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x47, 0xd0, 0x42, 0xbb, 0x00, 0x00, 0x00,
+	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x64, 0x31, 0x74, 0x14, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x35, 0x28,
+	0xa5, 0x72, 0xf1, 0xb8, 0x43, 0x4c, 0x08, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x92, 0xe5, 0xe2, 0x4a,
+	0xca, 0xcf, 0x4b, 0x89, 0x4f, 0x49, 0xcd, 0xcb, 0xcf, 0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c,
+	0xe2, 0x04, 0x89, 0xb8, 0x80, 0x04, 0x84, 0x0c, 0xb8, 0xd8, 0x20, 0xda, 0x25, 0x98, 0x15, 0x18,
+	0x35, 0xb8, 0x8d, 0x24, 0xf4, 0xd0, 0x2d, 0xd4, 0x0b, 0x00, 0xcb, 0x07, 0x41, 0xd5, 0x79, 0xb1,
+	0x70, 0x30, 0x0a, 0x30, 0x39, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
+	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
+	0x94, 0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0xac, 0xfc,
+	0xa2, 0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0xe2, 0xf8, 0x92, 0xca, 0x82, 0xd4,
+	0xe2, 0x24, 0x36, 0xb0, 0xcb, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdc, 0xb0, 0xfd, 0xb1,
+	0x0c, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -109,6 +122,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -140,6 +165,10 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -210,6 +239,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
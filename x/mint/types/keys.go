@@ -6,6 +6,9 @@ var KeyMinter = []byte("Minter")
 // KeyGenesisTime is the key to use for GenesisTime in the mint store.
 var KeyGenesisTime = []byte("GenesisTime")
 
+// KeyParams is the key to use for the Params in the mint store.
+var KeyParams = []byte("Params")
+
 const (
 	// ModuleName is the name of the mint module.
 	ModuleName = "mint"
@@ -40,12 +40,13 @@ func (m Minter) Validate() error {
 
 // CalculateInflationRate returns the inflation rate for the current year depending on
 // the current block time in context. The inflation rate is expected to
-// decrease every year according to the schedule specified in the README.
-func (m Minter) CalculateInflationRate(ctx sdk.Context, genesisTime time.Time) math.LegacyDec {
+// decrease every year according to the schedule specified in the README and
+// the rates in params.
+func (m Minter) CalculateInflationRate(ctx sdk.Context, genesisTime time.Time, params Params) math.LegacyDec {
 	yearsSinceGenesis := yearsSinceGenesis(genesisTime, ctx.BlockTime())
-	inflationRate := InitialInflationRateAsDec().Mul(math.LegacyOneDec().Sub(DisinflationRateAsDec()).Power(uint64(yearsSinceGenesis)))
-	if inflationRate.LT(TargetInflationRateAsDec()) {
-		return TargetInflationRateAsDec()
+	inflationRate := params.InitialInflationRate.Mul(math.LegacyOneDec().Sub(params.DisinflationRate).Power(uint64(yearsSinceGenesis)))
+	if inflationRate.LT(params.TargetInflationRate) {
+		return params.TargetInflationRate
 	}
 
 	return inflationRate
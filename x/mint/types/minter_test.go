@@ -53,7 +53,7 @@ func TestCalculateInflationRate(t *testing.T) {
 			years := time.Duration(tc.year * NanosecondsPerYear * int64(time.Nanosecond))
 			blockTime := genesisTime.Add(years)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil).WithBlockTime(blockTime)
-			inflationRate := minter.CalculateInflationRate(ctx, genesisTime)
+			inflationRate := minter.CalculateInflationRate(ctx, genesisTime, DefaultParams())
 			got, err := inflationRate.Float64()
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want, got, "want %v got %v year %v blockTime %v", tc.want, got, tc.year, blockTime)
@@ -176,7 +176,7 @@ func BenchmarkCalculateInflationRate(b *testing.B) {
 
 	for b.Loop() {
 		ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
-		minter.CalculateInflationRate(ctx, genesisTime)
+		minter.CalculateInflationRate(ctx, genesisTime, DefaultParams())
 	}
 }
 
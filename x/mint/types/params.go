@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxBlockProvisionRecipients is the maximum number of accounts that receive a
+// fraction of every block provision.
+const MaxBlockProvisionRecipients = 10
+
+// NewParams creates a new Params instance.
+func NewParams(initialInflationRate, disinflationRate, targetInflationRate math.LegacyDec, recipients []BlockProvisionRecipient) Params {
+	return Params{
+		InitialInflationRate:     initialInflationRate,
+		DisinflationRate:         disinflationRate,
+		TargetInflationRate:      targetInflationRate,
+		BlockProvisionRecipients: recipients,
+	}
+}
+
+// DefaultParams returns the default parameters of the module which are the
+// rates defined in CIP-29. The whole block provision is sent to the fee
+// collector by default.
+func DefaultParams() Params {
+	return NewParams(InitialInflationRateAsDec(), DisinflationRateAsDec(), TargetInflationRateAsDec(), nil)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateRate("initial inflation rate", p.InitialInflationRate); err != nil {
+		return err
+	}
+	if err := validateRate("disinflation rate", p.DisinflationRate); err != nil {
+		return err
+	}
+	if err := validateRate("target inflation rate", p.TargetInflationRate); err != nil {
+		return err
+	}
+	if p.TargetInflationRate.GT(p.InitialInflationRate) {
+		return fmt.Errorf("target inflation rate %s cannot be greater than initial inflation rate %s", p.TargetInflationRate, p.InitialInflationRate)
+	}
+	return validateBlockProvisionRecipients(p.BlockProvisionRecipients)
+}
+
+func validateRate(name string, rate math.LegacyDec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("%s must be set", name)
+	}
+	if rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s %s must be in the range [0, 1]", name, rate)
+	}
+	return nil
+}
+
+func validateBlockProvisionRecipients(recipients []BlockProvisionRecipient) error {
+	if len(recipients) > MaxBlockProvisionRecipients {
+		return fmt.Errorf("number of block provision recipients %d exceeds the maximum %d", len(recipients), MaxBlockProvisionRecipients)
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(recipients))
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return fmt.Errorf("invalid block provision recipient address %q: %w", recipient.Address, err)
+		}
+		if seen[recipient.Address] {
+			return fmt.Errorf("duplicate block provision recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Fraction.IsNil() || !recipient.Fraction.IsPositive() || recipient.Fraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("fraction %s of block provision recipient %s must be in the range (0, 1]", recipient.Fraction, recipient.Address)
+		}
+		total = total.Add(recipient.Fraction)
+	}
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fractions of the block provision recipients sum to %s which is greater than 1", total)
+	}
+	return nil
+}
@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/mint/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the mint module.
+type Params struct {
+	// InitialInflationRate is the inflation rate of the first year after
+	// genesis.
+	InitialInflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=initial_inflation_rate,json=initialInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"initial_inflation_rate"`
+	// DisinflationRate is the rate at which the inflation rate decreases each
+	// year.
+	DisinflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=disinflation_rate,json=disinflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"disinflation_rate"`
+	// TargetInflationRate is the inflation rate that the network aims to
+	// stabilize at. The inflation rate doesn't decrease after reaching it.
+	TargetInflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=target_inflation_rate,json=targetInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_inflation_rate"`
+	// BlockProvisionRecipients receive a fraction of every block provision. The
+	// rest of the block provision is sent to the fee collector.
+	BlockProvisionRecipients []BlockProvisionRecipient `protobuf:"bytes,4,rep,name=block_provision_recipients,json=blockProvisionRecipients,proto3" json:"block_provision_recipients"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad74936e076812e, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBlockProvisionRecipients() []BlockProvisionRecipient {
+	if m != nil {
+		return m.BlockProvisionRecipients
+	}
+	return nil
+}
+
+// BlockProvisionRecipient is an account that receives a fraction of every
+// block provision.
+type BlockProvisionRecipient struct {
+	// Address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Fraction is the fraction, in the range (0, 1], of the block provision that
+	// the account receives.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+}
+
+func (m *BlockProvisionRecipient) Reset()         { *m = BlockProvisionRecipient{} }
+func (m *BlockProvisionRecipient) String() string { return proto.CompactTextString(m) }
+func (*BlockProvisionRecipient) ProtoMessage()    {}
+func (*BlockProvisionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad74936e076812e, []int{1}
+}
+func (m *BlockProvisionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockProvisionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockProvisionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockProvisionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockProvisionRecipient.Merge(m, src)
+}
+func (m *BlockProvisionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockProvisionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockProvisionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockProvisionRecipient proto.InternalMessageInfo
+
+func (m *BlockProvisionRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celestia.mint.v1.Params")
+	proto.RegisterType((*BlockProvisionRecipient)(nil), "celestia.mint.v1.BlockProvisionRecipient")
+}
+
+func init() { proto.RegisterFile("celestia/mint/v1/params.proto", fileDescriptor_3ad74936e076812e) }
+
+var fileDescriptor_3ad74936e076812e = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xda, 0x30,
+	0x1c, 0xc6, 0x93, 0x81, 0xd8, 0xe6, 0x5d, 0x58, 0xc6, 0xb6, 0x8c, 0x69, 0x01, 0x71, 0x62, 0x07,
+	0x9c, 0xc1, 0x9e, 0x60, 0x11, 0x97, 0x4d, 0x9b, 0x84, 0xb2, 0x5b, 0x0f, 0x45, 0x8e, 0x63, 0x8c,
+	0x45, 0x12, 0x47, 0xb6, 0x8b, 0xca, 0x5b, 0xf4, 0x01, 0x7a, 0xef, 0x0b, 0xf0, 0x10, 0x1c, 0x11,
+	0xa7, 0xaa, 0x07, 0x54, 0xc1, 0x8b, 0x54, 0x89, 0x13, 0xd4, 0xa2, 0xf6, 0xc2, 0xcd, 0xd1, 0xe7,
+	0xef, 0xf7, 0xe5, 0xfb, 0xfb, 0x0f, 0xbe, 0x61, 0x12, 0x11, 0xa9, 0x18, 0x72, 0x63, 0x96, 0x28,
+	0x77, 0xde, 0x77, 0x53, 0x24, 0x50, 0x2c, 0x61, 0x2a, 0xb8, 0xe2, 0x56, 0xbd, 0x94, 0x61, 0x26,
+	0xc3, 0x79, 0xbf, 0xd9, 0xa0, 0x9c, 0xf2, 0x5c, 0x74, 0xb3, 0x93, 0xbe, 0xd7, 0xfc, 0x82, 0xb9,
+	0x8c, 0xb9, 0x1c, 0x6b, 0x41, 0x7f, 0x68, 0xa9, 0x73, 0x53, 0x01, 0xb5, 0x51, 0xce, 0xb4, 0x28,
+	0xf8, 0xc4, 0x12, 0xa6, 0x18, 0x8a, 0xc6, 0x2c, 0x99, 0x44, 0x48, 0x31, 0x9e, 0x8c, 0x05, 0x52,
+	0xc4, 0x36, 0xdb, 0x66, 0xf7, 0xad, 0xd7, 0x5f, 0x6d, 0x5b, 0xc6, 0xdd, 0xb6, 0xf5, 0x55, 0x03,
+	0x64, 0x38, 0x83, 0x8c, 0xbb, 0x31, 0x52, 0x53, 0xf8, 0x97, 0x50, 0x84, 0x17, 0x43, 0x82, 0x37,
+	0xcb, 0x1e, 0x28, 0xf8, 0x43, 0x82, 0xfd, 0x46, 0x01, 0xfc, 0x5d, 0xf2, 0x7c, 0xa4, 0x88, 0x75,
+	0x0e, 0xde, 0x87, 0x4c, 0x1e, 0x65, 0xbc, 0x3a, 0x35, 0xa3, 0xfe, 0x98, 0x95, 0xf3, 0x09, 0xf8,
+	0xa8, 0x90, 0xa0, 0x44, 0x1d, 0xf7, 0xa8, 0x9c, 0x9a, 0xf1, 0x41, 0xf3, 0x9e, 0xd6, 0x88, 0x41,
+	0x33, 0x88, 0x38, 0x9e, 0x65, 0x63, 0x9d, 0x33, 0x99, 0xa7, 0x10, 0xcc, 0x52, 0x46, 0x12, 0x25,
+	0xed, 0x6a, 0xbb, 0xd2, 0x7d, 0x37, 0xf8, 0x0e, 0x8f, 0x9f, 0x08, 0x7a, 0x99, 0x67, 0x54, 0x5a,
+	0xfc, 0xd2, 0xe1, 0x55, 0xb3, 0xdf, 0xf2, 0xed, 0xe0, 0x79, 0x59, 0x76, 0xae, 0x4d, 0xf0, 0xf9,
+	0x05, 0xaf, 0x35, 0x00, 0xaf, 0x51, 0x18, 0x0a, 0x22, 0x65, 0xf1, 0x56, 0xf6, 0x66, 0xd9, 0x6b,
+	0x14, 0x05, 0x7e, 0x69, 0xe5, 0xbf, 0x12, 0x2c, 0xa1, 0x7e, 0x79, 0xd1, 0xfa, 0x07, 0xde, 0x4c,
+	0x04, 0xc2, 0x59, 0x9d, 0xd3, 0x87, 0x7f, 0x40, 0x78, 0x7f, 0x56, 0x3b, 0xc7, 0x5c, 0xef, 0x1c,
+	0xf3, 0x7e, 0xe7, 0x98, 0x57, 0x7b, 0xc7, 0x58, 0xef, 0x1d, 0xe3, 0x76, 0xef, 0x18, 0x67, 0x3f,
+	0x28, 0x53, 0xd3, 0x8b, 0x00, 0x62, 0x1e, 0xbb, 0xe5, 0x34, 0xb8, 0xa0, 0x87, 0x73, 0x0f, 0xa5,
+	0xa9, 0x7b, 0xa9, 0x37, 0x5c, 0x2d, 0x52, 0x22, 0x83, 0x5a, 0xbe, 0x9b, 0x3f, 0x1f, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xf4, 0x91, 0x55, 0x1e, 0xff, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockProvisionRecipients) > 0 {
+		for iNdEx := len(m.BlockProvisionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockProvisionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TargetInflationRate.Size()
+		i -= size
+		if _, err := m.TargetInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DisinflationRate.Size()
+		i -= size
+		if _, err := m.DisinflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialInflationRate.Size()
+		i -= size
+		if _, err := m.InitialInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockProvisionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockProvisionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockProvisionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialInflationRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DisinflationRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetInflationRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.BlockProvisionRecipients) > 0 {
+		for _, e := range m.BlockProvisionRecipients {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockProvisionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisinflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisinflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockProvisionRecipients = append(m.BlockProvisionRecipients, BlockProvisionRecipient{})
+			if err := m.BlockProvisionRecipients[len(m.BlockProvisionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockProvisionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockProvisionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockProvisionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	recipient := sdk.AccAddress("recipient").String()
+	otherRecipient := sdk.AccAddress("other_recipient").String()
+
+	withRecipients := func(recipients ...BlockProvisionRecipient) Params {
+		params := DefaultParams()
+		params.BlockProvisionRecipients = recipients
+		return params
+	}
+	tooManyRecipients := make([]BlockProvisionRecipient, MaxBlockProvisionRecipients+1)
+	for i := range tooManyRecipients {
+		tooManyRecipients[i] = BlockProvisionRecipient{
+			Address:  sdk.AccAddress([]byte{byte(i)}).String(),
+			Fraction: math.LegacyNewDecWithPrec(1, 2),
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{
+			name:   "default params",
+			params: DefaultParams(),
+		},
+		{
+			name:   "constant inflation",
+			params: NewParams(math.LegacyNewDecWithPrec(2, 2), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(2, 2), nil),
+		},
+		{
+			name:    "unset initial inflation rate",
+			params:  NewParams(math.LegacyDec{}, DisinflationRateAsDec(), TargetInflationRateAsDec(), nil),
+			wantErr: true,
+		},
+		{
+			name:    "negative disinflation rate",
+			params:  NewParams(InitialInflationRateAsDec(), math.LegacyNewDecWithPrec(-1, 2), TargetInflationRateAsDec(), nil),
+			wantErr: true,
+		},
+		{
+			name:    "initial inflation rate greater than one",
+			params:  NewParams(math.LegacyNewDecWithPrec(11, 1), DisinflationRateAsDec(), TargetInflationRateAsDec(), nil),
+			wantErr: true,
+		},
+		{
+			name:    "target inflation rate greater than initial inflation rate",
+			params:  NewParams(TargetInflationRateAsDec(), DisinflationRateAsDec(), InitialInflationRateAsDec(), nil),
+			wantErr: true,
+		},
+		{
+			name: "recipients",
+			params: withRecipients(
+				BlockProvisionRecipient{Address: recipient, Fraction: math.LegacyNewDecWithPrec(5, 1)},
+				BlockProvisionRecipient{Address: otherRecipient, Fraction: math.LegacyNewDecWithPrec(5, 1)},
+			),
+		},
+		{
+			name:    "recipient with invalid address",
+			params:  withRecipients(BlockProvisionRecipient{Address: "invalid", Fraction: math.LegacyNewDecWithPrec(1, 1)}),
+			wantErr: true,
+		},
+		{
+			name: "duplicate recipient",
+			params: withRecipients(
+				BlockProvisionRecipient{Address: recipient, Fraction: math.LegacyNewDecWithPrec(1, 1)},
+				BlockProvisionRecipient{Address: recipient, Fraction: math.LegacyNewDecWithPrec(1, 1)},
+			),
+			wantErr: true,
+		},
+		{
+			name:    "recipient with zero fraction",
+			params:  withRecipients(BlockProvisionRecipient{Address: recipient, Fraction: math.LegacyZeroDec()}),
+			wantErr: true,
+		},
+		{
+			name: "fractions greater than one",
+			params: withRecipients(
+				BlockProvisionRecipient{Address: recipient, Fraction: math.LegacyNewDecWithPrec(6, 1)},
+				BlockProvisionRecipient{Address: otherRecipient, Fraction: math.LegacyNewDecWithPrec(5, 1)},
+			),
+			wantErr: true,
+		},
+		{
+			name:    "too many recipients",
+			params:  withRecipients(tooManyRecipients...),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
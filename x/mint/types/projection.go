@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxProjectionYears is the maximum number of years that a supply projection
+// spans.
+const MaxProjectionYears = 100
+
+// ProjectSupply projects the minting between start and end for a total supply
+// of supply at start. It returns the projection of every year since genesis
+// between start and end.
+//
+// The minter is updated at the start of every year the same way the
+// BeginBlocker updates it so the projection uses the inflation rate of
+// CalculateInflationRate and the block provisions of CalculateBlockProvision.
+// The projection assumes that the supply only changes due to minting. It mints
+// a whole year at once so it can exceed the minted supply of the network by
+// the rounding of the block provisions, i.e. less than one token per block.
+// It also splits the minting at every genesis anniversary whereas the network
+// mints the whole interval of the first block of a year at the new rate.
+func (m Minter) ProjectSupply(ctx sdk.Context, genesisTime, start, end time.Time, supply math.Int, params Params) ([]ProjectedYear, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end time %v must be after start time %v", end, start)
+	}
+	startYear := yearsSinceGenesis(genesisTime, start)
+	endYear := yearsSinceGenesis(genesisTime, end)
+	if endYear-startYear > MaxProjectionYears {
+		return nil, fmt.Errorf("projection of %d years exceeds the maximum of %d years", endYear-startYear, MaxProjectionYears)
+	}
+
+	schedule := make([]ProjectedYear, 0, endYear-startYear+1)
+	periodStart := start
+	for year := startYear; periodStart.Before(end); year++ {
+		periodEnd := genesisTime.Add(time.Duration(year+1) * time.Duration(NanosecondsPerYear))
+		if periodEnd.After(end) {
+			periodEnd = end
+		}
+
+		// update the inflation rate and annual provisions like maybeUpdateMinter.
+		inflationRate := m.CalculateInflationRate(ctx.WithBlockTime(periodStart), genesisTime, params)
+		if !inflationRate.Equal(m.InflationRate) || m.AnnualProvisions.IsZero() {
+			m.InflationRate = inflationRate
+			m.AnnualProvisions = inflationRate.MulInt(supply)
+		}
+
+		provision, err := m.CalculateBlockProvision(periodEnd, periodStart)
+		if err != nil {
+			return nil, err
+		}
+		supply = supply.Add(provision.Amount)
+
+		schedule = append(schedule, ProjectedYear{
+			Year:             year,
+			StartTime:        periodStart,
+			EndTime:          periodEnd,
+			InflationRate:    m.InflationRate,
+			AnnualProvisions: m.AnnualProvisions,
+			MintedSupply:     provision.Amount,
+			TotalSupply:      supply,
+		})
+		periodStart = periodEnd
+	}
+	return schedule, nil
+}
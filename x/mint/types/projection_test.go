@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectSupply(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	genesisTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	oneYear := time.Duration(NanosecondsPerYear)
+	supply := math.NewInt(1_000_000_000_000) // 1 trillion utia
+	params := DefaultParams()
+
+	minter := DefaultMinter()
+	minter.AnnualProvisions = minter.InflationRate.MulInt(supply)
+
+	t.Run("within a year", func(t *testing.T) {
+		start := genesisTime.Add(time.Hour)
+		end := start.Add(24 * time.Hour)
+		schedule, err := minter.ProjectSupply(ctx, genesisTime, start, end, supply, params)
+		require.NoError(t, err)
+		require.Len(t, schedule, 1)
+
+		want, err := minter.CalculateBlockProvision(end, start)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), schedule[0].Year)
+		require.Equal(t, InitialInflationRateAsDec(), schedule[0].InflationRate)
+		require.Equal(t, want.Amount, schedule[0].MintedSupply)
+		require.Equal(t, supply.Add(want.Amount), schedule[0].TotalSupply)
+	})
+
+	t.Run("across years", func(t *testing.T) {
+		start := genesisTime.Add(oneYear / 2)
+		end := genesisTime.Add(2*oneYear + oneYear/2)
+		schedule, err := minter.ProjectSupply(ctx, genesisTime, start, end, supply, params)
+		require.NoError(t, err)
+		require.Len(t, schedule, 3)
+
+		for i, year := range schedule {
+			require.Equal(t, int64(i), year.Year)
+			wantRate := minter.CalculateInflationRate(ctx.WithBlockTime(year.StartTime), genesisTime, params)
+			require.Equal(t, wantRate, year.InflationRate)
+			if i > 0 {
+				previous := schedule[i-1]
+				require.Equal(t, previous.EndTime, year.StartTime)
+				require.Equal(t, wantRate.MulInt(previous.TotalSupply), year.AnnualProvisions)
+				require.Equal(t, previous.TotalSupply.Add(year.MintedSupply), year.TotalSupply)
+			}
+		}
+		require.Equal(t, genesisTime.Add(oneYear), schedule[1].StartTime)
+		require.Equal(t, end, schedule[2].EndTime)
+	})
+
+	t.Run("updated params", func(t *testing.T) {
+		constant := NewParams(math.LegacyNewDecWithPrec(2, 2), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(2, 2), nil)
+		start := genesisTime.Add(time.Hour)
+		schedule, err := minter.ProjectSupply(ctx, genesisTime, start, start.Add(24*time.Hour), supply, constant)
+		require.NoError(t, err)
+		require.Equal(t, math.LegacyNewDecWithPrec(2, 2), schedule[0].InflationRate)
+		require.Equal(t, math.LegacyNewDecWithPrec(2, 2).MulInt(supply), schedule[0].AnnualProvisions)
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		start := genesisTime.Add(time.Hour)
+		_, err := minter.ProjectSupply(ctx, genesisTime, start, start, supply, params)
+		require.Error(t, err)
+	})
+
+	t.Run("too many years", func(t *testing.T) {
+		end := genesisTime.Add((MaxProjectionYears + 1) * oneYear)
+		_, err := minter.ProjectSupply(ctx, genesisTime, genesisTime, end, supply, params)
+		require.Error(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -34,6 +35,88 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryInflationRateRequest is the request type for the Query/InflationRate RPC
 // method.
 type QueryInflationRateRequest struct {
@@ -43,7 +126,7 @@ func (m *QueryInflationRateRequest) Reset()         { *m = QueryInflationRateReq
 func (m *QueryInflationRateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInflationRateRequest) ProtoMessage()    {}
 func (*QueryInflationRateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1ed5b0ae449a133, []int{0}
+	return fileDescriptor_a1ed5b0ae449a133, []int{2}
 }
 func (m *QueryInflationRateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,7 +166,7 @@ func (m *QueryInflationRateResponse) Reset()         { *m = QueryInflationRateRe
 func (m *QueryInflationRateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInflationRateResponse) ProtoMessage()    {}
 func (*QueryInflationRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1ed5b0ae449a133, []int{1}
+	return fileDescriptor_a1ed5b0ae449a133, []int{3}
 }
 func (m *QueryInflationRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -121,7 +204,7 @@ func (m *QueryAnnualProvisionsRequest) Reset()         { *m = QueryAnnualProvisi
 func (m *QueryAnnualProvisionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAnnualProvisionsRequest) ProtoMessage()    {}
 func (*QueryAnnualProvisionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1ed5b0ae449a133, []int{2}
+	return fileDescriptor_a1ed5b0ae449a133, []int{4}
 }
 func (m *QueryAnnualProvisionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +244,7 @@ func (m *QueryAnnualProvisionsResponse) Reset()         { *m = QueryAnnualProvis
 func (m *QueryAnnualProvisionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAnnualProvisionsResponse) ProtoMessage()    {}
 func (*QueryAnnualProvisionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1ed5b0ae449a133, []int{3}
+	return fileDescriptor_a1ed5b0ae449a133, []int{5}
 }
 func (m *QueryAnnualProvisionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,7 +282,7 @@ func (m *QueryGenesisTimeRequest) Reset()         { *m = QueryGenesisTimeRequest
 func (m *QueryGenesisTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGenesisTimeRequest) ProtoMessage()    {}
 func (*QueryGenesisTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1ed5b0ae449a133, []int{4}
+	return fileDescriptor_a1ed5b0ae449a133, []int{6}
 }
 func (m *QueryGenesisTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +322,7 @@ func (m *QueryGenesisTimeResponse) Reset()         { *m = QueryGenesisTimeRespon
 func (m *QueryGenesisTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGenesisTimeResponse) ProtoMessage()    {}
 func (*QueryGenesisTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1ed5b0ae449a133, []int{5}
+	return fileDescriptor_a1ed5b0ae449a133, []int{7}
 }
 func (m *QueryGenesisTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,50 +358,285 @@ func (m *QueryGenesisTimeResponse) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// QuerySupplyProjectionRequest is the request type for the
+// Query/SupplyProjection RPC method. Exactly one of time and height must be
+// set.
+type QuerySupplyProjectionRequest struct {
+	// Time is the future time to project the supply at.
+	Time *time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// Height is the future height to project the supply at. Its time is
+	// estimated from the average block time since genesis.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuerySupplyProjectionRequest) Reset()         { *m = QuerySupplyProjectionRequest{} }
+func (m *QuerySupplyProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionRequest) ProtoMessage()    {}
+func (*QuerySupplyProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{8}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionRequest.Merge(m, src)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionRequest) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *QuerySupplyProjectionRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QuerySupplyProjectionResponse is the response type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionResponse struct {
+	// Time is the time that the supply is projected at.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Height is the requested height or the height estimated for the requested
+	// time.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// InflationRate is the projected inflation rate at time.
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+	// AnnualProvisions is the projected annual provisions at time.
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// MintedSupply is the supply that is projected to be minted from the latest
+	// block until time.
+	MintedSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=minted_supply,json=mintedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"minted_supply"`
+	// TotalSupply is the projected total supply of the bond denom at time.
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	// Schedule is the projection of every year from the latest block until
+	// time.
+	Schedule []ProjectedYear `protobuf:"bytes,7,rep,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QuerySupplyProjectionResponse) Reset()         { *m = QuerySupplyProjectionResponse{} }
+func (m *QuerySupplyProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionResponse) ProtoMessage()    {}
+func (*QuerySupplyProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{9}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionResponse.Merge(m, src)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *QuerySupplyProjectionResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuerySupplyProjectionResponse) GetSchedule() []ProjectedYear {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// ProjectedYear is the projection of a year since genesis. The first and the
+// last year of a projection are partial years.
+type ProjectedYear struct {
+	// Year is the number of years elapsed since genesis.
+	Year int64 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// StartTime is the start of the projected part of the year.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// EndTime is the end of the projected part of the year.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// InflationRate is the inflation rate of the year.
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+	// AnnualProvisions is the annual provisions of the year.
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// MintedSupply is the supply minted between StartTime and EndTime.
+	MintedSupply cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=minted_supply,json=mintedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"minted_supply"`
+	// TotalSupply is the total supply at EndTime.
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+}
+
+func (m *ProjectedYear) Reset()         { *m = ProjectedYear{} }
+func (m *ProjectedYear) String() string { return proto.CompactTextString(m) }
+func (*ProjectedYear) ProtoMessage()    {}
+func (*ProjectedYear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{10}
+}
+func (m *ProjectedYear) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedYear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedYear.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedYear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedYear.Merge(m, src)
+}
+func (m *ProjectedYear) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedYear) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedYear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedYear proto.InternalMessageInfo
+
+func (m *ProjectedYear) GetYear() int64 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *ProjectedYear) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ProjectedYear) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.mint.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.mint.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "celestia.mint.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "celestia.mint.v1.QueryInflationRateResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "celestia.mint.v1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "celestia.mint.v1.QueryAnnualProvisionsResponse")
 	proto.RegisterType((*QueryGenesisTimeRequest)(nil), "celestia.mint.v1.QueryGenesisTimeRequest")
 	proto.RegisterType((*QueryGenesisTimeResponse)(nil), "celestia.mint.v1.QueryGenesisTimeResponse")
+	proto.RegisterType((*QuerySupplyProjectionRequest)(nil), "celestia.mint.v1.QuerySupplyProjectionRequest")
+	proto.RegisterType((*QuerySupplyProjectionResponse)(nil), "celestia.mint.v1.QuerySupplyProjectionResponse")
+	proto.RegisterType((*ProjectedYear)(nil), "celestia.mint.v1.ProjectedYear")
 }
 
 func init() { proto.RegisterFile("celestia/mint/v1/query.proto", fileDescriptor_a1ed5b0ae449a133) }
 
 var fileDescriptor_a1ed5b0ae449a133 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x33, 0xfc, 0x2d, 0x26, 0x2d, 0x0a, 0x23, 0x24, 0x5a, 0x37, 0x38, 0xe0, 0x08, 0xd4,
-	0x52, 0x98, 0x21, 0xe5, 0x09, 0x08, 0x48, 0x88, 0x8a, 0x45, 0x89, 0xba, 0x62, 0x13, 0x4d, 0xcc,
-	0x74, 0x32, 0xc2, 0x9e, 0x71, 0x3c, 0xe3, 0x88, 0x6c, 0x79, 0x01, 0x2a, 0xb1, 0xe0, 0x01, 0x78,
-	0x99, 0x2e, 0x2b, 0xb1, 0x01, 0x16, 0x05, 0x25, 0x3c, 0x08, 0xf2, 0xd8, 0x0e, 0xf9, 0xb3, 0x94,
-	0xee, 0xae, 0x7d, 0xae, 0xef, 0xf9, 0x7c, 0xcf, 0x85, 0x75, 0x9f, 0x05, 0x4c, 0x1b, 0x41, 0x49,
-	0x28, 0xa4, 0x21, 0xc3, 0x16, 0x19, 0x24, 0x2c, 0x1e, 0xe1, 0x28, 0x56, 0x46, 0xa1, 0x5a, 0xa1,
-	0xe2, 0x54, 0xc5, 0xc3, 0x96, 0x73, 0x9b, 0x2b, 0xae, 0xac, 0x48, 0xd2, 0x2a, 0xeb, 0x73, 0xea,
-	0x5c, 0x29, 0x1e, 0x30, 0x42, 0x23, 0x41, 0xa8, 0x94, 0xca, 0x50, 0x23, 0x94, 0xd4, 0xb9, 0xda,
-	0xc8, 0x55, 0xfb, 0xd4, 0x4b, 0x4e, 0x88, 0x11, 0x21, 0xd3, 0x86, 0x86, 0x51, 0xd6, 0xe0, 0xed,
-	0xc0, 0xed, 0xb7, 0xa9, 0xeb, 0x6b, 0x79, 0x12, 0xd8, 0x2f, 0x3b, 0xd4, 0xb0, 0x0e, 0x1b, 0x24,
-	0x4c, 0x1b, 0xaf, 0x0f, 0x9d, 0x55, 0xa2, 0x8e, 0x94, 0xd4, 0x0c, 0x1d, 0xc2, 0x9b, 0xa2, 0x10,
-	0xba, 0x31, 0x35, 0x6c, 0x0b, 0xdc, 0x03, 0xbb, 0x1b, 0xed, 0xe6, 0xd9, 0x45, 0xa3, 0xf2, 0xeb,
-	0xa2, 0xb1, 0xe3, 0x2b, 0x1d, 0x2a, 0xad, 0xdf, 0x7f, 0xc0, 0x42, 0x91, 0x90, 0x9a, 0x3e, 0x7e,
-	0xc3, 0x38, 0xf5, 0x47, 0x2f, 0x99, 0xdf, 0xd9, 0x14, 0xb3, 0x33, 0x3d, 0x17, 0xd6, 0xad, 0xd3,
-	0x73, 0x29, 0x13, 0x1a, 0x1c, 0xc5, 0x6a, 0x28, 0x74, 0xfa, 0x1b, 0x05, 0xc9, 0x00, 0xde, 0x2d,
-	0xd1, 0x73, 0x98, 0x23, 0x78, 0x8b, 0x5a, 0xad, 0x1b, 0x4d, 0xc5, 0xcb, 0xf0, 0xd4, 0xe8, 0xc2,
-	0x64, 0x6f, 0x1b, 0xde, 0xb1, 0x96, 0xaf, 0x98, 0x64, 0x5a, 0xe8, 0x63, 0x11, 0x4e, 0xf7, 0xd2,
-	0x85, 0x5b, 0xcb, 0x52, 0x0e, 0xf2, 0x02, 0x6e, 0xf0, 0xec, 0x75, 0x37, 0xdd, 0xb5, 0x65, 0xa8,
-	0x1e, 0x38, 0x38, 0x0b, 0x02, 0x17, 0x41, 0xe0, 0xe3, 0x22, 0x88, 0xf6, 0xb5, 0xd3, 0xdf, 0x0d,
-	0xd0, 0xa9, 0xf2, 0xff, 0xc3, 0x0e, 0x7e, 0x5e, 0x85, 0xd7, 0xad, 0x03, 0xfa, 0x0a, 0xe0, 0xe6,
-	0xdc, 0xfa, 0xd1, 0x3e, 0x5e, 0xbc, 0x0c, 0x5c, 0x9a, 0xa0, 0xf3, 0x78, 0xbd, 0xe6, 0x8c, 0xdd,
-	0xdb, 0xff, 0xf4, 0xfd, 0xef, 0x97, 0x2b, 0x0f, 0x50, 0x93, 0x64, 0xab, 0x2a, 0x0e, 0xb3, 0xc7,
-	0x0c, 0x6d, 0x91, 0xf9, 0xb0, 0xd1, 0x37, 0x00, 0x6b, 0x8b, 0x71, 0x20, 0x5c, 0xe2, 0x57, 0x92,
-	0xab, 0x43, 0xd6, 0xee, 0xcf, 0x11, 0xb1, 0x45, 0xdc, 0x45, 0x0f, 0x57, 0x22, 0x2e, 0x9d, 0x00,
-	0xfa, 0x0c, 0x60, 0x75, 0x26, 0x26, 0xb4, 0x57, 0x62, 0xb8, 0x9c, 0xb2, 0xf3, 0x68, 0x9d, 0xd6,
-	0x1c, 0x6b, 0xcf, 0x62, 0x35, 0xd1, 0xfd, 0x95, 0x58, 0xb3, 0x07, 0xd1, 0x3e, 0x3c, 0x1b, 0xbb,
-	0xe0, 0x7c, 0xec, 0x82, 0x3f, 0x63, 0x17, 0x9c, 0x4e, 0xdc, 0xca, 0xf9, 0xc4, 0xad, 0xfc, 0x98,
-	0xb8, 0x95, 0x77, 0x4f, 0xb9, 0x30, 0xfd, 0xa4, 0x87, 0x7d, 0x15, 0x92, 0xc2, 0x5a, 0xc5, 0x7c,
-	0x5a, 0x3f, 0xa1, 0x51, 0x44, 0x3e, 0x66, 0xa3, 0xcd, 0x28, 0x62, 0xba, 0x77, 0xc3, 0x9e, 0xd3,
-	0xb3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x1e, 0xd5, 0xdc, 0x4b, 0x04, 0x00, 0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0x1b, 0x27, 0x6d, 0x27, 0x09, 0x0a, 0x43, 0x81, 0xd4, 0x6d, 0x93, 0xe0, 0x52, 0x94,
+	0x52, 0x6a, 0x93, 0x82, 0x10, 0x37, 0xd4, 0x14, 0x09, 0xb5, 0x02, 0x14, 0x4c, 0x0f, 0xc0, 0x81,
+	0x68, 0x92, 0x4c, 0x1d, 0x83, 0xed, 0x71, 0xed, 0x49, 0x21, 0x57, 0x24, 0xce, 0x54, 0xe2, 0xc0,
+	0x15, 0x69, 0xff, 0xc2, 0xfe, 0x88, 0x1e, 0xab, 0xdd, 0xcb, 0x6a, 0x0f, 0xdd, 0x55, 0xbb, 0x7f,
+	0x61, 0xef, 0x2b, 0xcf, 0x8c, 0xb3, 0x49, 0x1c, 0xab, 0xc9, 0xee, 0xf6, 0x66, 0xfb, 0x7d, 0xf3,
+	0xbe, 0xe7, 0x6f, 0xde, 0xcc, 0x03, 0xeb, 0x1d, 0x6c, 0xe3, 0x80, 0x5a, 0x48, 0x77, 0x2c, 0x97,
+	0xea, 0x67, 0x75, 0xfd, 0xb4, 0x8f, 0xfd, 0x81, 0xe6, 0xf9, 0x84, 0x12, 0x58, 0x8c, 0x50, 0x2d,
+	0x44, 0xb5, 0xb3, 0xba, 0xb2, 0x62, 0x12, 0x93, 0x30, 0x50, 0x0f, 0x9f, 0x78, 0x9d, 0xb2, 0xda,
+	0x21, 0x81, 0x43, 0x82, 0x16, 0x07, 0xf8, 0x8b, 0x80, 0xd6, 0x4d, 0x42, 0x4c, 0x1b, 0xeb, 0xc8,
+	0xb3, 0x74, 0xe4, 0xba, 0x84, 0x22, 0x6a, 0x11, 0x37, 0x42, 0x2b, 0x02, 0x65, 0x6f, 0xed, 0xfe,
+	0x89, 0x4e, 0x2d, 0x07, 0x07, 0x14, 0x39, 0x9e, 0x28, 0xd8, 0x88, 0xe9, 0xf3, 0x90, 0x8f, 0x1c,
+	0xb1, 0x5e, 0x5d, 0x01, 0xf0, 0x87, 0x50, 0x6f, 0x93, 0x7d, 0x34, 0xf0, 0x69, 0x1f, 0x07, 0x54,
+	0xfd, 0x0e, 0xbc, 0x33, 0xf6, 0x35, 0xf0, 0x88, 0x1b, 0x60, 0xf8, 0x05, 0xc8, 0xf2, 0xc5, 0x25,
+	0xa9, 0x2a, 0xd5, 0x72, 0x7b, 0x25, 0x6d, 0xf2, 0xf7, 0x34, 0xbe, 0xa2, 0x21, 0x5f, 0x5c, 0x55,
+	0x52, 0x86, 0xa8, 0x56, 0xd7, 0xc0, 0x2a, 0xa3, 0x3b, 0x74, 0x4f, 0x6c, 0xa6, 0xde, 0x40, 0x14,
+	0x47, 0xbd, 0x7a, 0x40, 0x99, 0x06, 0x8a, 0x96, 0x47, 0xe0, 0x2d, 0x2b, 0x02, 0x5a, 0x3e, 0xa2,
+	0x98, 0xb5, 0xce, 0x37, 0x36, 0xc3, 0x06, 0x8f, 0xaf, 0x2a, 0x6b, 0x7c, 0x56, 0x41, 0xf7, 0x77,
+	0xcd, 0x22, 0xba, 0x83, 0x68, 0x4f, 0xfb, 0x16, 0x9b, 0xa8, 0x33, 0xf8, 0x1a, 0x77, 0x8c, 0x82,
+	0x35, 0xca, 0xa9, 0x96, 0xc1, 0x3a, 0xeb, 0xb4, 0xef, 0xba, 0x7d, 0x64, 0x37, 0x7d, 0x72, 0x66,
+	0x05, 0xe1, 0x28, 0x23, 0x25, 0xa7, 0x60, 0x23, 0x01, 0x17, 0x62, 0x9a, 0xe0, 0x6d, 0xc4, 0xb0,
+	0x70, 0x9f, 0x04, 0x38, 0x8f, 0x9e, 0x22, 0x9a, 0x60, 0x56, 0x57, 0xc1, 0xfb, 0xac, 0xe5, 0x37,
+	0xd8, 0xc5, 0x81, 0x15, 0x1c, 0x5b, 0xce, 0x70, 0x2e, 0x2d, 0x50, 0x8a, 0x43, 0x42, 0xc8, 0x01,
+	0xc8, 0x9b, 0xfc, 0x73, 0x2b, 0xdc, 0x6f, 0xb1, 0x1d, 0x8a, 0xc6, 0xcd, 0xa0, 0x45, 0x66, 0xd0,
+	0x8e, 0x23, 0x33, 0x34, 0xe4, 0xf3, 0x27, 0x15, 0xc9, 0xc8, 0x99, 0x2f, 0xc9, 0x54, 0x5b, 0x8c,
+	0xe3, 0xc7, 0xbe, 0xe7, 0xd9, 0x83, 0xa6, 0x4f, 0x7e, 0xc3, 0x1d, 0x36, 0x2b, 0x2e, 0x00, 0x7e,
+	0x0e, 0xe4, 0xb9, 0xc8, 0x59, 0x35, 0x7c, 0x0f, 0x64, 0x7b, 0xd8, 0x32, 0x7b, 0xb4, 0xb4, 0x50,
+	0x95, 0x6a, 0x69, 0x43, 0xbc, 0xa9, 0xe7, 0xb2, 0x98, 0x6e, 0xbc, 0x9d, 0xf8, 0xa9, 0x2f, 0x67,
+	0xee, 0xb7, 0x14, 0x0e, 0xfb, 0xf6, 0x9e, 0xf0, 0xa7, 0x98, 0x79, 0xd2, 0x55, 0xa9, 0xb6, 0xdc,
+	0xa8, 0xcf, 0xb0, 0x59, 0x0f, 0xee, 0xef, 0x02, 0x71, 0x0e, 0xe3, 0x56, 0x82, 0xbf, 0x4e, 0x73,
+	0x82, 0xfc, 0xaa, 0xe4, 0x31, 0x5f, 0xc0, 0x26, 0x28, 0x84, 0x27, 0x0a, 0x77, 0x5b, 0x01, 0x1b,
+	0x57, 0x29, 0xc3, 0xb8, 0x77, 0x04, 0xf7, 0xbb, 0x71, 0xee, 0x43, 0x97, 0x8e, 0xb0, 0x1e, 0xba,
+	0xd4, 0xc8, 0x73, 0x06, 0x3e, 0x6f, 0xf8, 0x3d, 0xc8, 0x53, 0x42, 0x91, 0x1d, 0x11, 0x66, 0xe7,
+	0x27, 0xcc, 0x31, 0x02, 0xc1, 0xb7, 0x0f, 0x96, 0x82, 0x4e, 0x0f, 0x77, 0xfb, 0x36, 0x2e, 0x2d,
+	0x56, 0xd3, 0xb5, 0xdc, 0x5e, 0x65, 0xca, 0x6d, 0xc0, 0x77, 0x19, 0x77, 0x7f, 0xc6, 0xc8, 0x17,
+	0x97, 0xc2, 0x70, 0x99, 0xfa, 0xb7, 0x0c, 0x0a, 0x63, 0x15, 0x10, 0x02, 0x79, 0x80, 0x91, 0xcf,
+	0x2c, 0x90, 0x36, 0xd8, 0x33, 0x3c, 0x00, 0x20, 0xa0, 0xc8, 0xa7, 0xdc, 0xe9, 0x0b, 0x73, 0x98,
+	0x63, 0x99, 0xad, 0x0b, 0x11, 0xf8, 0x15, 0x58, 0xc2, 0x6e, 0x97, 0x53, 0xa4, 0xe7, 0xa0, 0x58,
+	0xc4, 0x6e, 0x97, 0x11, 0xc4, 0xad, 0x24, 0xdf, 0xa5, 0x95, 0x32, 0x77, 0x68, 0xa5, 0xec, 0x9b,
+	0xb6, 0xd2, 0xe2, 0xeb, 0x59, 0x69, 0xef, 0x79, 0x06, 0x64, 0xd8, 0xd5, 0x00, 0xff, 0x00, 0x59,
+	0x1e, 0x20, 0xf0, 0xc3, 0xb8, 0x99, 0xe2, 0x39, 0xa5, 0x6c, 0xdd, 0x52, 0xc5, 0x6f, 0x16, 0xb5,
+	0xfa, 0xd7, 0xc3, 0x67, 0xff, 0x2e, 0x28, 0xb0, 0xa4, 0x27, 0x84, 0x21, 0xfc, 0x4f, 0x02, 0x85,
+	0xb1, 0x00, 0x82, 0x3b, 0x09, 0xd4, 0xd3, 0x32, 0x4c, 0xf9, 0x64, 0xb6, 0x62, 0x21, 0x67, 0x87,
+	0xc9, 0xd9, 0x82, 0x9b, 0x22, 0xe8, 0x23, 0x31, 0x6d, 0x4c, 0x51, 0x5d, 0x1f, 0xb7, 0x19, 0xbc,
+	0x27, 0x81, 0xe2, 0x64, 0x20, 0x41, 0x2d, 0xa1, 0x5f, 0x42, 0xb2, 0x29, 0xfa, 0xcc, 0xf5, 0x42,
+	0xa2, 0xc6, 0x24, 0xd6, 0xe0, 0x47, 0x53, 0x25, 0xc6, 0xfc, 0x0a, 0xff, 0x91, 0x40, 0x6e, 0x24,
+	0xa8, 0xe0, 0x76, 0x42, 0xc3, 0x78, 0xce, 0x29, 0x1f, 0xcf, 0x52, 0x2a, 0x64, 0x6d, 0x33, 0x59,
+	0x9b, 0xf0, 0x83, 0xa9, 0xb2, 0x46, 0x23, 0x11, 0xfe, 0x2f, 0x81, 0xe2, 0x64, 0xd4, 0x24, 0xce,
+	0x2d, 0x21, 0x02, 0x13, 0xe7, 0x96, 0x94, 0x61, 0xa3, 0x5b, 0x3b, 0xe9, 0x34, 0x7e, 0x58, 0xc2,
+	0xa1, 0x89, 0x45, 0x8d, 0xa3, 0x8b, 0xeb, 0xb2, 0x74, 0x79, 0x5d, 0x96, 0x9e, 0x5e, 0x97, 0xa5,
+	0xf3, 0x9b, 0x72, 0xea, 0xf2, 0xa6, 0x9c, 0x7a, 0x74, 0x53, 0x4e, 0xfd, 0xf2, 0xa9, 0x69, 0xd1,
+	0x5e, 0xbf, 0xad, 0x75, 0x88, 0x33, 0x24, 0x22, 0xbe, 0x39, 0x7c, 0xde, 0x45, 0x9e, 0xa7, 0xff,
+	0xc9, 0xa9, 0xe9, 0xc0, 0xc3, 0x41, 0x3b, 0xcb, 0xae, 0xb1, 0xcf, 0x5e, 0x04, 0x00, 0x00, 0xff,
+	0xff, 0x2a, 0x36, 0xaa, 0x6c, 0x8f, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,12 +651,17 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params returns the params of the mint module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// InflationRate returns the current inflation rate.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
 	// AnnualProvisions returns the current annual provisions.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(ctx context.Context, in *QueryGenesisTimeRequest, opts ...grpc.CallOption) (*QueryGenesisTimeResponse, error)
+	// SupplyProjection projects the inflation rate, the annual provisions and
+	// the minted supply at a future time or height.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -349,6 +672,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error) {
 	out := new(QueryInflationRateResponse)
 	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/InflationRate", in, out, opts...)
@@ -376,20 +708,37 @@ func (c *queryClient) GenesisTime(ctx context.Context, in *QueryGenesisTimeReque
 	return out, nil
 }
 
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/SupplyProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params returns the params of the mint module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// InflationRate returns the current inflation rate.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
 	// AnnualProvisions returns the current annual provisions.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(context.Context, *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error)
+	// SupplyProjection projects the inflation rate, the annual provisions and
+	// the minted supply at a future time or height.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
@@ -399,11 +748,32 @@ func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) GenesisTime(ctx context.Context, req *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenesisTime not implemented")
 }
+func (*UnimplementedQueryServer) SupplyProjection(ctx context.Context, req *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryInflationRateRequest)
 	if err := dec(in); err != nil {
@@ -458,11 +828,33 @@ func _Query_GenesisTime_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/SupplyProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.mint.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
@@ -475,11 +867,71 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenesisTime",
 			Handler:    _Query_GenesisTime_Handler,
 		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/mint/v1/query.proto",
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryInflationRateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -636,30 +1088,262 @@ func (m *QueryGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	var l int
 	_ = l
 	if m.GenesisTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.GenesisTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GenesisTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.GenesisTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GenesisTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySupplyProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryInflationRateRequest) Size() (n int) {
+
+func (m *QuerySupplyProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Time != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MintedSupply.Size()
+		i -= size
+		if _, err := m.MintedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedYear) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedYear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedYear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MintedSupply.Size()
+		i -= size
+		if _, err := m.MintedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -721,13 +1405,81 @@ func (m *QueryGenesisTimeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySupplyProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QuerySupplyProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectedYear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovQuery(uint64(m.Year))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -750,10 +1502,10 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -777,7 +1529,7 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -800,17 +1552,17 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -820,22 +1572,22 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -860,7 +1612,7 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -883,10 +1635,10 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -910,7 +1662,7 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -933,15 +1685,15 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -968,7 +1720,7 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -993,7 +1745,7 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGenesisTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1016,10 +1768,10 @@ func (m *QueryGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGenesisTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGenesisTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1043,7 +1795,7 @@ func (m *QueryGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1066,10 +1818,143 @@ func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGenesisTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGenesisTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGenesisTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGenesisTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGenesisTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGenesisTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGenesisTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1129,6 +2014,654 @@ func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySupplyProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, ProjectedYear{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedYear) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedYear: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedYear: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
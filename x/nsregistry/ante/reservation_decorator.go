@@ -0,0 +1,40 @@
+package ante
+
+import (
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReservationKeeper checks the signers of blobs in reserved namespaces.
+type ReservationKeeper interface {
+	CheckSigner(ctx sdk.Context, namespace []byte, signer string) error
+}
+
+// ReservationDecorator rejects txs containing a MsgPayForBlobs that pays for a
+// blob in a reserved namespace if its signer is neither allowed by the owner
+// of the namespace nor grandfathered.
+type ReservationDecorator struct {
+	k ReservationKeeper
+}
+
+func NewReservationDecorator(k ReservationKeeper) ReservationDecorator {
+	return ReservationDecorator{k}
+}
+
+// AnteHandle implements the AnteHandler interface. PayForBlobs can't be nested
+// in an authz MsgExec so only the top level msgs are checked.
+func (d ReservationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		pfb, ok := msg.(*blobtypes.MsgPayForBlobs)
+		if !ok {
+			continue
+		}
+		for _, namespace := range pfb.Namespaces {
+			if err := d.k.CheckSigner(ctx, namespace, pfb.Signer); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
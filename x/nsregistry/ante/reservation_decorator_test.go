@@ -0,0 +1,81 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/ante"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationDecorator(t *testing.T) {
+	owner := newAddress()
+	allowed := newAddress()
+	other := newAddress()
+	restricted := share.MustNewV0Namespace([]byte("restricted")).Bytes()
+	open := share.MustNewV0Namespace([]byte("open")).Bytes()
+	free := share.MustNewV0Namespace([]byte("free")).Bytes()
+	keeper := mockKeeper{
+		string(restricted): {Namespace: restricted, Owner: owner, AllowedSigners: []string{allowed}},
+		string(open):       {Namespace: open, Owner: owner},
+	}
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{"owner pays for a blob in the restricted namespace", pfb(owner, restricted), false},
+		{"allowed signer pays for a blob in the restricted namespace", pfb(allowed, restricted), false},
+		{"other signer pays for a blob in the restricted namespace", pfb(other, restricted), true},
+		{"other signer pays for blobs in a free and the restricted namespace", pfb(other, free, restricted), true},
+		{"other signer pays for a blob in the unrestricted namespace", pfb(other, open), false},
+		{"other signer pays for a blob in a free namespace", pfb(other, free), false},
+		{"other msg", &banktypes.MsgSend{FromAddress: other}, false},
+	}
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	decorator := ante.NewReservationDecorator(keeper)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := enc.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg))
+			_, err := decorator.AnteHandle(sdk.Context{}, builder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrUnauthorizedSigner)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func pfb(signer string, namespaces ...[]byte) *blobtypes.MsgPayForBlobs {
+	return &blobtypes.MsgPayForBlobs{Signer: signer, Namespaces: namespaces}
+}
+
+func newAddress() string {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+}
+
+// mockKeeper holds the active reservations by namespace whose grace period
+// has passed.
+type mockKeeper map[string]types.Reservation
+
+func (k mockKeeper) CheckSigner(_ sdk.Context, namespace []byte, signer string) error {
+	reservation, found := k[string(namespace)]
+	if found && reservation.IsRestricted(time.Now()) && !reservation.IsAllowedSigner(signer) {
+		return types.ErrUnauthorizedSigner
+	}
+	return nil
+}
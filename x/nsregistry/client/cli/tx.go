@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+// FlagAllowedSigners is the flag of the addresses allowed to pay for blobs in
+// a registered namespace.
+const FlagAllowedSigners = "allowed-signers"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdRegisterNamespace())
+	cmd.AddCommand(CmdRenewNamespace())
+	cmd.AddCommand(CmdUpdateAllowedSigners())
+
+	return cmd
+}
+
+func CmdRegisterNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [namespace] [duration]",
+		Short: "Reserve a namespace for a duration",
+		Long: `Reserve a namespace for a duration, e.g. 720h. The namespace is the hex
+encoded namespace of 29 bytes. The fee per day set by the module params is
+charged for the duration and burned. If allowed signers are set, only the
+owner and the allowed signers may pay for blobs in the namespace.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			allowedSigners, err := cmd.Flags().GetStringSlice(FlagAllowedSigners)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterNamespace(clientCtx.GetFromAddress().String(), namespace, duration, allowedSigners)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedSigners, nil, "Comma separated addresses, besides the owner, that may pay for blobs in the namespace")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRenewNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew [namespace] [duration]",
+		Short: "Extend the reservation of a namespace by a duration",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenewNamespace(clientCtx.GetFromAddress().String(), namespace, duration)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateAllowedSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-allowed-signers [namespace] [signer...]",
+		Short: "Replace the signers allowed to pay for blobs in a reserved namespace",
+		Long: `Replace the signers, besides the owner, allowed to pay for blobs in a reserved
+namespace. Without signers, anyone may pay for blobs in the namespace.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAllowedSigners(clientCtx.GetFromAddress().String(), namespace, args[1:])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseNamespace parses a hex encoded namespace with an optional 0x prefix.
+func parseNamespace(arg string) ([]byte, error) {
+	namespace, err := hex.DecodeString(strings.TrimPrefix(arg, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex namespace: %w", err)
+	}
+	return namespace, types.ValidateNamespace(namespace)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the nsregistry module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, gs.Params)
+	for _, reservation := range gs.Reservations {
+		k.setReservation(ctx, reservation)
+	}
+	for _, signer := range gs.GrandfatheredSigners {
+		k.setGrandfathered(ctx, signer.Namespace, sdk.MustAccAddressFromBech32(signer.Signer))
+	}
+	return nil
+}
+
+// ExportGenesis returns the nsregistry module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:               k.GetParams(ctx),
+		Reservations:         k.GetAllReservations(ctx),
+		GrandfatheredSigners: k.GetAllGrandfatheredSigners(ctx),
+	}
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the params of the module.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Reservation returns the active reservation of a namespace.
+func (k Keeper) Reservation(goCtx context.Context, req *types.QueryReservationRequest) (*types.QueryReservationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateNamespace(req.Namespace); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reservation, found := k.GetActiveReservation(ctx, req.Namespace)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "namespace %x is not reserved", req.Namespace)
+	}
+	return &types.QueryReservationResponse{Reservation: reservation}, nil
+}
+
+// Reservations returns all reservations ordered by namespace.
+func (k Keeper) Reservations(goCtx context.Context, req *types.QueryReservationsRequest) (*types.QueryReservationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var reservations []types.Reservation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReservationKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var reservation types.Reservation
+		if err := k.cdc.Unmarshal(value, &reservation); err != nil {
+			return err
+		}
+		reservations = append(reservations, reservation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReservationsResponse{Reservations: reservations, Pagination: pageRes}, nil
+}
+
+// ReservationsByOwner returns the reservations of an owner ordered by
+// namespace.
+func (k Keeper) ReservationsByOwner(goCtx context.Context, req *types.QueryReservationsByOwnerRequest) (*types.QueryReservationsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var reservations []types.Reservation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OwnerIndexPrefix(owner))
+	pageRes, err := query.Paginate(store, req.Pagination, func(namespace, _ []byte) error {
+		if reservation, found := k.GetReservation(ctx, namespace); found {
+			reservations = append(reservations, reservation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReservationsByOwnerResponse{Reservations: reservations, Pagination: pageRes}, nil
+}
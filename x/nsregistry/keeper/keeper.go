@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Keeper handles the reservations of namespaces.
+type Keeper struct {
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+	authority  string
+}
+
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	authority string,
+) *Keeper {
+	return &Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/keeper"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReservationLifecycle(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false).WithBlockTime(now)
+	k := testApp.NsRegistryKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	alice := fundedAccount(t, testApp, ctx)
+	bob := fundedAccount(t, testApp, ctx)
+	carol := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	namespace := share.MustNewV0Namespace([]byte("rollup")).Bytes()
+
+	// registering burns the fee of the duration
+	supplyBefore := testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom)
+	_, err := msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(alice, namespace, 2*types.Day, []string{carol}))
+	require.NoError(t, err)
+	fee := types.DefaultFeePerDay.Amount.MulRaw(2)
+	require.Equal(t, supplyBefore.Amount.Sub(fee), testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom).Amount)
+
+	reservation, found := k.GetActiveReservation(ctx, namespace)
+	require.True(t, found)
+	require.Equal(t, alice, reservation.Owner)
+	require.Equal(t, now.Add(2*types.Day), reservation.ExpiresAt)
+	require.True(t, reservation.IsAllowedSigner(alice))
+	require.True(t, reservation.IsAllowedSigner(carol))
+	require.False(t, reservation.IsAllowedSigner(bob))
+
+	// the namespace can't be registered by anyone else, nor renewed or
+	// updated by anyone but its owner
+	_, err = msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(bob, namespace, types.Day, nil))
+	require.ErrorIs(t, err, types.ErrNamespaceReserved)
+	_, err = msgServer.RenewNamespace(ctx, types.NewMsgRenewNamespace(bob, namespace, types.Day))
+	require.ErrorIs(t, err, types.ErrNotOwner)
+	_, err = msgServer.UpdateAllowedSigners(ctx, types.NewMsgUpdateAllowedSigners(bob, namespace, nil))
+	require.ErrorIs(t, err, types.ErrNotOwner)
+
+	// renewals extend the reservation up to the max duration from now
+	_, err = msgServer.RenewNamespace(ctx, types.NewMsgRenewNamespace(alice, namespace, types.DefaultMaxDuration))
+	require.ErrorIs(t, err, types.ErrInvalidDuration)
+	_, err = msgServer.RenewNamespace(ctx, types.NewMsgRenewNamespace(alice, namespace, types.Day))
+	require.NoError(t, err)
+	reservation, _ = k.GetActiveReservation(ctx, namespace)
+	require.Equal(t, now.Add(3*types.Day), reservation.ExpiresAt)
+
+	_, err = msgServer.UpdateAllowedSigners(ctx, types.NewMsgUpdateAllowedSigners(alice, namespace, nil))
+	require.NoError(t, err)
+	reservation, _ = k.GetActiveReservation(ctx, namespace)
+	require.True(t, reservation.IsAllowedSigner(bob))
+
+	byOwner, err := k.ReservationsByOwner(ctx, &types.QueryReservationsByOwnerRequest{Owner: alice})
+	require.NoError(t, err)
+	require.Equal(t, []types.Reservation{reservation}, byOwner.Reservations)
+	queried, err := k.Reservation(ctx, &types.QueryReservationRequest{Namespace: namespace})
+	require.NoError(t, err)
+	require.Equal(t, reservation, queried.Reservation)
+
+	// the reservation expires at the end of the renewed duration
+	ctx = ctx.WithBlockTime(now.Add(3*types.Day - time.Second))
+	require.NoError(t, k.PruneExpiredReservations(ctx))
+	_, found = k.GetActiveReservation(ctx, namespace)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(now.Add(3 * types.Day))
+	_, found = k.GetActiveReservation(ctx, namespace)
+	require.False(t, found)
+	require.NoError(t, k.PruneExpiredReservations(ctx))
+	_, found = k.GetReservation(ctx, namespace)
+	require.False(t, found)
+	_, err = k.Reservation(ctx, &types.QueryReservationRequest{Namespace: namespace})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	byOwner, err = k.ReservationsByOwner(ctx, &types.QueryReservationsByOwnerRequest{Owner: alice})
+	require.NoError(t, err)
+	require.Empty(t, byOwner.Reservations)
+
+	// an expired namespace can be registered by anyone
+	_, err = msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(bob, namespace, types.Day, nil))
+	require.NoError(t, err)
+	all, err := k.Reservations(ctx, &types.QueryReservationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Reservations, 1)
+	require.Equal(t, bob, all.Reservations[0].Owner)
+}
+
+func TestRegisterNamespaceLimits(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false)
+	msgServer := keeper.NewMsgServerImpl(testApp.NsRegistryKeeper)
+	alice := fundedAccount(t, testApp, ctx)
+	namespace := share.MustNewV0Namespace([]byte("rollup")).Bytes()
+
+	_, err := msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(alice, namespace, time.Hour, nil))
+	require.ErrorIs(t, err, types.ErrInvalidDuration)
+	_, err = msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(alice, namespace, types.DefaultMaxDuration+time.Hour, nil))
+	require.ErrorIs(t, err, types.ErrInvalidDuration)
+
+	signers := make([]string, types.DefaultMaxAllowedSigners+1)
+	for i := range signers {
+		signers[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+	_, err = msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(alice, namespace, types.Day, signers))
+	require.ErrorIs(t, err, types.ErrInvalidAllowedSigner)
+
+	// the owner must be able to pay the fee
+	poor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	_, err = msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(poor, namespace, types.Day, nil))
+	require.Error(t, err)
+}
+
+func TestUpdateParams(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false)
+	msgServer := keeper.NewMsgServerImpl(testApp.NsRegistryKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params := types.DefaultParams()
+	params.FeePerDay = sdk.NewCoin(appconsts.BondDenom, math.NewInt(5))
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	resp, err := testApp.NsRegistryKeeper.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, resp.Params)
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), params))
+	require.Error(t, err)
+	params.MaxDuration = 0
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.Error(t, err)
+}
+
+func TestRevokeReservation(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false)
+	k := testApp.NsRegistryKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	squatter := fundedAccount(t, testApp, ctx)
+	rollup := fundedAccount(t, testApp, ctx)
+	namespace := share.MustNewV0Namespace([]byte("rollup")).Bytes()
+	_, err := msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(squatter, namespace, types.DefaultMaxDuration, []string{squatter}))
+	require.NoError(t, err)
+
+	// only governance can revoke a reservation
+	_, err = msgServer.RevokeReservation(ctx, types.NewMsgRevokeReservation(squatter, namespace))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.RevokeReservation(ctx, types.NewMsgRevokeReservation(authority, share.MustNewV0Namespace([]byte("other")).Bytes()))
+	require.ErrorIs(t, err, types.ErrReservationNotFound)
+
+	_, err = msgServer.RevokeReservation(ctx, types.NewMsgRevokeReservation(authority, namespace))
+	require.NoError(t, err)
+	_, found := k.GetReservation(ctx, namespace)
+	require.False(t, found)
+	byOwner, err := k.ReservationsByOwner(ctx, &types.QueryReservationsByOwnerRequest{Owner: squatter})
+	require.NoError(t, err)
+	require.Empty(t, byOwner.Reservations)
+	// the revoked reservation isn't pruned again when it would have expired
+	require.NoError(t, k.PruneExpiredReservations(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxDuration))))
+
+	// the namespace can be registered by the rollup that posts to it
+	_, err = msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(rollup, namespace, types.Day, nil))
+	require.NoError(t, err)
+	reservation, found := k.GetActiveReservation(ctx, namespace)
+	require.True(t, found)
+	require.Equal(t, rollup, reservation.Owner)
+}
+
+func TestGrandfathering(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false).WithBlockTime(now)
+	k := testApp.NsRegistryKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	squatter := fundedAccount(t, testApp, ctx)
+	rollup := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	late := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	namespace := share.MustNewV0Namespace([]byte("rollup")).Bytes()
+	_, err := msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(squatter, namespace, types.DefaultMaxDuration, []string{squatter}))
+	require.NoError(t, err)
+	reservation, _ := k.GetActiveReservation(ctx, namespace)
+	require.Equal(t, now.Add(types.DefaultGracePeriod), reservation.RestrictedFrom)
+
+	// the rollup keeps posting during the grace period and is grandfathered
+	require.NoError(t, k.CheckSigner(ctx, namespace, rollup))
+	restricted := ctx.WithBlockTime(reservation.RestrictedFrom)
+	require.NoError(t, k.CheckSigner(restricted, namespace, rollup))
+	require.NoError(t, k.CheckSigner(restricted, namespace, squatter))
+	require.ErrorIs(t, k.CheckSigner(restricted, namespace, late), types.ErrUnauthorizedSigner)
+	require.Equal(t, []types.GrandfatheredSigner{{Namespace: namespace, Signer: rollup}}, k.GetAllGrandfatheredSigners(ctx))
+
+	// updating the allowed signers restarts the grace period
+	_, err = msgServer.UpdateAllowedSigners(restricted, types.NewMsgUpdateAllowedSigners(squatter, namespace, []string{squatter}))
+	require.NoError(t, err)
+	require.NoError(t, k.CheckSigner(restricted, namespace, late))
+	reservation, _ = k.GetActiveReservation(restricted, namespace)
+	require.Equal(t, reservation.RestrictedFrom, restricted.BlockTime().Add(types.DefaultGracePeriod))
+
+	// grandfathered signers are deleted with the reservation
+	require.NoError(t, k.PruneExpiredReservations(ctx.WithBlockTime(reservation.ExpiresAt)))
+	require.Empty(t, k.GetAllGrandfatheredSigners(ctx))
+}
+
+func TestGenesis(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false)
+	k := testApp.NsRegistryKeeper
+
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	gs := types.GenesisState{
+		Params: types.DefaultParams(),
+		Reservations: []types.Reservation{
+			{Namespace: share.MustNewV0Namespace([]byte("rollup-a")).Bytes(), Owner: owner, ExpiresAt: expiresAt},
+			{Namespace: share.MustNewV0Namespace([]byte("rollup-b")).Bytes(), Owner: owner, ExpiresAt: expiresAt, AllowedSigners: []string{owner}},
+		},
+		GrandfatheredSigners: []types.GrandfatheredSigner{
+			{Namespace: share.MustNewV0Namespace([]byte("rollup-b")).Bytes(), Signer: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()},
+		},
+	}
+	require.NoError(t, k.InitGenesis(ctx, gs))
+	require.Equal(t, &gs, k.ExportGenesis(ctx))
+
+	gs.Reservations = append(gs.Reservations, gs.Reservations[0])
+	require.Error(t, k.InitGenesis(ctx, gs))
+}
+
+// fundedAccount returns a new account funded with enough utia for the tests.
+func fundedAccount(t *testing.T, testApp *app.App, ctx sdk.Context) string {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(100_000_000_000)))
+	require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+	return addr.String()
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nsregistry MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterNamespace reserves a namespace that isn't reserved by anyone else
+// and burns the fee of the duration. The allowed signers only restrict who may
+// pay for blobs in the namespace once the grace period has passed.
+func (k msgServer) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if msg.Duration < params.MinDuration || msg.Duration > params.MaxDuration {
+		return nil, types.ErrInvalidDuration.Wrapf("duration %s must be in the range [%s, %s]", msg.Duration, params.MinDuration, params.MaxDuration)
+	}
+	if len(msg.AllowedSigners) > int(params.MaxAllowedSigners) {
+		return nil, types.ErrInvalidAllowedSigner.Wrapf("%d allowed signers exceed the max of %d", len(msg.AllowedSigners), params.MaxAllowedSigners)
+	}
+
+	existing, found := k.GetReservation(ctx, msg.Namespace)
+	if found && existing.IsActive(ctx.BlockTime()) {
+		return nil, types.ErrNamespaceReserved.Wrapf("reserved by %s until %s", existing.Owner, existing.ExpiresAt)
+	}
+
+	fee := params.Fee(msg.Duration)
+	if err := k.burnFee(ctx, msg.Owner, fee); err != nil {
+		return nil, err
+	}
+
+	if found {
+		// the reservation expired in this block and wasn't pruned yet
+		k.deleteReservation(ctx, existing)
+		k.deleteGrandfathered(ctx, existing.Namespace)
+	}
+	reservation := types.Reservation{
+		Namespace:      msg.Namespace,
+		Owner:          msg.Owner,
+		ExpiresAt:      ctx.BlockTime().Add(msg.Duration),
+		AllowedSigners: msg.AllowedSigners,
+		RestrictedFrom: ctx.BlockTime().Add(params.GracePeriod),
+	}
+	k.setReservation(ctx, reservation)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewRegisterNamespaceEvent(reservation.Namespace, reservation.Owner, reservation.ExpiresAt, fee),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterNamespaceResponse{}, nil
+}
+
+// RenewNamespace extends the reservation of a namespace by its owner and burns
+// the fee of the duration.
+func (k msgServer) RenewNamespace(goCtx context.Context, msg *types.MsgRenewNamespace) (*types.MsgRenewNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	existing, err := k.getOwnedReservation(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Duration < params.MinDuration {
+		return nil, types.ErrInvalidDuration.Wrapf("duration %s is shorter than the min of %s", msg.Duration, params.MinDuration)
+	}
+	expiresAt := existing.ExpiresAt.Add(msg.Duration)
+	if remaining := expiresAt.Sub(ctx.BlockTime()); remaining > params.MaxDuration {
+		return nil, types.ErrInvalidDuration.Wrapf("the reservation would expire in %s, beyond the max of %s", remaining, params.MaxDuration)
+	}
+
+	fee := params.Fee(msg.Duration)
+	if err := k.burnFee(ctx, msg.Owner, fee); err != nil {
+		return nil, err
+	}
+
+	k.deleteReservation(ctx, existing)
+	existing.ExpiresAt = expiresAt
+	k.setReservation(ctx, existing)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewRenewNamespaceEvent(existing.Namespace, existing.Owner, existing.ExpiresAt, fee),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewNamespaceResponse{}, nil
+}
+
+// UpdateAllowedSigners replaces the allowed signers of a namespace reserved by
+// the owner and restarts the grace period so that the new allowed signers
+// don't exclude signers that already pay for blobs in the namespace.
+func (k msgServer) UpdateAllowedSigners(goCtx context.Context, msg *types.MsgUpdateAllowedSigners) (*types.MsgUpdateAllowedSignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	existing, err := k.getOwnedReservation(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.AllowedSigners) > int(params.MaxAllowedSigners) {
+		return nil, types.ErrInvalidAllowedSigner.Wrapf("%d allowed signers exceed the max of %d", len(msg.AllowedSigners), params.MaxAllowedSigners)
+	}
+
+	existing.AllowedSigners = msg.AllowedSigners
+	if restrictedFrom := ctx.BlockTime().Add(params.GracePeriod); restrictedFrom.After(existing.RestrictedFrom) {
+		existing.RestrictedFrom = restrictedFrom
+	}
+	k.setReservation(ctx, existing)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewUpdateAllowedSignersEvent(existing.Namespace, existing.Owner, existing.AllowedSigners),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAllowedSignersResponse{}, nil
+}
+
+// UpdateParams updates the nsregistry module parameters.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure that the sender has the authority to update the parameters.
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewUpdateParamsEvent(msg.Authority, msg.Params),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RevokeReservation deletes the active reservation of a namespace through
+// governance so that a namespace reserved by someone else than the rollup that
+// posts to it can be registered again. The burned fee isn't refunded.
+func (k msgServer) RevokeReservation(goCtx context.Context, msg *types.MsgRevokeReservation) (*types.MsgRevokeReservationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure that the sender has the authority to revoke reservations.
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+
+	reservation, found := k.GetActiveReservation(ctx, msg.Namespace)
+	if !found {
+		return nil, types.ErrReservationNotFound.Wrapf("namespace %x", msg.Namespace)
+	}
+	k.deleteReservation(ctx, reservation)
+	k.deleteGrandfathered(ctx, reservation.Namespace)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewRevokeReservationEvent(reservation.Namespace, reservation.Owner, msg.Authority),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeReservationResponse{}, nil
+}
+
+// getOwnedReservation returns the active reservation of the namespace if it is
+// owned by the owner.
+func (k Keeper) getOwnedReservation(ctx sdk.Context, owner string, namespace []byte) (types.Reservation, error) {
+	reservation, found := k.GetActiveReservation(ctx, namespace)
+	if !found {
+		return types.Reservation{}, types.ErrReservationNotFound.Wrapf("namespace %x", namespace)
+	}
+	if reservation.Owner != owner {
+		return types.Reservation{}, types.ErrNotOwner.Wrapf("namespace %x is owned by %s", namespace, reservation.Owner)
+	}
+	return reservation, nil
+}
+
+// burnFee burns the fee paid by the owner.
+func (k Keeper) burnFee(ctx sdk.Context, owner string, fee sdk.Coin) error {
+	if fee.IsZero() {
+		return nil
+	}
+	fees := sdk.NewCoins(fee)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(owner), types.ModuleName, fees); err != nil {
+		return errors.Wrap(err, "failed to pay the reservation fee")
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams gets all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if len(bz) == 0 {
+		// the params are unset on chains that upgraded to an app version
+		// with this module, which starts with the default params.
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(types.ParamsKey), bz)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetReservation returns the reservation of the namespace, even if it has
+// expired in the current block.
+func (k Keeper) GetReservation(ctx sdk.Context, namespace []byte) (types.Reservation, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReservationKey(namespace))
+	if bz == nil {
+		return types.Reservation{}, false
+	}
+	var reservation types.Reservation
+	k.cdc.MustUnmarshal(bz, &reservation)
+	return reservation, true
+}
+
+// GetActiveReservation returns the reservation of the namespace if it has not
+// expired at the block time.
+func (k Keeper) GetActiveReservation(ctx sdk.Context, namespace []byte) (types.Reservation, bool) {
+	reservation, found := k.GetReservation(ctx, namespace)
+	if !found || !reservation.IsActive(ctx.BlockTime()) {
+		return types.Reservation{}, false
+	}
+	return reservation, true
+}
+
+// CheckSigner returns an error if the signer may not pay for blobs in the
+// namespace because it is neither allowed by the owner of its active
+// reservation nor grandfathered. A signer that pays for blobs in the namespace
+// before the reservation restricts its signers is grandfathered so that
+// reserving a namespace never excludes a rollup that already posts to it.
+func (k Keeper) CheckSigner(ctx sdk.Context, namespace []byte, signer string) error {
+	reservation, found := k.GetActiveReservation(ctx, namespace)
+	if !found || reservation.IsAllowedSigner(signer) {
+		return nil
+	}
+	address, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return err
+	}
+	// the gas of a PayForBlobs doesn't depend on whether its signer is
+	// grandfathered so that gas estimates stay valid during the grace period
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if ctx.KVStore(k.storeKey).Has(types.GrandfatheredKey(namespace, address)) {
+		return nil
+	}
+	if !reservation.IsRestricted(ctx.BlockTime()) {
+		k.setGrandfathered(ctx, namespace, address)
+		return nil
+	}
+	return types.ErrUnauthorizedSigner.Wrapf("%s may not pay for blobs in namespace %x reserved by %s", signer, namespace, reservation.Owner)
+}
+
+// GetAllGrandfatheredSigners returns the grandfathered signers of all
+// namespaces ordered by namespace and signer.
+func (k Keeper) GetAllGrandfatheredSigners(ctx sdk.Context) []types.GrandfatheredSigner {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GrandfatheredKeyPrefix)
+	defer iterator.Close()
+
+	var signers []types.GrandfatheredSigner
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the prefix, the namespace and the signer
+		key := iterator.Key()[len(types.GrandfatheredKeyPrefix):]
+		signers = append(signers, types.GrandfatheredSigner{
+			Namespace: key[:share.NamespaceSize],
+			Signer:    sdk.AccAddress(key[share.NamespaceSize:]).String(),
+		})
+	}
+	return signers
+}
+
+// setGrandfathered grandfathers the signer of the namespace.
+func (k Keeper) setGrandfathered(ctx sdk.Context, namespace []byte, signer sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.GrandfatheredKey(namespace, signer), []byte{})
+}
+
+// deleteGrandfathered deletes the grandfathered signers of the namespace.
+func (k Keeper) deleteGrandfathered(ctx sdk.Context, namespace []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GrandfatheredPrefix(namespace))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllReservations returns all reservations ordered by namespace.
+func (k Keeper) GetAllReservations(ctx sdk.Context) []types.Reservation {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReservationKeyPrefix)
+	defer iterator.Close()
+
+	var reservations []types.Reservation
+	for ; iterator.Valid(); iterator.Next() {
+		var reservation types.Reservation
+		k.cdc.MustUnmarshal(iterator.Value(), &reservation)
+		reservations = append(reservations, reservation)
+	}
+	return reservations
+}
+
+// setReservation stores the reservation and indexes it by owner and expiry
+// time. The previous reservation of the namespace must have been deleted if
+// its owner or expiry time differ.
+func (k Keeper) setReservation(ctx sdk.Context, reservation types.Reservation) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(reservation.Owner)
+	store.Set(types.ReservationKey(reservation.Namespace), k.cdc.MustMarshal(&reservation))
+	store.Set(types.OwnerIndexKey(owner, reservation.Namespace), []byte{})
+	store.Set(types.ExpiryQueueKey(reservation.ExpiresAt, reservation.Namespace), []byte{})
+}
+
+// deleteReservation deletes the reservation and its indexes.
+func (k Keeper) deleteReservation(ctx sdk.Context, reservation types.Reservation) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(reservation.Owner)
+	store.Delete(types.ReservationKey(reservation.Namespace))
+	store.Delete(types.OwnerIndexKey(owner, reservation.Namespace))
+	store.Delete(types.ExpiryQueueKey(reservation.ExpiresAt, reservation.Namespace))
+}
+
+// PruneExpiredReservations deletes the reservations that have expired at the
+// block time so that their namespaces can be registered again.
+func (k Keeper) PruneExpiredReservations(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(types.ExpiryQueuePrefix(ctx.BlockTime()))
+	iterator := store.Iterator(types.ExpiryQueueKeyPrefix, end)
+	var namespaces [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		// the key ends with the namespace
+		key := iterator.Key()
+		namespaces = append(namespaces, key[len(key)-share.NamespaceSize:])
+	}
+	iterator.Close()
+
+	for _, namespace := range namespaces {
+		reservation, found := k.GetReservation(ctx, namespace)
+		if !found {
+			continue
+		}
+		k.deleteReservation(ctx, reservation)
+		k.deleteGrandfathered(ctx, reservation.Namespace)
+		if err := ctx.EventManager().EmitTypedEvent(types.NewNamespaceExpiredEvent(reservation.Namespace, reservation.Owner)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidNamespace     = errors.Register(ModuleName, 11200, "invalid namespace")
+	ErrNamespaceReserved    = errors.Register(ModuleName, 11201, "namespace is already reserved")
+	ErrReservationNotFound  = errors.Register(ModuleName, 11202, "namespace is not reserved")
+	ErrNotOwner             = errors.Register(ModuleName, 11203, "signer is not the owner of the namespace")
+	ErrInvalidDuration      = errors.Register(ModuleName, 11204, "invalid reservation duration")
+	ErrInvalidAllowedSigner = errors.Register(ModuleName, 11205, "invalid allowed signers")
+	ErrUnauthorizedSigner   = errors.Register(ModuleName, 11206, "signer may not pay for blobs in the reserved namespace")
+)
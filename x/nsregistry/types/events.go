@@ -0,0 +1,61 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRegisterNamespaceEvent returns a new EventRegisterNamespace.
+func NewRegisterNamespaceEvent(namespace []byte, owner string, expiresAt time.Time, fee sdk.Coin) *EventRegisterNamespace {
+	return &EventRegisterNamespace{
+		Namespace: namespace,
+		Owner:     owner,
+		ExpiresAt: expiresAt,
+		Fee:       fee,
+	}
+}
+
+// NewRenewNamespaceEvent returns a new EventRenewNamespace.
+func NewRenewNamespaceEvent(namespace []byte, owner string, expiresAt time.Time, fee sdk.Coin) *EventRenewNamespace {
+	return &EventRenewNamespace{
+		Namespace: namespace,
+		Owner:     owner,
+		ExpiresAt: expiresAt,
+		Fee:       fee,
+	}
+}
+
+// NewUpdateAllowedSignersEvent returns a new EventUpdateAllowedSigners.
+func NewUpdateAllowedSignersEvent(namespace []byte, owner string, allowedSigners []string) *EventUpdateAllowedSigners {
+	return &EventUpdateAllowedSigners{
+		Namespace:      namespace,
+		Owner:          owner,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// NewNamespaceExpiredEvent returns a new EventNamespaceExpired.
+func NewNamespaceExpiredEvent(namespace []byte, owner string) *EventNamespaceExpired {
+	return &EventNamespaceExpired{
+		Namespace: namespace,
+		Owner:     owner,
+	}
+}
+
+// NewRevokeReservationEvent returns a new EventRevokeReservation.
+func NewRevokeReservationEvent(namespace []byte, owner string, authority string) *EventRevokeReservation {
+	return &EventRevokeReservation{
+		Namespace: namespace,
+		Owner:     owner,
+		Authority: authority,
+	}
+}
+
+// NewUpdateParamsEvent returns a new EventUpdateParams.
+func NewUpdateParamsEvent(authority string, params Params) *EventUpdateParams {
+	return &EventUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
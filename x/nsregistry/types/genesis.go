@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(gs.Reservations))
+	for _, reservation := range gs.Reservations {
+		if err := reservation.Validate(); err != nil {
+			return err
+		}
+		if seen[string(reservation.Namespace)] {
+			return fmt.Errorf("duplicate reservation of namespace %x", reservation.Namespace)
+		}
+		seen[string(reservation.Namespace)] = true
+	}
+	grandfathered := make(map[string]bool, len(gs.GrandfatheredSigners))
+	for _, signer := range gs.GrandfatheredSigners {
+		if err := signer.Validate(); err != nil {
+			return err
+		}
+		if !seen[string(signer.Namespace)] {
+			return fmt.Errorf("grandfathered signer %s of namespace %x without a reservation", signer.Signer, signer.Namespace)
+		}
+		key := string(signer.Namespace) + signer.Signer
+		if grandfathered[key] {
+			return fmt.Errorf("duplicate grandfathered signer %s of namespace %x", signer.Signer, signer.Namespace)
+		}
+		grandfathered[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "nsregistry"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// ParamsKey defines the key used for storing module parameters
+	ParamsKey = "params"
+)
+
+var (
+	// ReservationKeyPrefix is the prefix of the keys of the reservations by
+	// namespace.
+	ReservationKeyPrefix = []byte("reservation/")
+
+	// OwnerIndexKeyPrefix is the prefix of the keys of the index of the
+	// reserved namespaces by owner.
+	OwnerIndexKeyPrefix = []byte("owner/")
+
+	// ExpiryQueueKeyPrefix is the prefix of the keys of the queue of the
+	// reserved namespaces by expiry time.
+	ExpiryQueueKeyPrefix = []byte("expiry/")
+
+	// GrandfatheredKeyPrefix is the prefix of the keys of the grandfathered
+	// signers of the reserved namespaces.
+	GrandfatheredKeyPrefix = []byte("grandfathered/")
+)
+
+// ReservationKey returns the key of the reservation of the namespace.
+func ReservationKey(namespace []byte) []byte {
+	return append(append([]byte{}, ReservationKeyPrefix...), namespace...)
+}
+
+// OwnerIndexPrefix returns the prefix of the keys of the namespaces reserved
+// by the owner.
+func OwnerIndexPrefix(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, OwnerIndexKeyPrefix...), address.MustLengthPrefix(owner)...)
+}
+
+// OwnerIndexKey returns the key of the namespace in the index of the owner.
+func OwnerIndexKey(owner sdk.AccAddress, namespace []byte) []byte {
+	return append(OwnerIndexPrefix(owner), namespace...)
+}
+
+// ExpiryQueuePrefix returns the prefix of the keys of the namespaces
+// expiring at the time.
+func ExpiryQueuePrefix(expiresAt time.Time) []byte {
+	return append(append([]byte{}, ExpiryQueueKeyPrefix...), sdk.FormatTimeBytes(expiresAt)...)
+}
+
+// ExpiryQueueKey returns the key of the namespace in the expiry queue.
+func ExpiryQueueKey(expiresAt time.Time, namespace []byte) []byte {
+	return append(ExpiryQueuePrefix(expiresAt), namespace...)
+}
+
+// GrandfatheredPrefix returns the prefix of the keys of the grandfathered
+// signers of the namespace.
+func GrandfatheredPrefix(namespace []byte) []byte {
+	return append(append([]byte{}, GrandfatheredKeyPrefix...), namespace...)
+}
+
+// GrandfatheredKey returns the key of the grandfathered signer of the
+// namespace.
+func GrandfatheredKey(namespace []byte, signer sdk.AccAddress) []byte {
+	return append(GrandfatheredPrefix(namespace), signer...)
+}
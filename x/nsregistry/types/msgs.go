@@ -0,0 +1,106 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgRegisterNamespace)(nil)
+	_ sdk.Msg = (*MsgRenewNamespace)(nil)
+	_ sdk.Msg = (*MsgUpdateAllowedSigners)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgRevokeReservation)(nil)
+)
+
+// NewMsgRegisterNamespace creates a new MsgRegisterNamespace instance.
+func NewMsgRegisterNamespace(owner string, namespace []byte, duration time.Duration, allowedSigners []string) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Owner:          owner,
+		Namespace:      namespace,
+		Duration:       duration,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// ValidateBasic performs stateless validation of the message.
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	if err := validateOwnerAndNamespace(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	if msg.Duration <= 0 {
+		return ErrInvalidDuration.Wrapf("duration must be positive: %s", msg.Duration)
+	}
+	return ValidateAllowedSigners(msg.AllowedSigners)
+}
+
+// NewMsgRenewNamespace creates a new MsgRenewNamespace instance.
+func NewMsgRenewNamespace(owner string, namespace []byte, duration time.Duration) *MsgRenewNamespace {
+	return &MsgRenewNamespace{
+		Owner:     owner,
+		Namespace: namespace,
+		Duration:  duration,
+	}
+}
+
+// ValidateBasic performs stateless validation of the message.
+func (msg *MsgRenewNamespace) ValidateBasic() error {
+	if err := validateOwnerAndNamespace(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	if msg.Duration <= 0 {
+		return ErrInvalidDuration.Wrapf("duration must be positive: %s", msg.Duration)
+	}
+	return nil
+}
+
+// NewMsgUpdateAllowedSigners creates a new MsgUpdateAllowedSigners instance.
+func NewMsgUpdateAllowedSigners(owner string, namespace []byte, allowedSigners []string) *MsgUpdateAllowedSigners {
+	return &MsgUpdateAllowedSigners{
+		Owner:          owner,
+		Namespace:      namespace,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// ValidateBasic performs stateless validation of the message.
+func (msg *MsgUpdateAllowedSigners) ValidateBasic() error {
+	if err := validateOwnerAndNamespace(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowedSigners(msg.AllowedSigners)
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// NewMsgRevokeReservation creates a new MsgRevokeReservation instance.
+func NewMsgRevokeReservation(authority string, namespace []byte) *MsgRevokeReservation {
+	return &MsgRevokeReservation{
+		Authority: authority,
+		Namespace: namespace,
+	}
+}
+
+// ValidateBasic performs stateless validation of the message.
+func (msg *MsgRevokeReservation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return ValidateNamespace(msg.Namespace)
+}
+
+func validateOwnerAndNamespace(owner string, namespace []byte) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	return ValidateNamespace(namespace)
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Day is the duration priced by the fee per day.
+const Day = 24 * time.Hour
+
+var (
+	// DefaultFeePerDay is the default fee for reserving a namespace for a day.
+	// It is high enough that squatting on namespaces used by others is costly.
+	DefaultFeePerDay = sdk.NewCoin(appconsts.BondDenom, math.NewInt(10_000_000))
+	// DefaultMinDuration is the default shortest duration of a registration
+	// or renewal.
+	DefaultMinDuration = Day
+	// DefaultMaxDuration is the default longest time for which a namespace can
+	// be reserved in advance.
+	DefaultMaxDuration = 365 * Day
+	// DefaultMaxAllowedSigners is the default maximum number of signers
+	// allowed to pay for blobs in a namespace.
+	DefaultMaxAllowedSigners uint32 = 10
+	// DefaultGracePeriod is the default time during which any signer may pay
+	// for blobs in a newly restricted namespace and is grandfathered. It is
+	// long enough for a rollup that posts to the namespace to do so.
+	DefaultGracePeriod = 7 * Day
+)
+
+// NewParams creates a new Params instance.
+func NewParams(feePerDay sdk.Coin, minDuration, maxDuration time.Duration, maxAllowedSigners uint32, gracePeriod time.Duration) Params {
+	return Params{
+		FeePerDay:         feePerDay,
+		MinDuration:       minDuration,
+		MaxDuration:       maxDuration,
+		MaxAllowedSigners: maxAllowedSigners,
+		GracePeriod:       gracePeriod,
+	}
+}
+
+// DefaultParams returns the default parameters for the module.
+func DefaultParams() Params {
+	return NewParams(DefaultFeePerDay, DefaultMinDuration, DefaultMaxDuration, DefaultMaxAllowedSigners, DefaultGracePeriod)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := p.FeePerDay.Validate(); err != nil {
+		return fmt.Errorf("invalid fee per day: %w", err)
+	}
+	if p.MinDuration <= 0 {
+		return fmt.Errorf("min duration must be positive: %s", p.MinDuration)
+	}
+	if p.MaxDuration < p.MinDuration {
+		return fmt.Errorf("max duration %s must not be shorter than the min duration %s", p.MaxDuration, p.MinDuration)
+	}
+	if p.GracePeriod < 0 {
+		return fmt.Errorf("grace period must not be negative: %s", p.GracePeriod)
+	}
+	return nil
+}
+
+// Fee returns the fee of reserving a namespace for the duration. It is the
+// fee per day prorated to the duration and rounded up.
+func (p Params) Fee(duration time.Duration) sdk.Coin {
+	day := math.NewInt(int64(Day))
+	amount := p.FeePerDay.Amount.Mul(math.NewInt(int64(duration))).Add(day).SubRaw(1).Quo(day)
+	return sdk.NewCoin(p.FeePerDay.Denom, amount)
+}
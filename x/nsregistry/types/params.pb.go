@@ -0,0 +1,537 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/nsregistry/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// fee_per_day is the fee charged for reserving a namespace for 24 hours.
+	// The fee of a registration or renewal is prorated to its duration and
+	// burned.
+	FeePerDay types.Coin `protobuf:"bytes,1,opt,name=fee_per_day,json=feePerDay,proto3" json:"fee_per_day"`
+	// min_duration is the shortest duration of a registration or renewal.
+	MinDuration time.Duration `protobuf:"bytes,2,opt,name=min_duration,json=minDuration,proto3,stdduration" json:"min_duration"`
+	// max_duration is the longest time for which a namespace can be reserved
+	// in advance, including by renewals.
+	MaxDuration time.Duration `protobuf:"bytes,3,opt,name=max_duration,json=maxDuration,proto3,stdduration" json:"max_duration"`
+	// max_allowed_signers is the maximum number of signers that the owner of a
+	// namespace can allow to pay for blobs in it.
+	MaxAllowedSigners uint32 `protobuf:"varint,4,opt,name=max_allowed_signers,json=maxAllowedSigners,proto3" json:"max_allowed_signers,omitempty"`
+	// grace_period is the time after a namespace is registered, or its allowed
+	// signers are updated, during which any signer may pay for blobs in it.
+	// Signers that do so are grandfathered and may keep paying for blobs in it.
+	GracePeriod time.Duration `protobuf:"bytes,5,opt,name=grace_period,json=gracePeriod,proto3,stdduration" json:"grace_period"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a6bd1769d4b587f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeePerDay() types.Coin {
+	if m != nil {
+		return m.FeePerDay
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMinDuration() time.Duration {
+	if m != nil {
+		return m.MinDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDuration() time.Duration {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAllowedSigners() uint32 {
+	if m != nil {
+		return m.MaxAllowedSigners
+	}
+	return 0
+}
+
+func (m *Params) GetGracePeriod() time.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celestia.nsregistry.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("celestia/nsregistry/v1/params.proto", fileDescriptor_0a6bd1769d4b587f)
+}
+
+var fileDescriptor_0a6bd1769d4b587f = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x6f, 0x9b, 0x40,
+	0x14, 0xc6, 0xc1, 0x75, 0xad, 0x16, 0xb7, 0x43, 0xdd, 0xaa, 0xa2, 0x1e, 0xb0, 0xd5, 0x2c, 0x5e,
+	0x72, 0x27, 0x12, 0x29, 0x6b, 0x14, 0xc7, 0xca, 0x8c, 0x9c, 0x2d, 0x0b, 0x3a, 0xe0, 0xf9, 0x72,
+	0x12, 0xf0, 0xd0, 0x1d, 0x76, 0xe0, 0xbf, 0xc8, 0x98, 0x3f, 0xc9, 0xa3, 0xb3, 0x65, 0x4a, 0x22,
+	0xfb, 0x1f, 0x89, 0x38, 0xc0, 0xce, 0xea, 0xed, 0xc1, 0xf7, 0xbd, 0xdf, 0xf7, 0xe9, 0xee, 0xac,
+	0x93, 0x10, 0x62, 0x50, 0xb9, 0x60, 0x34, 0x55, 0x12, 0xb8, 0x50, 0xb9, 0x2c, 0xe9, 0xca, 0xa5,
+	0x19, 0x93, 0x2c, 0x51, 0x24, 0x93, 0x98, 0xe3, 0xe0, 0x6f, 0x6b, 0x22, 0x07, 0x13, 0x59, 0xb9,
+	0xc3, 0x3f, 0x1c, 0x39, 0x6a, 0x0b, 0xad, 0xa6, 0xda, 0x3d, 0x74, 0x38, 0x22, 0x8f, 0x81, 0xea,
+	0xaf, 0x60, 0xb9, 0xa0, 0xd1, 0x52, 0xb2, 0x5c, 0x60, 0xda, 0xea, 0x21, 0xaa, 0x04, 0x15, 0x0d,
+	0x98, 0x02, 0xba, 0x72, 0x03, 0xc8, 0x99, 0x4b, 0x43, 0x14, 0x8d, 0xfe, 0xff, 0xb9, 0x63, 0xf5,
+	0x3c, 0x1d, 0x3f, 0xb8, 0xb4, 0xfa, 0x0b, 0x00, 0x3f, 0x03, 0xe9, 0x47, 0xac, 0xb4, 0xcd, 0xb1,
+	0x39, 0xe9, 0x9f, 0xfd, 0x23, 0x35, 0x80, 0x54, 0x00, 0xd2, 0x00, 0xc8, 0x35, 0x8a, 0x74, 0xda,
+	0x5d, 0xbf, 0x8e, 0x8c, 0xf9, 0xf7, 0x05, 0x80, 0x07, 0x72, 0xc6, 0xca, 0xc1, 0x8d, 0xf5, 0x23,
+	0x11, 0xa9, 0xdf, 0x36, 0xb0, 0x3b, 0x0d, 0xa1, 0xae, 0x48, 0xda, 0x8a, 0x64, 0xd6, 0x18, 0xa6,
+	0xdf, 0x2a, 0xc2, 0xd3, 0xdb, 0xc8, 0x9c, 0xf7, 0x13, 0x91, 0xb6, 0xbf, 0x35, 0x87, 0x15, 0x07,
+	0xce, 0x97, 0x63, 0x38, 0xac, 0xd8, 0x73, 0x88, 0xf5, 0xbb, 0xe2, 0xb0, 0x38, 0xc6, 0x07, 0x88,
+	0x7c, 0x25, 0x78, 0x0a, 0x52, 0xd9, 0xdd, 0xb1, 0x39, 0xf9, 0x39, 0xff, 0x95, 0xb0, 0xe2, 0xaa,
+	0x56, 0x6e, 0x6b, 0xa1, 0xca, 0xe5, 0x92, 0x85, 0xfa, 0x08, 0x04, 0x46, 0xf6, 0xd7, 0x23, 0x72,
+	0xf5, 0xa2, 0xa7, 0xf7, 0xa6, 0xde, 0x7a, 0xeb, 0x98, 0x9b, 0xad, 0x63, 0xbe, 0x6f, 0x1d, 0xf3,
+	0x71, 0xe7, 0x18, 0x9b, 0x9d, 0x63, 0xbc, 0xec, 0x1c, 0xe3, 0xee, 0x82, 0x8b, 0xfc, 0x7e, 0x19,
+	0x90, 0x10, 0x13, 0xda, 0x5e, 0x33, 0x4a, 0xbe, 0x9f, 0x4f, 0x59, 0x96, 0xd1, 0xe2, 0xf3, 0xeb,
+	0xc8, 0xcb, 0x0c, 0x54, 0xd0, 0xd3, 0xd9, 0xe7, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xac,
+	0x59, 0x23, 0x41, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxAllowedSigners != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAllowedSigners))
+		i--
+		dAtA[i] = 0x20
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FeePerDay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePerDay.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxAllowedSigners != 0 {
+		n += 1 + sovParams(uint64(m.MaxAllowedSigners))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerDay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSigners", wireType)
+			}
+			m.MaxAllowedSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAllowedSigners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.FeePerDay = sdk.Coin{Denom: appconsts.BondDenom, Amount: math.NewInt(-1)}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinDuration = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxDuration = params.MinDuration - 1
+	require.Error(t, params.Validate())
+}
+
+func TestParamsFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.FeePerDay = sdk.NewCoin(appconsts.BondDenom, math.NewInt(1000))
+
+	testCases := []struct {
+		duration time.Duration
+		want     int64
+	}{
+		{types.Day, 1000},
+		{30 * types.Day, 30_000},
+		{types.Day / 2, 500},
+		// the fee is rounded up
+		{time.Second, 1},
+		{types.Day + time.Minute, 1001},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, sdk.NewCoin(appconsts.BondDenom, math.NewInt(tc.want)), params.Fee(tc.duration), tc.duration)
+	}
+}
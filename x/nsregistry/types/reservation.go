@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsActive returns true if the reservation has not expired at the time.
+func (r Reservation) IsActive(now time.Time) bool {
+	return now.Before(r.ExpiresAt)
+}
+
+// IsRestricted returns true if the signers of the reservation are restricted
+// at the time, i.e. the grace period after its allowed signers were set has
+// passed.
+func (r Reservation) IsRestricted(now time.Time) bool {
+	return len(r.AllowedSigners) > 0 && !now.Before(r.RestrictedFrom)
+}
+
+// IsAllowedSigner returns true if the signer may pay for blobs in the reserved
+// namespace regardless of whether it is grandfathered.
+func (r Reservation) IsAllowedSigner(signer string) bool {
+	if len(r.AllowedSigners) == 0 || signer == r.Owner {
+		return true
+	}
+	for _, allowed := range r.AllowedSigners {
+		if signer == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs a stateless validation of the reservation.
+func (r Reservation) Validate() error {
+	if err := ValidateNamespace(r.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return ValidateAllowedSigners(r.AllowedSigners)
+}
+
+// Validate performs a stateless validation of the grandfathered signer.
+func (g GrandfatheredSigner) Validate() error {
+	if err := ValidateNamespace(g.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Signer); err != nil {
+		return fmt.Errorf("invalid grandfathered signer address: %w", err)
+	}
+	return nil
+}
+
+// ValidateNamespace returns an error if the namespace can't be reserved
+// because it is not a valid user-specifiable blob namespace.
+func ValidateNamespace(namespace []byte) error {
+	ns, err := share.NewNamespaceFromBytes(namespace)
+	if err != nil {
+		return ErrInvalidNamespace.Wrap(err.Error())
+	}
+	if err := blobtypes.ValidateBlobNamespace(ns); err != nil {
+		return ErrInvalidNamespace.Wrap(err.Error())
+	}
+	return nil
+}
+
+// ValidateAllowedSigners returns an error if an allowed signer is not a valid
+// address or is duplicated.
+func ValidateAllowedSigners(signers []string) error {
+	seen := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return ErrInvalidAllowedSigner.Wrapf("invalid address %s: %s", signer, err)
+		}
+		if seen[signer] {
+			return ErrInvalidAllowedSigner.Wrapf("duplicate address %s", signer)
+		}
+		seen[signer] = true
+	}
+	return nil
+}
@@ -45,3 +45,20 @@ func (s *CLITestSuite) TestCmdGetUpgrade() {
 	s.Require().NoError(err)
 	s.Require().Contains(output.String(), "No upgrade is pending.")
 }
+
+func (s *CLITestSuite) TestCmdUpgradeReadiness() {
+	cmd := cli.CmdUpgradeReadiness()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{"2"})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "missing_voting_power")
+	s.Require().Contains(output.String(), "missing")
+}
+
+func (s *CLITestSuite) TestCmdQueryParams() {
+	cmd := cli.CmdQueryParams()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "signal_expiry_blocks")
+	s.Require().Contains(output.String(), "threshold")
+	s.Require().Contains(output.String(), "upgrade_height_delay")
+}
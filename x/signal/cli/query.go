@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,18 +22,56 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryTally())
 	cmd.AddCommand(CmdGetUpgrade())
 	cmd.AddCommand(CmdGetMissingValidators())
+	cmd.AddCommand(CmdUpgradeReadiness())
+	cmd.AddCommand(CmdSignalHistory())
+	return cmd
+}
+
+const (
+	// FlagWatch is the flag to watch the tally until the version reaches the threshold.
+	FlagWatch = "watch"
+	// FlagInterval is the flag of the interval at which the tally is watched.
+	FlagInterval = "interval"
+)
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the signal module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&resp.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
 func CmdQueryTally() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "tally version",
-		Short:   "Query for the tally of voting power that has signalled for a particular version",
+		Use:   "tally version",
+		Short: "Query for the tally of voting power that has signalled for a particular version",
+		Long: `Query for the tally of voting power that has signalled for a particular version.
+With --watch, the tally is printed whenever it changes until the version
+reaches the threshold.`,
 		Args:    cobra.ExactArgs(1),
-		Example: "tally 3",
+		Example: "tally 3\ntally 3 --watch --interval 30s",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -44,6 +84,17 @@ func CmdQueryTally() *cobra.Command {
 			}
 
 			upgradeQueryClient := types.NewQueryClient(clientCtx)
+			if watch, _ := cmd.Flags().GetBool(FlagWatch); watch {
+				interval, err := cmd.Flags().GetDuration(FlagInterval)
+				if err != nil {
+					return err
+				}
+				query := func(ctx context.Context) (*types.QueryUpgradeReadinessResponse, error) {
+					return upgradeQueryClient.UpgradeReadiness(ctx, &types.QueryUpgradeReadinessRequest{Version: version})
+				}
+				return watchTally(cmd.Context(), query, interval, clientCtx.PrintString)
+			}
+
 			resp, err := upgradeQueryClient.VersionTally(cmd.Context(), &types.QueryVersionTallyRequest{Version: version})
 			if err != nil {
 				return err
@@ -53,6 +104,122 @@ func CmdQueryTally() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagWatch, false, "Print the tally whenever it changes until the version reaches the threshold")
+	cmd.Flags().Duration(FlagInterval, 6*time.Second, "Interval at which the tally is queried with --watch")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// watchTally queries the readiness of a version every interval and prints it
+// whenever the tally changes. It returns once the version reaches the
+// threshold or ctx is done.
+func watchTally(ctx context.Context, query func(context.Context) (*types.QueryUpgradeReadinessResponse, error), interval time.Duration, printString func(string) error) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive: %s", interval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := ""
+	for {
+		resp, err := query(ctx)
+		if err != nil {
+			return err
+		}
+		if line := formatTally(resp); line != last {
+			if err := printString(fmt.Sprintf("height %d: %s\n", resp.Height, line)); err != nil {
+				return err
+			}
+			last = line
+		}
+		if resp.Ready {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// formatTally formats the tally of a readiness response without its height.
+func formatTally(resp *types.QueryUpgradeReadinessResponse) string {
+	percentage := float64(0)
+	if resp.TotalVotingPower > 0 {
+		percentage = float64(resp.VotingPower) / float64(resp.TotalVotingPower) * 100
+	}
+	line := fmt.Sprintf("voting power %d/%d (%.2f%%), threshold %d", resp.VotingPower, resp.TotalVotingPower, percentage, resp.ThresholdPower)
+	if resp.Ready {
+		return line + ", ready"
+	}
+	line += fmt.Sprintf(", missing %d from %d validators", resp.MissingVotingPower, len(resp.Missing))
+	if len(resp.Missing) > 0 {
+		largest := resp.Missing[0]
+		name := largest.Moniker
+		if name == "" {
+			name = largest.ValidatorAddress
+		}
+		line += fmt.Sprintf(" (largest %s with %d)", name, largest.VotingPower)
+	}
+	return line
+}
+
+func CmdUpgradeReadiness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "readiness version",
+		Short:   "Query for how close the network is to upgrading to a particular version",
+		Long:    "Query for the voting power that signalled for a particular version, the validators that are missing weighted by voting power and the voting power after each upcoming signal expiry.",
+		Args:    cobra.ExactArgs(1),
+		Example: "readiness 5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			upgradeQueryClient := types.NewQueryClient(clientCtx)
+			resp, err := upgradeQueryClient.UpgradeReadiness(cmd.Context(), &types.QueryUpgradeReadinessRequest{Version: version})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSignalHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "signal-history validator-address",
+		Short:   "Query for the recent signals of a validator",
+		Args:    cobra.ExactArgs(1),
+		Example: "signal-history celestiavaloper1...",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			upgradeQueryClient := types.NewQueryClient(clientCtx)
+			resp, err := upgradeQueryClient.SignalHistory(cmd.Context(), &types.QuerySignalHistoryRequest{ValidatorAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchTally(t *testing.T) {
+	responses := []*types.QueryUpgradeReadinessResponse{
+		{Height: 1, VotingPower: 40, ThresholdPower: 100, TotalVotingPower: 120, MissingVotingPower: 60, Missing: []*types.ValidatorReadiness{{Moniker: "val-2", VotingPower: 59}, {Moniker: "val-3", VotingPower: 21}}},
+		// the tally didn't change so it isn't printed.
+		{Height: 2, VotingPower: 40, ThresholdPower: 100, TotalVotingPower: 120, MissingVotingPower: 60, Missing: []*types.ValidatorReadiness{{Moniker: "val-2", VotingPower: 59}, {Moniker: "val-3", VotingPower: 21}}},
+		{Height: 3, VotingPower: 99, ThresholdPower: 100, TotalVotingPower: 120, MissingVotingPower: 1, Missing: []*types.ValidatorReadiness{{ValidatorAddress: "celestiavaloper1", VotingPower: 21}}},
+		{Height: 4, VotingPower: 120, ThresholdPower: 100, TotalVotingPower: 120, Ready: true},
+	}
+	query := func(context.Context) (*types.QueryUpgradeReadinessResponse, error) {
+		resp := responses[0]
+		responses = responses[1:]
+		return resp, nil
+	}
+	var output strings.Builder
+	printString := func(s string) error {
+		output.WriteString(s)
+		return nil
+	}
+
+	require.NoError(t, watchTally(context.Background(), query, time.Millisecond, printString))
+	require.Empty(t, responses)
+	require.Equal(t, `height 1: voting power 40/120 (33.33%), threshold 100, missing 60 from 2 validators (largest val-2 with 59)
+height 3: voting power 99/120 (82.50%), threshold 100, missing 1 from 1 validators (largest celestiavaloper1 with 21)
+height 4: voting power 120/120 (100.00%), threshold 100, ready
+`, output.String())
+
+	t.Run("returns when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		query := func(context.Context) (*types.QueryUpgradeReadinessResponse, error) {
+			cancel()
+			return &types.QueryUpgradeReadinessResponse{}, nil
+		}
+		require.NoError(t, watchTally(ctx, query, time.Hour, printString))
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		require.ErrorContains(t, watchTally(context.Background(), query, 0, printString), "interval must be positive")
+	})
+}
@@ -0,0 +1,24 @@
+package signal
+
+import (
+	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the signal module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if gs.Params == nil {
+		return nil
+	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, *gs.Params)
+	return nil
+}
+
+// ExportGenesis returns the signal module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params := k.GetParams(ctx)
+	return &types.GenesisState{Params: &params}
+}
@@ -5,13 +5,17 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"strconv"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -19,20 +23,8 @@ import (
 var (
 	_ types.MsgServer   = &Keeper{}
 	_ types.QueryServer = Keeper{}
-
-	// defaultSignalThreshold is 5/6 or approximately 83.33%
-	defaultSignalThreshold = math.LegacyNewDec(5).Quo(math.LegacyNewDec(6))
 )
 
-// Threshold is the fraction of voting power that is required
-// to signal for a version change. It is set to 5/6 as the middle point
-// between 2/3 and 3/3 providing 1/6 fault tolerance to halting the
-// network during an upgrade period. It can be modified through a
-// hard fork change that modified the app version
-func Threshold(_ uint64) math.LegacyDec {
-	return defaultSignalThreshold
-}
-
 type Keeper struct {
 	// binaryCodec is used to marshal and unmarshal data from the store.
 	binaryCodec codec.BinaryCodec
@@ -44,6 +36,10 @@ type Keeper struct {
 	// stakingKeeper is used to fetch validators to calculate the total power
 	// signalled to a version.
 	stakingKeeper StakingKeeper
+
+	// authority is the address that may cancel upgrades and update the
+	// params, i.e. the governance module account.
+	authority string
 }
 
 // NewKeeper returns a signal keeper.
@@ -51,14 +47,21 @@ func NewKeeper(
 	binaryCodec codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	stakingKeeper StakingKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		binaryCodec:   binaryCodec,
 		storeKey:      storeKey,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SignalVersion is a method required by the MsgServer interface.
 func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion) (*types.MsgSignalVersionResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -124,7 +127,7 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 		header := sdkCtx.HeaderInfo()
 		upgrade := types.Upgrade{
 			AppVersion:    version,
-			UpgradeHeight: header.Height + appconsts.GetUpgradeHeightDelay(header.ChainID),
+			UpgradeHeight: header.Height + k.GetUpgradeHeightDelay(sdkCtx),
 		}
 		k.setUpgrade(sdkCtx, upgrade)
 	}
@@ -140,6 +143,67 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 	return &types.MsgTryUpgradeResponse{}, nil
 }
 
+// CancelUpgrade is a method required by the MsgServer interface. It cancels
+// the pending upgrade if its upgrade height hasn't been reached yet. The
+// signals for the cancelled version are deleted so that the upgrade can't
+// reach a quorum again until validators signal for it anew.
+func (k *Keeper) CancelUpgrade(ctx context.Context, req *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, req.Authority)
+	}
+
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return nil, types.ErrNoUpgradePending.Wrapf("can not cancel upgrade")
+	}
+	if sdkCtx.BlockHeight() >= upgrade.UpgradeHeight {
+		return nil, types.ErrUpgradeHeightReached.Wrapf("can not cancel upgrade to version %d at height %d", upgrade.AppVersion, upgrade.UpgradeHeight)
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	store.Delete(types.UpgradeKey)
+	k.deleteSignalsForVersion(sdkCtx, upgrade.AppVersion)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyAppVersion, strconv.FormatUint(upgrade.AppVersion, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(upgrade.UpgradeHeight, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.URLMsgCancelUpgrade),
+		),
+	)
+
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
+// UpdateParams is a method required by the MsgServer interface. It updates the
+// params of the signal module.
+func (k *Keeper) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, req.Authority)
+	}
+	if err := req.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
+	}
+
+	k.SetParams(sdkCtx, req.Params)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateParams,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.URLMsgUpdateParams),
+		),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
 // VersionTally enables a client to query for the tally of voting power has
 // signalled for a particular version.
 func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRequest) (*types.QueryVersionTallyResponse, error) {
@@ -149,11 +213,12 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 		return nil, err
 	}
 	currentVotingPower := math.NewInt(0)
+	params := k.GetParams(sdkCtx)
 	store := sdkCtx.KVStore(k.storeKey)
 	iterator := store.Iterator(types.FirstSignalKey, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+		if !types.IsSignalKey(iterator.Key()) || k.isExpired(sdkCtx, params, iterator.Value()) {
 			continue
 		}
 		valAddress := sdk.ValAddress(iterator.Key())
@@ -183,6 +248,7 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 func (k Keeper) GetMissingValidators(ctx context.Context, req *types.QueryGetMissingValidatorsRequest) (*types.QueryGetMissingValidatorsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	missingValidators := make([]string, 0)
+	params := k.GetParams(sdkCtx)
 
 	// Use IterateValidators to iterate over all validators
 	err := k.stakingKeeper.IterateValidators(sdkCtx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
@@ -206,9 +272,10 @@ func (k Keeper) GetMissingValidators(ctx context.Context, req *types.QueryGetMis
 		store := sdkCtx.KVStore(k.storeKey)
 		votedVersionBytes := store.Get(valAddr)
 
-		// If validator hasn't voted or voted for a different version, add to missing list
-		if votedVersionBytes == nil {
-			// Validator hasn't voted at all
+		// If validator hasn't voted, its vote expired or it voted for a
+		// different version, add to missing list
+		if votedVersionBytes == nil || k.isExpired(sdkCtx, params, votedVersionBytes) {
+			// Validator hasn't voted at all or its vote expired
 			missingValidators = append(missingValidators, validator.GetMoniker())
 		} else {
 			votedVersion := VersionFromBytes(votedVersionBytes)
@@ -227,10 +294,20 @@ func (k Keeper) GetMissingValidators(ctx context.Context, req *types.QueryGetMis
 	return &types.QueryGetMissingValidatorsResponse{MissingValidators: missingValidators}, nil
 }
 
-// SetValidatorVersion saves a signalled version for a validator.
+// SetValidatorVersion saves a signalled version for a validator together with
+// the current block height, from which the expiry of the signal is counted,
+// and the block time. The previous signals of the validator are kept up to
+// types.MaxSignalHistory signals.
 func (k Keeper) SetValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(valAddress, VersionToBytes(version))
+	signals := []types.SignalRecord{{Version: version, Height: ctx.BlockHeight(), Time: ctx.BlockTime()}}
+	if value := store.Get(valAddress); value != nil {
+		signals = append(signals, SignalsFromBytes(value)...)
+	}
+	if len(signals) > types.MaxSignalHistory {
+		signals = signals[:types.MaxSignalHistory]
+	}
+	store.Set(valAddress, SignalsToBytes(signals))
 }
 
 // DeleteValidatorVersion deletes a signalled version for a validator.
@@ -241,17 +318,23 @@ func (k Keeper) DeleteValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddres
 
 // TallyVotingPower tallies the voting power for each version and returns true
 // and the version if any version has reached the quorum in voting power.
-// Returns false and 0 otherwise.
+// Returns false and 0 otherwise. Expired signals are deleted and don't count
+// towards the tally.
 func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64, error) {
 	versionToPower := make(map[uint64]int64)
+	params := k.GetParams(ctx)
 	store := ctx.KVStore(k.storeKey)
 	iterator := store.Iterator(types.FirstSignalKey, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+		if !types.IsSignalKey(iterator.Key()) {
 			continue
 		}
 		valAddress := sdk.ValAddress(iterator.Key())
+		if k.isExpired(ctx, params, iterator.Value()) {
+			k.DeleteValidatorVersion(ctx, valAddress)
+			continue
+		}
 		// check that the validator is still part of the bonded set
 		found := true
 		val, err := k.stakingKeeper.GetValidator(ctx, valAddress)
@@ -292,10 +375,20 @@ func (k Keeper) GetVotingPowerThreshold(ctx sdk.Context) (math.Int, error) {
 		return math.ZeroInt(), err
 	}
 
-	thresholdFraction := Threshold(ctx.BlockHeader().Version.App)
+	thresholdFraction := k.GetParams(ctx).Threshold
 	return thresholdFraction.MulInt(totalVotingPower).Ceil().TruncateInt(), nil
 }
 
+// GetUpgradeHeightDelay returns the number of blocks after a version reached
+// the threshold at which the network upgrades to it. It is the delay of the
+// params or the default delay of the chain ID if the params don't set one.
+func (k Keeper) GetUpgradeHeightDelay(ctx sdk.Context) int64 {
+	if delay := k.GetParams(ctx).UpgradeHeightDelay; delay > 0 {
+		return delay
+	}
+	return appconsts.GetUpgradeHeightDelay(ctx.HeaderInfo().ChainID)
+}
+
 // ShouldUpgrade returns whether the signalling mechanism has concluded that the
 // network is ready to upgrade and the upgrade. It returns false
 // and an empty upgrade if no version has reached quorum.
@@ -314,17 +407,42 @@ func (k *Keeper) ShouldUpgrade(ctx sdk.Context) (isQuorumVersion bool, upgrade t
 
 // ResetTally resets the tally after a version change. It iterates over the
 // store and deletes all versions. It also resets the quorumVersion and
-// upgradeHeight to 0.
+// upgradeHeight to 0. The params are kept.
 func (k *Keeper) ResetTally(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 	// delete the value in the upgrade key and all signals.
 	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), types.ParamsKey) {
+			continue
+		}
 		store.Delete(iterator.Key())
 	}
 }
 
+// deleteSignalsForVersion deletes the signals for the version.
+func (k Keeper) deleteSignalsForVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if types.IsSignalKey(iterator.Key()) && VersionFromBytes(iterator.Value()) == version {
+			store.Delete(iterator.Key())
+		}
+	}
+}
+
+// isExpired returns true if the signal wasn't renewed within the signal expiry
+// of the params.
+func (k Keeper) isExpired(ctx sdk.Context, params types.Params, signal []byte) bool {
+	if params.SignalExpiryBlocks == 0 {
+		return false
+	}
+	expiresAt := SignalHeightFromBytes(signal) + int64(params.SignalExpiryBlocks)
+	return ctx.BlockHeight() >= expiresAt
+}
+
 func VersionToBytes(version uint64) []byte {
 	return binary.BigEndian.AppendUint64(nil, version)
 }
@@ -333,6 +451,76 @@ func VersionFromBytes(version []byte) uint64 {
 	return binary.BigEndian.Uint64(version)
 }
 
+// signalRecordSize is the size of an encoded signal: the version, the height
+// and the block time in unix nanoseconds.
+const signalRecordSize = 24
+
+// SignalsToBytes encodes the signals of a validator, latest first. The latest
+// signal is encoded first so that VersionFromBytes and SignalHeightFromBytes
+// return its version and height.
+func SignalsToBytes(signals []types.SignalRecord) []byte {
+	bz := make([]byte, 0, len(signals)*signalRecordSize)
+	for _, signal := range signals {
+		bz = binary.BigEndian.AppendUint64(bz, signal.Version)
+		bz = binary.BigEndian.AppendUint64(bz, uint64(signal.Height))
+		bz = binary.BigEndian.AppendUint64(bz, uint64(signal.Time.UnixNano()))
+	}
+	return bz
+}
+
+// SignalsFromBytes decodes the signals of a validator, latest first. Signals
+// stored before the height and time were recorded are decoded as a single
+// signal without them.
+func SignalsFromBytes(bz []byte) []types.SignalRecord {
+	if len(bz)%signalRecordSize != 0 {
+		return []types.SignalRecord{{Version: VersionFromBytes(bz), Height: SignalHeightFromBytes(bz)}}
+	}
+	signals := make([]types.SignalRecord, 0, len(bz)/signalRecordSize)
+	for ; len(bz) > 0; bz = bz[signalRecordSize:] {
+		signals = append(signals, types.SignalRecord{
+			Version: binary.BigEndian.Uint64(bz),
+			Height:  int64(binary.BigEndian.Uint64(bz[8:])),
+			Time:    time.Unix(0, int64(binary.BigEndian.Uint64(bz[16:]))).UTC(),
+		})
+	}
+	return signals
+}
+
+// SignalHeightFromBytes returns the height at which the latest signal was
+// signalled. Signals stored before the height was recorded return 0.
+func SignalHeightFromBytes(signal []byte) int64 {
+	if len(signal) < 16 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(signal[8:]))
+}
+
+// GetParams returns the params of the signal module. The default params are
+// returned if the params are unset.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	// the params are encoded as an empty value if all of them are zero.
+	if !store.Has(types.ParamsKey) {
+		return types.DefaultParams()
+	}
+	bz := store.Get(types.ParamsKey)
+
+	var params types.Params
+	k.binaryCodec.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the params of the signal module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.binaryCodec.MustMarshal(&params))
+}
+
+// Params returns the params of the signal module.
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
 // GetUpgrade returns the current upgrade information.
 func (k Keeper) GetUpgrade(ctx context.Context, _ *types.QueryGetUpgradeRequest) (*types.QueryGetUpgradeResponse, error) {
 	upgrade, ok := k.getUpgrade(sdk.UnwrapSDKContext(ctx))
@@ -2,10 +2,12 @@ package signal_test
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
@@ -23,11 +25,16 @@ import (
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 func TestGetVotingPowerThreshold(t *testing.T) {
 	bigInt := big.NewInt(0)
 	bigInt.SetString("23058430092136939509", 10)
@@ -72,8 +79,9 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 			stakingKeeper := newMockStakingKeeper(tc.validators)
-			k := signal.NewKeeper(config.Codec, nil, stakingKeeper)
-			got, err := k.GetVotingPowerThreshold(sdk.Context{})
+			signalStore, ctx := setupStore(t)
+			k := signal.NewKeeper(config.Codec, signalStore, stakingKeeper, authority)
+			got, err := k.GetVotingPowerThreshold(ctx)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want, got, fmt.Sprintf("want %v, got %v", tc.want.String(), got.String()))
 		})
@@ -548,13 +556,349 @@ func TestTallyAfterTryUpgrade(t *testing.T) {
 	require.EqualValues(t, 120, res.TotalVotingPower)
 }
 
+func TestCancelUpgrade(t *testing.T) {
+	signalQuorum := func(t *testing.T, upgradeKeeper signal.Keeper, ctx sdk.Context) {
+		for _, valAddr := range testutil.ValAddrs[:4] {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 2})
+			require.NoError(t, err)
+		}
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+	}
+
+	t.Run("should cancel the pending upgrade and delete its signals", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		signalQuorum(t, upgradeKeeper, ctx)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+		require.NoError(t, err)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, types.EventTypeCancelUpgrade, events[0].Type)
+		appVersion, ok := events[0].GetAttribute(types.AttributeKeyAppVersion)
+		require.True(t, ok)
+		require.Equal(t, "2", appVersion.Value)
+
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.VotingPower)
+
+		// validators can signal again after the cancellation.
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 3})
+		require.NoError(t, err)
+	})
+
+	t.Run("should return an error if the authority is invalid", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		signalQuorum(t, upgradeKeeper, ctx)
+
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(testutil.ValAddrs[0].String()))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+	})
+
+	t.Run("should return an error if no upgrade is pending", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+		require.ErrorIs(t, err, types.ErrNoUpgradePending)
+	})
+
+	t.Run("should return an error if the upgrade height has been reached", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		signalQuorum(t, upgradeKeeper, ctx)
+
+		ctx = ctx.WithBlockHeight(appconsts.GetUpgradeHeightDelay(appconsts.TestChainID))
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+		require.ErrorIs(t, err, types.ErrUpgradeHeightReached)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+	})
+}
+
+func TestSignalExpiry(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	_, err := upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(authority, types.NewParams(10, types.DefaultThreshold, types.DefaultUpgradeHeightDelay)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(1)
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(5)
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[2].String(), Version: 2})
+	require.NoError(t, err)
+
+	tally := func(ctx sdk.Context) uint64 {
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		return res.VotingPower
+	}
+	require.EqualValues(t, 99, tally(ctx.WithBlockHeight(10)))
+	// the signal of the first validator expires at height 11.
+	require.EqualValues(t, 59, tally(ctx.WithBlockHeight(11)))
+
+	missing, err := upgradeKeeper.GetMissingValidators(ctx.WithBlockHeight(11), &types.QueryGetMissingValidatorsRequest{Version: 2})
+	require.NoError(t, err)
+	require.Contains(t, missing.MissingValidators, testutil.ValAddrs[0].String())
+	require.NotContains(t, missing.MissingValidators, testutil.ValAddrs[2].String())
+
+	// the expired signal doesn't count towards a quorum and is deleted.
+	_, err = upgradeKeeper.SignalVersion(ctx.WithBlockHeight(11), &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[3].String(), Version: 2})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.TryUpgrade(ctx.WithBlockHeight(11), &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+	require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+	require.EqualValues(t, 79, tally(ctx.WithBlockHeight(11)))
+
+	// a renewed signal counts again.
+	_, err = upgradeKeeper.SignalVersion(ctx.WithBlockHeight(12), &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(ctx.WithBlockHeight(12), &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[1].String(), Version: 2})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.TryUpgrade(ctx.WithBlockHeight(12), &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+	require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+
+	// the params survive the reset of the tally.
+	upgradeKeeper.ResetTally(ctx)
+	require.EqualValues(t, 10, upgradeKeeper.GetParams(ctx).SignalExpiryBlocks)
+}
+
+func TestMigrateSignals(t *testing.T) {
+	signalStore, ctx := setupStore(t)
+	stakingKeeper := newMockStakingKeeper(map[string]int64{
+		testutil.ValAddrs[0].String(): 40,
+		testutil.ValAddrs[1].String(): 60,
+	})
+	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, stakingKeeper, authority)
+
+	// a signal stored before its height was recorded.
+	ctx.KVStore(signalStore).Set(testutil.ValAddrs[0], signal.VersionToBytes(2))
+	// a signal stored after.
+	_, err := upgradeKeeper.SignalVersion(ctx.WithBlockHeight(5), &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[1].String(), Version: 2})
+	require.NoError(t, err)
+
+	upgradeHeight := int64(types.DefaultSignalExpiryBlocks + 10)
+	ctx = ctx.WithBlockHeight(upgradeHeight)
+	require.NoError(t, signal.NewMigrator(upgradeKeeper).MigrateSignals(ctx))
+
+	signals := signal.SignalsFromBytes(ctx.KVStore(signalStore).Get(testutil.ValAddrs[0]))
+	require.Len(t, signals, 1)
+	require.EqualValues(t, 2, signals[0].Version)
+	require.Equal(t, upgradeHeight, signals[0].Height)
+	signals = signal.SignalsFromBytes(ctx.KVStore(signalStore).Get(testutil.ValAddrs[1]))
+	require.Len(t, signals, 1)
+	require.EqualValues(t, 5, signals[0].Height)
+
+	// the migrated signal doesn't expire straight away.
+	res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 40, res.VotingPower)
+}
+
+func TestSignalExpiryDisabled(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	require.Equal(t, types.DefaultParams(), upgradeKeeper.GetParams(ctx))
+
+	_, err := upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(testutil.ValAddrs[0].String(), types.NewParams(0, types.DefaultThreshold, types.DefaultUpgradeHeightDelay)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(authority, types.NewParams(0, types.DefaultThreshold, types.DefaultUpgradeHeightDelay)))
+	require.NoError(t, err)
+
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2})
+	require.NoError(t, err)
+	res, err := upgradeKeeper.VersionTally(ctx.WithBlockHeight(math.MaxInt64), &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 40, res.VotingPower)
+}
+
+func TestSignalsFromBytes(t *testing.T) {
+	signals := []types.SignalRecord{
+		{Version: 3, Height: 100, Time: time.Unix(1700000000, 5).UTC()},
+		{Version: 2, Height: 50, Time: time.Unix(1600000000, 0).UTC()},
+	}
+	bz := signal.SignalsToBytes(signals)
+	require.Equal(t, signals, signal.SignalsFromBytes(bz))
+	require.EqualValues(t, 3, signal.VersionFromBytes(bz))
+	require.EqualValues(t, 100, signal.SignalHeightFromBytes(bz))
+
+	// signals stored without a height or time are decoded as a single signal.
+	legacy := signal.VersionToBytes(2)
+	require.Equal(t, []types.SignalRecord{{Version: 2}}, signal.SignalsFromBytes(legacy))
+	require.EqualValues(t, 0, signal.SignalHeightFromBytes(legacy))
+	legacy = binary.BigEndian.AppendUint64(legacy, 7)
+	require.Equal(t, []types.SignalRecord{{Version: 2, Height: 7}}, signal.SignalsFromBytes(legacy))
+}
+
+func TestSignalHistory(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	valAddr := testutil.ValAddrs[0].String()
+	blockTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	res, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: valAddr})
+	require.NoError(t, err)
+	require.Empty(t, res.Signals)
+
+	for i := 1; i <= types.MaxSignalHistory+2; i++ {
+		ctx := ctx.WithBlockHeight(int64(i)).WithBlockTime(blockTime.Add(time.Duration(i) * time.Minute))
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr, Version: uint64(i)})
+		require.NoError(t, err)
+	}
+
+	res, err = upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: valAddr})
+	require.NoError(t, err)
+	require.Len(t, res.Signals, types.MaxSignalHistory)
+	latest := types.MaxSignalHistory + 2
+	require.Equal(t, types.SignalRecord{Version: uint64(latest), Height: int64(latest), Time: blockTime.Add(time.Duration(latest) * time.Minute)}, res.Signals[0])
+	require.EqualValues(t, 3, res.Signals[types.MaxSignalHistory-1].Version)
+
+	_, err = upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: "invalid"})
+	require.Error(t, err)
+}
+
+func TestUpgradeReadiness(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	_, err := upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(authority, types.NewParams(100, types.DefaultThreshold, types.DefaultUpgradeHeightDelay)))
+	require.NoError(t, err)
+
+	signalAt := func(height int64, valAddr sdk.ValAddress, version uint64) {
+		_, err := upgradeKeeper.SignalVersion(ctx.WithBlockHeight(height), &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: version})
+		require.NoError(t, err)
+	}
+	signalAt(1, testutil.ValAddrs[0], 2)
+	signalAt(5, testutil.ValAddrs[1], 2)
+	signalAt(5, testutil.ValAddrs[3], 2)
+	signalAt(10, testutil.ValAddrs[2], 3)
+
+	res, err := upgradeKeeper.UpgradeReadiness(ctx.WithBlockHeight(20), &types.QueryUpgradeReadinessRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 20, res.Height)
+	require.EqualValues(t, 61, res.VotingPower)
+	require.EqualValues(t, 100, res.ThresholdPower)
+	require.EqualValues(t, 120, res.TotalVotingPower)
+	require.EqualValues(t, 39, res.MissingVotingPower)
+	require.False(t, res.Ready)
+
+	// the validators are ordered by voting power.
+	require.Len(t, res.Signalled, 3)
+	require.Equal(t, testutil.ValAddrs[0].String(), res.Signalled[0].ValidatorAddress)
+	require.EqualValues(t, 101, res.Signalled[0].ExpiryHeight)
+	require.Equal(t, testutil.ValAddrs[3].String(), res.Signalled[1].ValidatorAddress)
+	require.Equal(t, testutil.ValAddrs[1].String(), res.Signalled[2].ValidatorAddress)
+	require.Len(t, res.Missing, 1)
+	require.Equal(t, testutil.ValAddrs[2].String(), res.Missing[0].ValidatorAddress)
+	require.EqualValues(t, 59, res.Missing[0].VotingPower)
+	require.EqualValues(t, 3, res.Missing[0].Signal.Version)
+
+	require.Equal(t, []*types.ReadinessPoint{{Height: 101, VotingPower: 21}, {Height: 105, VotingPower: 0}}, res.Projection)
+
+	// the tally matches the VersionTally query.
+	tally, err := upgradeKeeper.VersionTally(ctx.WithBlockHeight(20), &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.Equal(t, tally.VotingPower, res.VotingPower)
+
+	// expired signals are missing.
+	res, err = upgradeKeeper.UpgradeReadiness(ctx.WithBlockHeight(101), &types.QueryUpgradeReadinessRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 21, res.VotingPower)
+	require.Len(t, res.Missing, 2)
+	require.Equal(t, testutil.ValAddrs[2].String(), res.Missing[0].ValidatorAddress)
+	require.Nil(t, res.Missing[1].Signal)
+
+	signalAt(30, testutil.ValAddrs[2], 2)
+	res, err = upgradeKeeper.UpgradeReadiness(ctx.WithBlockHeight(30), &types.QueryUpgradeReadinessRequest{Version: 2})
+	require.NoError(t, err)
+	require.True(t, res.Ready)
+	require.Zero(t, res.MissingVotingPower)
+}
+
 func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
+	signalStore, mockCtx := setupStore(t)
+	mockStakingKeeper := newMockStakingKeeper(
+		map[string]int64{
+			testutil.ValAddrs[0].String(): 40,
+			testutil.ValAddrs[1].String(): 1,
+			testutil.ValAddrs[2].String(): 59,
+			testutil.ValAddrs[3].String(): 20,
+		},
+	)
+	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper, authority)
+	return upgradeKeeper, mockCtx, mockStakingKeeper
+}
+
+func TestParams(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	res, err := upgradeKeeper.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res.Params)
+
+	t.Run("invalid params are rejected", func(t *testing.T) {
+		params := types.NewParams(0, sdkmath.LegacyNewDecWithPrec(5, 1), 0)
+		_, err := upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+
+	t.Run("the threshold of the params is required to upgrade", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		params := types.NewParams(0, sdkmath.LegacyOneDec(), 10)
+		_, err := upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+		require.NoError(t, err)
+
+		threshold, err := upgradeKeeper.GetVotingPowerThreshold(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 120, threshold.Int64())
+
+		// 100 of 120 voting power would reach the default threshold.
+		for _, valAddr := range testutil.ValAddrs[:3] {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 2})
+			require.NoError(t, err)
+		}
+		_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[3].String(), Version: 2})
+		require.NoError(t, err)
+		_, err = upgradeKeeper.TryUpgrade(ctx.WithHeaderInfo(header.Info{ChainID: appconsts.TestChainID, Height: 5}), &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+
+		// the upgrade height delay of the params overrides the delay of the chain ID.
+		res, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 15, res.Upgrade.UpgradeHeight)
+	})
+}
+
+func TestGenesis(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	// genesis states without params keep the default params.
+	require.NoError(t, upgradeKeeper.InitGenesis(ctx, types.GenesisState{}))
+	require.Equal(t, types.DefaultParams(), upgradeKeeper.GetParams(ctx))
+
+	params := types.NewParams(100, sdkmath.LegacyNewDecWithPrec(9, 1), 50)
+	require.NoError(t, upgradeKeeper.InitGenesis(ctx, types.GenesisState{Params: &params}))
+	require.Equal(t, params, upgradeKeeper.GetParams(ctx))
+	require.EqualValues(t, 50, upgradeKeeper.GetUpgradeHeightDelay(ctx))
+	require.Equal(t, &types.GenesisState{Params: &params}, upgradeKeeper.ExportGenesis(ctx))
+
+	params.UpgradeHeightDelay = types.MaxUpgradeHeightDelay + 1
+	require.Error(t, upgradeKeeper.InitGenesis(ctx, types.GenesisState{Params: &params}))
+}
+
+// setupStore returns the key of a signal store and a context with the store.
+func setupStore(t *testing.T) (*storetypes.KVStoreKey, sdk.Context) {
 	signalStore := storetypes.NewKVStoreKey(types.StoreKey)
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NoOpMetrics{})
 	stateStore.MountStoreWithDB(signalStore, storetypes.StoreTypeIAVL, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
-	mockCtx := sdk.NewContext(stateStore, tmproto.Header{
+	ctx := sdk.NewContext(stateStore, tmproto.Header{
 		Version: cmtversion.Consensus{
 			Block: 1,
 			App:   1,
@@ -562,17 +906,7 @@ func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 	}, false, log.NewNopLogger()).WithHeaderInfo(header.Info{
 		ChainID: appconsts.TestChainID, // TryUpgrade reads chainID from header info, not block header.
 	})
-	mockStakingKeeper := newMockStakingKeeper(
-		map[string]int64{
-			testutil.ValAddrs[0].String(): 40,
-			testutil.ValAddrs[1].String(): 1,
-			testutil.ValAddrs[2].String(): 59,
-			testutil.ValAddrs[3].String(): 20,
-		},
-	)
-	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper)
-	return upgradeKeeper, mockCtx, mockStakingKeeper
+	return signalStore, ctx
 }
 
 var _ signal.StakingKeeper = (*mockStakingKeeper)(nil)
@@ -0,0 +1,43 @@
+package signal
+
+import (
+	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is responsible for handling migrations related to the signal module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator creates a new Migrator instance using the provided Keeper for
+// handling migrations in the signal module.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateSignals re-encodes the signals stored before their height and block
+// time were recorded as signals made at the current height and block time.
+// They would otherwise be decoded with height 0 and expire straight away.
+func (m Migrator) MigrateSignals(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+
+	migrated := make(map[string][]byte)
+	for ; iterator.Valid(); iterator.Next() {
+		if !types.IsSignalKey(iterator.Key()) || len(iterator.Value())%signalRecordSize == 0 {
+			continue
+		}
+		signal := types.SignalRecord{
+			Version: VersionFromBytes(iterator.Value()),
+			Height:  ctx.BlockHeight(),
+			Time:    ctx.BlockTime(),
+		}
+		migrated[string(iterator.Key())] = SignalsToBytes([]types.SignalRecord{signal})
+	}
+	for key, value := range migrated {
+		store.Set([]byte(key), value)
+	}
+	return nil
+}
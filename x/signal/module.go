@@ -3,6 +3,7 @@ package signal
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
 	"github.com/celestiaorg/celestia-app/v6/x/signal/cli"
@@ -10,23 +11,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 )
 
 const (
 	// consensusVersion defines the current x/signal module consensus version.
-	consensusVersion uint64 = 3
+	consensusVersion uint64 = 4
 )
 
 var (
 	_ module.AppModuleBasic   = AppModule{}
 	_ module.HasGenesisBasics = AppModule{}
+	_ module.HasGenesis       = AppModule{}
 
-	_ appmodule.AppModule   = AppModule{}
-	_ appmodule.HasServices = AppModule{}
+	_ module.HasServices = AppModule{}
+
+	_ appmodule.AppModule = AppModule{}
 )
 
 // AppModule implements the AppModule interface for the blobstream module.
@@ -75,22 +78,48 @@ func (AppModule) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()
 }
 
-// DefaultGenesis returns the blob module's default genesis state.
-func (am AppModule) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
-	return []byte("{}")
+// DefaultGenesis returns the signal module's default genesis state.
+func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
-// ValidateGenesis is always successful, as we ignore the value.
-func (am AppModule) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, _ json.RawMessage) error {
-	return nil
+// ValidateGenesis performs genesis state validation for the signal module.
+func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return genState.Validate()
+}
+
+// InitGenesis performs the signal module's genesis initialization.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(gs, &genState); err != nil {
+		panic(fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err))
+	}
+
+	if err := am.keeper.InitGenesis(ctx, genState); err != nil {
+		panic(fmt.Errorf("failed to initialize %s genesis state: %w", types.ModuleName, err))
+	}
+}
+
+// ExportGenesis returns the signal module's exported genesis state as raw JSON bytes.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
 // RegisterServices registers a GRPC query service to respond to the
-// module-specific GRPC queries.
-func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
-	types.RegisterMsgServer(registrar, &am.keeper)
-	types.RegisterQueryServer(registrar, &am.keeper)
-	return nil
+// module-specific GRPC queries and the module's migrations.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), &am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), &am.keeper)
+
+	m := NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.MigrateSignals); err != nil {
+		panic(err)
+	}
 }
 
 // ConsensusVersion returns the consensus version of this module.
@@ -0,0 +1,149 @@
+package signal
+
+import (
+	"context"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SignalHistory returns the recent signals of a validator, latest first.
+func (k Keeper) SignalHistory(ctx context.Context, req *types.QuerySignalHistoryRequest) (*types.QuerySignalHistoryResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	value := sdkCtx.KVStore(k.storeKey).Get(valAddr)
+	if value == nil {
+		return &types.QuerySignalHistoryResponse{}, nil
+	}
+	return &types.QuerySignalHistoryResponse{Signals: SignalsFromBytes(value)}, nil
+}
+
+// UpgradeReadiness returns how close the network is to the threshold for a
+// particular version. It lists the bonded validators that signalled and that
+// are missing, weighted by their voting power, and projects the voting power
+// for the version as the signals expire.
+func (k Keeper) UpgradeReadiness(ctx context.Context, req *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(sdkCtx)
+	store := sdkCtx.KVStore(k.storeKey)
+
+	signalled := make([]*types.ValidatorReadiness, 0)
+	missing := make([]*types.ValidatorReadiness, 0)
+	votingPower := uint64(0)
+	var iterErr error
+	err := k.stakingKeeper.IterateValidators(sdkCtx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		power, err := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddr)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		// only validators with voting power count towards the tally.
+		if power <= 0 {
+			return false
+		}
+
+		readiness := &types.ValidatorReadiness{
+			ValidatorAddress: validator.GetOperator(),
+			Moniker:          validator.GetMoniker(),
+			VotingPower:      power,
+		}
+		value := store.Get(valAddr)
+		if value != nil && !k.isExpired(sdkCtx, params, value) {
+			latest := SignalsFromBytes(value)[0]
+			readiness.Signal = &latest
+			if params.SignalExpiryBlocks > 0 {
+				readiness.ExpiryHeight = latest.Height + int64(params.SignalExpiryBlocks)
+			}
+		}
+
+		if readiness.Signal != nil && readiness.Signal.Version == req.Version {
+			signalled = append(signalled, readiness)
+			votingPower += uint64(power)
+		} else {
+			missing = append(missing, readiness)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	totalVotingPower, err := k.stakingKeeper.GetLastTotalPower(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := k.GetVotingPowerThreshold(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	missingVotingPower := uint64(0)
+	if votingPower < threshold.Uint64() {
+		missingVotingPower = threshold.Uint64() - votingPower
+	}
+
+	sortByVotingPower(signalled)
+	sortByVotingPower(missing)
+
+	return &types.QueryUpgradeReadinessResponse{
+		Height:             sdkCtx.BlockHeight(),
+		VotingPower:        votingPower,
+		ThresholdPower:     threshold.Uint64(),
+		TotalVotingPower:   totalVotingPower.Uint64(),
+		MissingVotingPower: missingVotingPower,
+		Ready:              votingPower >= threshold.Uint64(),
+		Signalled:          signalled,
+		Missing:            missing,
+		Projection:         projectReadiness(signalled, votingPower),
+	}, nil
+}
+
+// sortByVotingPower sorts validators by voting power descending and by
+// address for equal voting power.
+func sortByVotingPower(validators []*types.ValidatorReadiness) {
+	sort.SliceStable(validators, func(i, j int) bool {
+		if validators[i].VotingPower != validators[j].VotingPower {
+			return validators[i].VotingPower > validators[j].VotingPower
+		}
+		return validators[i].ValidatorAddress < validators[j].ValidatorAddress
+	})
+}
+
+// projectReadiness returns the voting power that remains after each expiry
+// height of the signalled validators if no signal is renewed.
+func projectReadiness(signalled []*types.ValidatorReadiness, votingPower uint64) []*types.ReadinessPoint {
+	expiring := make([]*types.ValidatorReadiness, 0, len(signalled))
+	for _, validator := range signalled {
+		if validator.ExpiryHeight > 0 {
+			expiring = append(expiring, validator)
+		}
+	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].ExpiryHeight < expiring[j].ExpiryHeight
+	})
+
+	projection := make([]*types.ReadinessPoint, 0)
+	for _, validator := range expiring {
+		votingPower -= uint64(validator.VotingPower)
+		if n := len(projection); n > 0 && projection[n-1].Height == validator.ExpiryHeight {
+			projection[n-1].VotingPower = votingPower
+			continue
+		}
+		projection = append(projection, &types.ReadinessPoint{Height: validator.ExpiryHeight, VotingPower: votingPower})
+	}
+	return projection
+}
@@ -0,0 +1,16 @@
+package types
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+	return &GenesisState{Params: &params}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if gs.Params == nil {
+		return nil
+	}
+	return gs.Params.Validate()
+}
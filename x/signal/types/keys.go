@@ -5,8 +5,22 @@ var (
 	// pending.
 	UpgradeKey = []byte{0x00}
 
+	// ParamsKey is the key in the signal store used to persist the params.
+	ParamsKey = []byte{0x01}
+
 	// FirstSignalKey is used as a divider to separate the UpgradeKey from all
 	// the keys associated with signals from validators. In practice, this key
 	// isn't expected to be set or retrieved.
 	FirstSignalKey = []byte{0x000}
 )
+
+// MaxSignalHistory is the number of recent signals that are stored per
+// validator.
+const MaxSignalHistory = 10
+
+// IsSignalKey returns true if the key is the key of a signal from a
+// validator. Signals are stored under the validator address whereas the other
+// keys of the signal store are a single byte.
+func IsSignalKey(key []byte) bool {
+	return len(key) > 1
+}
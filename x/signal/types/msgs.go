@@ -12,17 +12,26 @@ const (
 
 	URLMsgSignalVersion = "/celestia.signal.v1.Msg/SignalVersion"
 	URLMsgTryUpgrade    = "/celestia.signal.v1.Msg/TryUpgrade"
+	URLMsgCancelUpgrade = "/celestia.signal.v1.Msg/CancelUpgrade"
+	URLMsgUpdateParams  = "/celestia.signal.v1.Msg/UpdateParams"
 
 	EventTypeTryUpgrade    = "signal_try_upgrade"
 	EventTypeSignalVersion = "signal_version"
+	EventTypeCancelUpgrade = "signal_cancel_upgrade"
+	EventTypeUpdateParams  = "signal_update_params"
 
 	AttributeKeyValidatorAddress = "validator_address"
 	AttributeKeySigner           = "signer"
+	AttributeKeyAuthority        = "authority"
+	AttributeKeyAppVersion       = "app_version"
+	AttributeKeyUpgradeHeight    = "upgrade_height"
 )
 
 var (
 	_ sdk.Msg = &MsgSignalVersion{}
 	_ sdk.Msg = &MsgTryUpgrade{}
+	_ sdk.Msg = &MsgCancelUpgrade{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -49,3 +58,28 @@ func (msg *MsgTryUpgrade) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
 	return err
 }
+
+func NewMsgCancelUpgrade(authority string) *MsgCancelUpgrade {
+	return &MsgCancelUpgrade{
+		Authority: authority,
+	}
+}
+
+func (msg *MsgCancelUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	return msg.Params.Validate()
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// DefaultSignalExpiryBlocks is the default number of blocks after which a
+	// signal expires. It is approximately two weeks with 6 second blocks.
+	DefaultSignalExpiryBlocks uint64 = 201_600
+
+	// DefaultUpgradeHeightDelay is the default upgrade height delay. Zero uses
+	// the delay of the chain ID, see appconsts.GetUpgradeHeightDelay.
+	DefaultUpgradeHeightDelay int64 = 0
+
+	// MaxUpgradeHeightDelay is the maximum upgrade height delay. It is
+	// approximately four weeks with 6 second blocks.
+	MaxUpgradeHeightDelay int64 = 403_200
+)
+
+var (
+	// DefaultThreshold is 5/6 or approximately 83.33%. It is the middle point
+	// between 2/3 and 3/3 providing 1/6 fault tolerance to halting the network
+	// during an upgrade period.
+	DefaultThreshold = math.LegacyNewDec(5).Quo(math.LegacyNewDec(6))
+
+	// MinThreshold is the exclusive lower bound of the threshold. A threshold
+	// of 2/3 or less would allow an upgrade that more than 1/3 of the voting
+	// power isn't ready for, which halts the network.
+	MinThreshold = math.LegacyNewDec(2).Quo(math.LegacyNewDec(3))
+)
+
+// NewParams creates a new Params instance.
+func NewParams(signalExpiryBlocks uint64, threshold math.LegacyDec, upgradeHeightDelay int64) Params {
+	return Params{
+		SignalExpiryBlocks: signalExpiryBlocks,
+		Threshold:          threshold,
+		UpgradeHeightDelay: upgradeHeightDelay,
+	}
+}
+
+// DefaultParams returns the default parameters for the module.
+func DefaultParams() Params {
+	return NewParams(DefaultSignalExpiryBlocks, DefaultThreshold, DefaultUpgradeHeightDelay)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if p.Threshold.IsNil() {
+		return fmt.Errorf("threshold must be set")
+	}
+	if p.Threshold.LTE(MinThreshold) || p.Threshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("threshold %s must be in the range (%s, 1]", p.Threshold, MinThreshold)
+	}
+	if p.UpgradeHeightDelay < 0 || p.UpgradeHeightDelay > MaxUpgradeHeightDelay {
+		return fmt.Errorf("upgrade height delay %d must be in the range [0, %d]", p.UpgradeHeightDelay, MaxUpgradeHeightDelay)
+	}
+	return nil
+}
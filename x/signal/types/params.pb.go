@@ -0,0 +1,401 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/signal/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the signal module.
+type Params struct {
+	// signal_expiry_blocks is the number of blocks after which a version signal
+	// that was not renewed expires and no longer counts towards the tally.
+	// Zero disables the expiry.
+	SignalExpiryBlocks uint64 `protobuf:"varint,1,opt,name=signal_expiry_blocks,json=signalExpiryBlocks,proto3" json:"signal_expiry_blocks,omitempty"`
+	// threshold is the fraction, in the range (2/3, 1], of the total voting
+	// power that must signal for a version to upgrade to it.
+	Threshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"threshold"`
+	// upgrade_height_delay is the number of blocks after a version reached the
+	// threshold at which the network upgrades to it. Zero uses the default
+	// delay of the chain ID.
+	UpgradeHeightDelay int64 `protobuf:"varint,3,opt,name=upgrade_height_delay,json=upgradeHeightDelay,proto3" json:"upgrade_height_delay,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9af0f852a09db350, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSignalExpiryBlocks() uint64 {
+	if m != nil {
+		return m.SignalExpiryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetUpgradeHeightDelay() int64 {
+	if m != nil {
+		return m.UpgradeHeightDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celestia.signal.v1.Params")
+}
+
+func init() { proto.RegisterFile("celestia/signal/v1/params.proto", fileDescriptor_9af0f852a09db350) }
+
+var fileDescriptor_9af0f852a09db350 = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0xd0, 0xb1, 0x6a, 0x02, 0x31,
+	0x18, 0x07, 0xf0, 0x4b, 0x2d, 0x82, 0x37, 0x06, 0x07, 0x6b, 0x21, 0x4a, 0x27, 0x17, 0x93, 0xda,
+	0xbe, 0x81, 0x58, 0xe8, 0x20, 0xb4, 0x38, 0x76, 0x39, 0x62, 0x2e, 0x24, 0xc1, 0xbb, 0x26, 0x24,
+	0x51, 0xbc, 0xb7, 0xe8, 0xc3, 0x74, 0xed, 0xee, 0x28, 0x9d, 0x4a, 0x07, 0x29, 0xfa, 0x22, 0xe5,
+	0x12, 0xaf, 0xdd, 0xbe, 0xf0, 0xfb, 0xf8, 0xe7, 0xe3, 0x9f, 0x0e, 0x18, 0x2f, 0xb8, 0xf3, 0x8a,
+	0x12, 0xa7, 0xc4, 0x2b, 0x2d, 0xc8, 0x66, 0x42, 0x0c, 0xb5, 0xb4, 0x74, 0xd8, 0x58, 0xed, 0x35,
+	0x84, 0xcd, 0x02, 0x8e, 0x0b, 0x78, 0x33, 0xe9, 0x77, 0x85, 0x16, 0x3a, 0x30, 0xa9, 0xa7, 0xb8,
+	0xd9, 0xbf, 0x62, 0xda, 0x95, 0xda, 0x65, 0x11, 0xe2, 0x23, 0xd2, 0xcd, 0x07, 0x48, 0xdb, 0xcf,
+	0x21, 0x15, 0xde, 0xa6, 0xdd, 0x18, 0x94, 0xf1, 0xad, 0x51, 0xb6, 0xca, 0x96, 0x85, 0x66, 0x2b,
+	0xd7, 0x03, 0x43, 0x30, 0xba, 0x5c, 0xc0, 0x68, 0x0f, 0x81, 0xa6, 0x41, 0xe0, 0x53, 0xda, 0xf1,
+	0xd2, 0x72, 0x27, 0x75, 0x91, 0xf7, 0x2e, 0x86, 0x60, 0xd4, 0x99, 0x4e, 0x76, 0x87, 0x41, 0xf2,
+	0x7d, 0x18, 0x5c, 0xc7, 0x5f, 0x5c, 0xbe, 0xc2, 0x4a, 0x93, 0x92, 0x7a, 0x89, 0xe7, 0x5c, 0x50,
+	0x56, 0xcd, 0x38, 0xfb, 0x7c, 0x1f, 0xa7, 0xe7, 0x23, 0x66, 0x9c, 0x2d, 0xfe, 0x33, 0xea, 0x13,
+	0xd6, 0x46, 0x58, 0x9a, 0xf3, 0x4c, 0x72, 0x25, 0xa4, 0xcf, 0x72, 0x5e, 0xd0, 0xaa, 0xd7, 0x1a,
+	0x82, 0x51, 0x6b, 0x01, 0xcf, 0xf6, 0x18, 0x68, 0x56, 0xcb, 0x74, 0xbe, 0x3b, 0x22, 0xb0, 0x3f,
+	0x22, 0xf0, 0x73, 0x44, 0xe0, 0xed, 0x84, 0x92, 0xfd, 0x09, 0x25, 0x5f, 0x27, 0x94, 0xbc, 0xdc,
+	0x09, 0xe5, 0xe5, 0x7a, 0x89, 0x99, 0x2e, 0x49, 0xd3, 0x94, 0xb6, 0xe2, 0x6f, 0x1e, 0x53, 0x63,
+	0xc8, 0xb6, 0x29, 0xd7, 0x57, 0x86, 0xbb, 0x65, 0x3b, 0x94, 0x72, 0xff, 0x1b, 0x00, 0x00, 0xff,
+	0xff, 0x73, 0xc0, 0x10, 0x54, 0x7c, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeHeightDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UpgradeHeightDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignalExpiryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignalExpiryBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignalExpiryBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SignalExpiryBlocks))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.UpgradeHeightDelay != 0 {
+		n += 1 + sovParams(uint64(m.UpgradeHeightDelay))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalExpiryBlocks", wireType)
+			}
+			m.SignalExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeHeightDelay", wireType)
+			}
+			m.UpgradeHeightDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeHeightDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	tests := []struct {
+		name    string
+		modify  func(*types.Params)
+		wantErr string
+	}{
+		{"threshold of 1", func(p *types.Params) { p.Threshold = math.LegacyOneDec() }, ""},
+		{"threshold just above 2/3", func(p *types.Params) { p.Threshold = math.LegacyNewDecWithPrec(667, 3) }, ""},
+		{"threshold of 2/3", func(p *types.Params) { p.Threshold = types.MinThreshold }, "must be in the range"},
+		{"threshold above 1", func(p *types.Params) { p.Threshold = math.LegacyNewDecWithPrec(1001, 3) }, "must be in the range"},
+		{"unset threshold", func(p *types.Params) { p.Threshold = math.LegacyDec{} }, "threshold must be set"},
+		{"upgrade height delay of 1", func(p *types.Params) { p.UpgradeHeightDelay = 1 }, ""},
+		{"max upgrade height delay", func(p *types.Params) { p.UpgradeHeightDelay = types.MaxUpgradeHeightDelay }, ""},
+		{"negative upgrade height delay", func(p *types.Params) { p.UpgradeHeightDelay = -1 }, "must be in the range"},
+		{"upgrade height delay above max", func(p *types.Params) { p.UpgradeHeightDelay = types.MaxUpgradeHeightDelay + 1 }, "must be in the range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tt.modify(&params)
+			err := params.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesis().Validate())
+	// genesis states without params use the default params.
+	require.NoError(t, types.GenesisState{}.Validate())
+
+	params := types.DefaultParams()
+	params.UpgradeHeightDelay = -1
+	require.Error(t, types.GenesisState{Params: &params}.Validate())
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -28,6 +30,88 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is the request type for the Params query.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Params query.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryVersionTallyRequest is the request type for the VersionTally query.
 type QueryVersionTallyRequest struct {
 	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -37,7 +121,7 @@ func (m *QueryVersionTallyRequest) Reset()         { *m = QueryVersionTallyReque
 func (m *QueryVersionTallyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionTallyRequest) ProtoMessage()    {}
 func (*QueryVersionTallyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{0}
+	return fileDescriptor_7af24246367e432c, []int{2}
 }
 func (m *QueryVersionTallyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -84,7 +168,7 @@ func (m *QueryVersionTallyResponse) Reset()         { *m = QueryVersionTallyResp
 func (m *QueryVersionTallyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionTallyResponse) ProtoMessage()    {}
 func (*QueryVersionTallyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{1}
+	return fileDescriptor_7af24246367e432c, []int{3}
 }
 func (m *QueryVersionTallyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -142,7 +226,7 @@ func (m *QueryGetUpgradeRequest) Reset()         { *m = QueryGetUpgradeRequest{}
 func (m *QueryGetUpgradeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetUpgradeRequest) ProtoMessage()    {}
 func (*QueryGetUpgradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{2}
+	return fileDescriptor_7af24246367e432c, []int{4}
 }
 func (m *QueryGetUpgradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +264,7 @@ func (m *QueryGetUpgradeResponse) Reset()         { *m = QueryGetUpgradeResponse
 func (m *QueryGetUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetUpgradeResponse) ProtoMessage()    {}
 func (*QueryGetUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{3}
+	return fileDescriptor_7af24246367e432c, []int{5}
 }
 func (m *QueryGetUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,7 +309,7 @@ func (m *QueryGetMissingValidatorsRequest) Reset()         { *m = QueryGetMissin
 func (m *QueryGetMissingValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMissingValidatorsRequest) ProtoMessage()    {}
 func (*QueryGetMissingValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{4}
+	return fileDescriptor_7af24246367e432c, []int{6}
 }
 func (m *QueryGetMissingValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,7 +355,7 @@ func (m *QueryGetMissingValidatorsResponse) Reset()         { *m = QueryGetMissi
 func (m *QueryGetMissingValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMissingValidatorsResponse) ProtoMessage()    {}
 func (*QueryGetMissingValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{5}
+	return fileDescriptor_7af24246367e432c, []int{7}
 }
 func (m *QueryGetMissingValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,50 +391,336 @@ func (m *QueryGetMissingValidatorsResponse) GetMissingValidators() []string {
 	return nil
 }
 
+// QuerySignalHistoryRequest is the request type for the SignalHistory query.
+type QuerySignalHistoryRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QuerySignalHistoryRequest) Reset()         { *m = QuerySignalHistoryRequest{} }
+func (m *QuerySignalHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalHistoryRequest) ProtoMessage()    {}
+func (*QuerySignalHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{8}
+}
+func (m *QuerySignalHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalHistoryRequest.Merge(m, src)
+}
+func (m *QuerySignalHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySignalHistoryRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QuerySignalHistoryResponse is the response type for the SignalHistory query.
+type QuerySignalHistoryResponse struct {
+	// signals are the recent signals of the validator, latest first. They
+	// include expired signals and are cleared after an upgrade.
+	Signals []SignalRecord `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
+}
+
+func (m *QuerySignalHistoryResponse) Reset()         { *m = QuerySignalHistoryResponse{} }
+func (m *QuerySignalHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalHistoryResponse) ProtoMessage()    {}
+func (*QuerySignalHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{9}
+}
+func (m *QuerySignalHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalHistoryResponse.Merge(m, src)
+}
+func (m *QuerySignalHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySignalHistoryResponse) GetSignals() []SignalRecord {
+	if m != nil {
+		return m.Signals
+	}
+	return nil
+}
+
+// QueryUpgradeReadinessRequest is the request type for the UpgradeReadiness query.
+type QueryUpgradeReadinessRequest struct {
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryUpgradeReadinessRequest) Reset()         { *m = QueryUpgradeReadinessRequest{} }
+func (m *QueryUpgradeReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessRequest) ProtoMessage()    {}
+func (*QueryUpgradeReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{10}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// QueryUpgradeReadinessResponse is the response type for the UpgradeReadiness query.
+type QueryUpgradeReadinessResponse struct {
+	// height is the height of the state that was queried.
+	Height           int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	VotingPower      uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	ThresholdPower   uint64 `protobuf:"varint,3,opt,name=threshold_power,json=thresholdPower,proto3" json:"threshold_power,omitempty"`
+	TotalVotingPower uint64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// missing_voting_power is the voting power that still needs to signal for
+	// the version to reach the threshold.
+	MissingVotingPower uint64 `protobuf:"varint,5,opt,name=missing_voting_power,json=missingVotingPower,proto3" json:"missing_voting_power,omitempty"`
+	// ready is true if the version has reached the threshold.
+	Ready bool `protobuf:"varint,6,opt,name=ready,proto3" json:"ready,omitempty"`
+	// signalled are the validators that signalled for the version, ordered by
+	// voting power descending.
+	Signalled []*ValidatorReadiness `protobuf:"bytes,7,rep,name=signalled,proto3" json:"signalled,omitempty"`
+	// missing are the validators that haven't signalled for the version,
+	// ordered by voting power descending.
+	Missing []*ValidatorReadiness `protobuf:"bytes,8,rep,name=missing,proto3" json:"missing,omitempty"`
+	// projection is the voting power for the version after each upcoming
+	// signal expiry if no signal is renewed.
+	Projection []*ReadinessPoint `protobuf:"bytes,9,rep,name=projection,proto3" json:"projection,omitempty"`
+}
+
+func (m *QueryUpgradeReadinessResponse) Reset()         { *m = QueryUpgradeReadinessResponse{} }
+func (m *QueryUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessResponse) ProtoMessage()    {}
+func (*QueryUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{11}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryUpgradeReadinessResponse) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *QueryUpgradeReadinessResponse) GetThresholdPower() uint64 {
+	if m != nil {
+		return m.ThresholdPower
+	}
+	return 0
+}
+
+func (m *QueryUpgradeReadinessResponse) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *QueryUpgradeReadinessResponse) GetMissingVotingPower() uint64 {
+	if m != nil {
+		return m.MissingVotingPower
+	}
+	return 0
+}
+
+func (m *QueryUpgradeReadinessResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *QueryUpgradeReadinessResponse) GetSignalled() []*ValidatorReadiness {
+	if m != nil {
+		return m.Signalled
+	}
+	return nil
+}
+
+func (m *QueryUpgradeReadinessResponse) GetMissing() []*ValidatorReadiness {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+func (m *QueryUpgradeReadinessResponse) GetProjection() []*ReadinessPoint {
+	if m != nil {
+		return m.Projection
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.signal.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.signal.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryVersionTallyRequest)(nil), "celestia.signal.v1.QueryVersionTallyRequest")
 	proto.RegisterType((*QueryVersionTallyResponse)(nil), "celestia.signal.v1.QueryVersionTallyResponse")
 	proto.RegisterType((*QueryGetUpgradeRequest)(nil), "celestia.signal.v1.QueryGetUpgradeRequest")
 	proto.RegisterType((*QueryGetUpgradeResponse)(nil), "celestia.signal.v1.QueryGetUpgradeResponse")
 	proto.RegisterType((*QueryGetMissingValidatorsRequest)(nil), "celestia.signal.v1.QueryGetMissingValidatorsRequest")
 	proto.RegisterType((*QueryGetMissingValidatorsResponse)(nil), "celestia.signal.v1.QueryGetMissingValidatorsResponse")
+	proto.RegisterType((*QuerySignalHistoryRequest)(nil), "celestia.signal.v1.QuerySignalHistoryRequest")
+	proto.RegisterType((*QuerySignalHistoryResponse)(nil), "celestia.signal.v1.QuerySignalHistoryResponse")
+	proto.RegisterType((*QueryUpgradeReadinessRequest)(nil), "celestia.signal.v1.QueryUpgradeReadinessRequest")
+	proto.RegisterType((*QueryUpgradeReadinessResponse)(nil), "celestia.signal.v1.QueryUpgradeReadinessResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/query.proto", fileDescriptor_7af24246367e432c) }
 
 var fileDescriptor_7af24246367e432c = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x36, 0x40, 0xc5, 0xb4, 0xe2, 0x63, 0x54, 0x81, 0x31, 0x91, 0x95, 0x5a, 0x48, 0x54,
-	0xd0, 0xd8, 0x6a, 0x68, 0x6f, 0x9c, 0xb8, 0x70, 0x01, 0xa9, 0x44, 0x90, 0x03, 0x97, 0x68, 0xdb,
-	0xac, 0x9c, 0x95, 0x1c, 0xaf, 0xbb, 0xbb, 0x31, 0x44, 0x88, 0x03, 0xfc, 0x01, 0x90, 0x10, 0x3f,
-	0x82, 0x7f, 0x02, 0xb7, 0x4a, 0x5c, 0x38, 0xa2, 0x84, 0x1f, 0x82, 0xb2, 0xbb, 0x4e, 0x02, 0x49,
-	0x4a, 0xb9, 0x25, 0x33, 0xef, 0xbd, 0x79, 0xf3, 0x66, 0x0d, 0xc1, 0x31, 0x4b, 0x99, 0xd2, 0x9c,
-	0xc6, 0x8a, 0x27, 0x19, 0x4d, 0xe3, 0x62, 0x2f, 0x3e, 0x19, 0x30, 0x39, 0x8c, 0x72, 0x29, 0xb4,
-	0x40, 0x2c, 0xfb, 0x91, 0xed, 0x47, 0xc5, 0x9e, 0x5f, 0x4b, 0x84, 0x48, 0x52, 0x16, 0xd3, 0x9c,
-	0xc7, 0x34, 0xcb, 0x84, 0xa6, 0x9a, 0x8b, 0x4c, 0x59, 0x86, 0x5f, 0x5f, 0xa2, 0x38, 0xc8, 0x13,
-	0x49, 0xbb, 0xcc, 0x22, 0xc2, 0x7d, 0xf0, 0x9e, 0x4d, 0x46, 0xb4, 0x99, 0x54, 0x5c, 0x64, 0xcf,
-	0x69, 0x9a, 0x0e, 0x5b, 0xec, 0x64, 0xc0, 0x94, 0x46, 0x0f, 0xd6, 0x0b, 0x5b, 0xf6, 0x48, 0x9d,
-	0xec, 0x5c, 0x68, 0x95, 0x7f, 0xc3, 0xcf, 0x04, 0x6e, 0x2d, 0xa1, 0xa9, 0x5c, 0x64, 0x8a, 0xe1,
-	0x36, 0x6c, 0x16, 0x42, 0xf3, 0x2c, 0xe9, 0xe4, 0xe2, 0x15, 0x93, 0x8e, 0xbc, 0x61, 0x6b, 0x87,
-	0x93, 0x12, 0xde, 0x85, 0xab, 0xba, 0x27, 0x99, 0xea, 0x89, 0xb4, 0xeb, 0x50, 0x6b, 0x06, 0x75,
-	0x65, 0x5a, 0xb6, 0xc0, 0x5d, 0x40, 0x2d, 0x34, 0x4d, 0x3b, 0x7f, 0x28, 0x56, 0x0d, 0xf6, 0x9a,
-	0xe9, 0xb4, 0x67, 0xb2, 0xa1, 0x07, 0x37, 0x8c, 0xad, 0xc7, 0x4c, 0xbf, 0xb0, 0x6b, 0xba, 0x5d,
-	0xc2, 0x43, 0xb8, 0xb9, 0xd0, 0x71, 0x76, 0x0f, 0x60, 0xdd, 0x65, 0x62, 0x9c, 0x6e, 0x34, 0x6f,
-	0x47, 0x8b, 0x41, 0x47, 0x25, 0xab, 0xc4, 0x86, 0x0f, 0xa1, 0x5e, 0x2a, 0x3e, 0xe5, 0x4a, 0xf1,
-	0x2c, 0x69, 0xd3, 0x94, 0x77, 0xa9, 0x16, 0x52, 0xfd, 0x3b, 0xc1, 0x16, 0x6c, 0x9f, 0xc1, 0x76,
-	0xce, 0x1a, 0x80, 0x7d, 0xdb, 0xec, 0x14, 0xd3, 0xae, 0x47, 0xea, 0xd5, 0x9d, 0xcb, 0xad, 0xeb,
-	0xfd, 0xbf, 0x69, 0xcd, 0x6f, 0x55, 0xb8, 0x68, 0x44, 0xf1, 0x03, 0x81, 0xcd, 0xf9, 0xd3, 0xe0,
-	0xee, 0xb2, 0x95, 0x56, 0x1d, 0xde, 0x6f, 0x9c, 0x13, 0x6d, 0x6d, 0x86, 0xe1, 0xfb, 0xef, 0xbf,
-	0x3e, 0xad, 0xd5, 0xd0, 0x9f, 0x7b, 0x65, 0x7a, 0x82, 0x88, 0xdf, 0xb8, 0x75, 0xdf, 0xe2, 0x3b,
-	0x02, 0x30, 0xcb, 0x1e, 0xef, 0xad, 0x9c, 0xb0, 0x70, 0x3a, 0xff, 0xfe, 0xb9, 0xb0, 0xce, 0x8b,
-	0x6f, 0xbc, 0x6c, 0x21, 0x2e, 0xbe, 0x78, 0xfc, 0x42, 0x60, 0x6b, 0x59, 0xde, 0xb8, 0x7f, 0xd6,
-	0x84, 0x55, 0xc7, 0xf5, 0x0f, 0xfe, 0x93, 0xe5, 0x1c, 0xde, 0x31, 0x0e, 0x03, 0xac, 0xcd, 0x39,
-	0x74, 0xb7, 0x9c, 0xe5, 0xf5, 0xe8, 0xc9, 0xd7, 0x51, 0x40, 0x4e, 0x47, 0x01, 0xf9, 0x39, 0x0a,
-	0xc8, 0xc7, 0x71, 0x50, 0x39, 0x1d, 0x07, 0x95, 0x1f, 0xe3, 0xa0, 0xf2, 0xb2, 0x99, 0x70, 0xdd,
-	0x1b, 0x1c, 0x45, 0xc7, 0xa2, 0x1f, 0x97, 0x06, 0x84, 0x4c, 0xa6, 0xbf, 0x1b, 0x34, 0xcf, 0xe3,
-	0xd7, 0xa5, 0xb8, 0x1e, 0xe6, 0x4c, 0x1d, 0x5d, 0x32, 0x1f, 0xfb, 0x83, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x79, 0xc3, 0x7d, 0xbc, 0x62, 0x04, 0x00, 0x00,
+	// 870 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x89, 0xdd, 0xbc, 0x14, 0x48, 0xa6, 0x56, 0xd9, 0x2c, 0xe9, 0xd6, 0x59, 0xa1,
+	0x34, 0x82, 0x7a, 0xb7, 0x31, 0xad, 0xd4, 0x03, 0x07, 0xb0, 0x90, 0xe0, 0xc0, 0x0f, 0xb3, 0x85,
+	0x1c, 0x38, 0x60, 0x4d, 0xbd, 0xa3, 0xf5, 0xc2, 0x7a, 0x67, 0x3b, 0x33, 0x36, 0x58, 0x55, 0x0f,
+	0xf4, 0x1f, 0x00, 0x09, 0x71, 0x85, 0x33, 0x77, 0xfe, 0x05, 0xa4, 0x1e, 0x2b, 0xb8, 0x70, 0x42,
+	0x28, 0xe1, 0x0f, 0xa9, 0x3c, 0x33, 0xbb, 0xde, 0x64, 0xd7, 0xa9, 0x7d, 0xdb, 0x99, 0xf7, 0x7d,
+	0x6f, 0xbe, 0x7c, 0xef, 0xe9, 0x8b, 0xc1, 0x1e, 0x90, 0x98, 0x70, 0x11, 0x61, 0x8f, 0x47, 0x61,
+	0x82, 0x63, 0x6f, 0x72, 0xec, 0x3d, 0x1a, 0x13, 0x36, 0x75, 0x53, 0x46, 0x05, 0x45, 0x28, 0xab,
+	0xbb, 0xaa, 0xee, 0x4e, 0x8e, 0xad, 0x66, 0x48, 0x43, 0x2a, 0xcb, 0xde, 0xec, 0x4b, 0x21, 0xad,
+	0xfd, 0x90, 0xd2, 0x30, 0x26, 0x1e, 0x4e, 0x23, 0x0f, 0x27, 0x09, 0x15, 0x58, 0x44, 0x34, 0xe1,
+	0xba, 0xba, 0x37, 0xa0, 0x7c, 0x44, 0x79, 0x5f, 0xd1, 0xd4, 0x41, 0x97, 0x6e, 0x56, 0x48, 0x48,
+	0x31, 0xc3, 0xa3, 0xcb, 0x00, 0x5a, 0x8d, 0x02, 0xb4, 0x2a, 0x00, 0xe3, 0x34, 0x64, 0x38, 0x20,
+	0x0a, 0xe1, 0x34, 0x01, 0x7d, 0x3e, 0xfb, 0xab, 0x7a, 0xb2, 0xaf, 0x4f, 0x1e, 0x8d, 0x09, 0x17,
+	0xce, 0x67, 0x70, 0xed, 0xdc, 0x2d, 0x4f, 0x69, 0xc2, 0x09, 0xba, 0x0f, 0x75, 0xf5, 0xbe, 0x69,
+	0xb4, 0x8c, 0xa3, 0xed, 0x8e, 0xe5, 0x96, 0x4d, 0x70, 0x15, 0xa7, 0xbb, 0xf1, 0xec, 0xdf, 0x9b,
+	0x6b, 0xbe, 0xc6, 0x3b, 0x77, 0xc1, 0x94, 0x0d, 0x4f, 0x08, 0xe3, 0x11, 0x4d, 0xbe, 0xc0, 0x71,
+	0x3c, 0xd5, 0x8f, 0x21, 0x13, 0x1a, 0x13, 0x75, 0x2d, 0xdb, 0x6e, 0xf8, 0xd9, 0xd1, 0xf9, 0xc5,
+	0x80, 0xbd, 0x0a, 0x9a, 0x56, 0x73, 0x00, 0x57, 0x27, 0x54, 0x44, 0x49, 0xd8, 0x4f, 0xe9, 0x77,
+	0x84, 0x69, 0xf2, 0xb6, 0xba, 0xeb, 0xcd, 0xae, 0xd0, 0x2d, 0x78, 0x4d, 0x0c, 0x19, 0xe1, 0x43,
+	0x1a, 0x07, 0x1a, 0xb5, 0x2e, 0x51, 0xaf, 0xe6, 0xd7, 0x0a, 0x78, 0x1b, 0x90, 0xa0, 0x02, 0xc7,
+	0xfd, 0x73, 0x1d, 0x6b, 0x12, 0xbb, 0x23, 0x2b, 0x27, 0xf3, 0xb6, 0x8e, 0x09, 0xd7, 0xa5, 0xac,
+	0x0f, 0x89, 0xf8, 0x52, 0xb9, 0x99, 0x19, 0xd7, 0x83, 0xd7, 0x4b, 0x15, 0x2d, 0xf7, 0x1e, 0x34,
+	0xb4, 0xf5, 0xda, 0xbd, 0x37, 0xaa, 0xdc, 0xcb, 0x58, 0x19, 0xd6, 0x79, 0x17, 0x5a, 0x59, 0xc7,
+	0x4f, 0x22, 0xce, 0xa3, 0x24, 0x3c, 0xc1, 0x71, 0x14, 0x60, 0x41, 0x19, 0x7f, 0xb9, 0x83, 0x3e,
+	0x1c, 0x5c, 0xc2, 0xd6, 0xca, 0xda, 0x80, 0x46, 0xaa, 0xd8, 0x9f, 0xe4, 0x55, 0xd3, 0x68, 0xd5,
+	0x8e, 0xb6, 0xfc, 0xdd, 0xd1, 0x45, 0x9a, 0xf3, 0xad, 0x1e, 0xca, 0x03, 0x29, 0xfa, 0xa3, 0x88,
+	0x0b, 0xca, 0xf2, 0x61, 0x7e, 0x0a, 0xbb, 0x79, 0x8f, 0x3e, 0x0e, 0x02, 0x46, 0xb8, 0xda, 0x96,
+	0xad, 0xee, 0xc1, 0x5f, 0x7f, 0xb4, 0x6f, 0xe8, 0x05, 0xcf, 0xdb, 0xbd, 0xaf, 0x20, 0x0f, 0x04,
+	0x8b, 0x92, 0xd0, 0xdf, 0x99, 0x5c, 0xb8, 0x77, 0xbe, 0x06, 0xab, 0xea, 0x31, 0xad, 0xfc, 0x3d,
+	0x68, 0x28, 0xeb, 0x94, 0xdc, 0xed, 0x4e, 0xab, 0xca, 0x53, 0xc5, 0xf5, 0xc9, 0x80, 0xb2, 0x40,
+	0xef, 0x65, 0x46, 0x73, 0xee, 0xc3, 0xbe, 0xec, 0x9f, 0x4f, 0x0b, 0x07, 0x51, 0x42, 0xf8, 0x12,
+	0xd6, 0xfe, 0x59, 0x83, 0x1b, 0x0b, 0xa8, 0x5a, 0xdd, 0x75, 0xa8, 0x0f, 0x49, 0x14, 0x0e, 0x85,
+	0xa4, 0xd6, 0x7c, 0x7d, 0x2a, 0x2d, 0xee, 0xfa, 0x52, 0x8b, 0x5b, 0x5b, 0x61, 0x71, 0x37, 0xaa,
+	0x17, 0x17, 0xdd, 0x81, 0x66, 0x3e, 0xe9, 0x22, 0x7e, 0x53, 0xe2, 0xb3, 0x2d, 0x28, 0x32, 0x9a,
+	0xb0, 0xc9, 0x08, 0x0e, 0xa6, 0x66, 0xbd, 0x65, 0x1c, 0x5d, 0xf1, 0xd5, 0x01, 0x7d, 0x00, 0x5b,
+	0xca, 0xc0, 0x98, 0x04, 0x66, 0x43, 0x3a, 0x7f, 0x58, 0xe5, 0x7c, 0x3e, 0xe6, 0xb9, 0x39, 0x73,
+	0xe2, 0x6c, 0x7a, 0xfa, 0x45, 0xf3, 0xca, 0x4a, 0x3d, 0x32, 0x1a, 0xea, 0x02, 0xa4, 0x8c, 0x7e,
+	0x43, 0x06, 0xb3, 0x44, 0x35, 0xb7, 0x64, 0x13, 0xa7, 0xaa, 0x49, 0xce, 0xed, 0xd1, 0x28, 0x11,
+	0x7e, 0x81, 0xd5, 0x79, 0xda, 0x80, 0x4d, 0x39, 0x47, 0x24, 0xa0, 0xae, 0xc2, 0x0b, 0x55, 0x0a,
+	0x29, 0xe7, 0xa4, 0x75, 0xeb, 0xa5, 0x38, 0xb5, 0x0a, 0xce, 0xde, 0xd3, 0xbf, 0xff, 0xff, 0x79,
+	0xfd, 0x1a, 0xda, 0x2d, 0x45, 0x39, 0xfa, 0xd1, 0x80, 0xab, 0xc5, 0x7c, 0x43, 0xb7, 0x17, 0x36,
+	0xad, 0x48, 0x4f, 0xab, 0xbd, 0x24, 0x5a, 0x0b, 0x71, 0xa4, 0x90, 0x7d, 0x64, 0x15, 0x84, 0x88,
+	0x19, 0xc2, 0x7b, 0xac, 0x17, 0xfb, 0x09, 0xfa, 0xc1, 0x00, 0x98, 0x07, 0x18, 0x7a, 0x6b, 0xe1,
+	0x0b, 0xa5, 0xfc, 0xb3, 0xde, 0x5e, 0x0a, 0xab, 0xb5, 0x58, 0x52, 0x4b, 0x13, 0xa1, 0xf2, 0x7f,
+	0x27, 0xf4, 0xbb, 0x01, 0xcd, 0xaa, 0xd0, 0x42, 0x77, 0x2f, 0x7b, 0x61, 0x51, 0x42, 0x5a, 0xf7,
+	0x56, 0x64, 0x69, 0x85, 0x6f, 0x4a, 0x85, 0x36, 0xda, 0x2f, 0x28, 0xd4, 0xbb, 0x57, 0xf0, 0xeb,
+	0x57, 0x03, 0x5e, 0x39, 0x97, 0x4f, 0x68, 0xf1, 0x50, 0xaa, 0x42, 0xd3, 0x72, 0x97, 0x85, 0x6b,
+	0x59, 0xae, 0x94, 0x75, 0x84, 0x0e, 0x0b, 0xb2, 0x86, 0x0a, 0xe3, 0x3d, 0x2e, 0xc5, 0xef, 0x13,
+	0xf4, 0x9b, 0x01, 0x3b, 0x17, 0x53, 0x0a, 0xdd, 0x59, 0xf8, 0xe8, 0x82, 0x2c, 0xb4, 0x8e, 0x57,
+	0x60, 0x68, 0xa5, 0x87, 0x52, 0x69, 0x0b, 0xd9, 0x05, 0xa5, 0x2c, 0x43, 0xcd, 0x2d, 0xec, 0x7e,
+	0xfc, 0xec, 0xd4, 0x36, 0x9e, 0x9f, 0xda, 0xc6, 0x7f, 0xa7, 0xb6, 0xf1, 0xd3, 0x99, 0xbd, 0xf6,
+	0xfc, 0xcc, 0x5e, 0xfb, 0xe7, 0xcc, 0x5e, 0xfb, 0xaa, 0x13, 0x46, 0x62, 0x38, 0x7e, 0xe8, 0x0e,
+	0xe8, 0xc8, 0xcb, 0x9e, 0xa7, 0x2c, 0xcc, 0xbf, 0xdb, 0x38, 0x4d, 0xbd, 0xef, 0xb3, 0xf6, 0x62,
+	0x9a, 0x12, 0xfe, 0xb0, 0x2e, 0x7f, 0xdb, 0xbc, 0xf3, 0x22, 0x00, 0x00, 0xff, 0xff, 0xba, 0xb2,
+	0x93, 0xea, 0xc4, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -365,6 +735,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// VersionTally enables a client to query for the tally of voting power that
 	// has signalled for a particular version.
 	VersionTally(ctx context.Context, in *QueryVersionTallyRequest, opts ...grpc.CallOption) (*QueryVersionTallyResponse, error)
@@ -374,6 +746,13 @@ type QueryClient interface {
 	// GetMissingValidators enables a client to query for the validators that
 	// have not yet signalled for a particular version
 	GetMissingValidators(ctx context.Context, in *QueryGetMissingValidatorsRequest, opts ...grpc.CallOption) (*QueryGetMissingValidatorsResponse, error)
+	// SignalHistory enables a client to query for the recent signals of a
+	// validator.
+	SignalHistory(ctx context.Context, in *QuerySignalHistoryRequest, opts ...grpc.CallOption) (*QuerySignalHistoryResponse, error)
+	// UpgradeReadiness enables a client to query for how close the network is to
+	// the threshold for a particular version, which validators are missing and
+	// how the tally changes as signals expire.
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
 }
 
 type queryClient struct {
@@ -384,6 +763,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) VersionTally(ctx context.Context, in *QueryVersionTallyRequest, opts ...grpc.CallOption) (*QueryVersionTallyResponse, error) {
 	out := new(QueryVersionTallyResponse)
 	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/VersionTally", in, out, opts...)
@@ -411,8 +799,28 @@ func (c *queryClient) GetMissingValidators(ctx context.Context, in *QueryGetMiss
 	return out, nil
 }
 
+func (c *queryClient) SignalHistory(ctx context.Context, in *QuerySignalHistoryRequest, opts ...grpc.CallOption) (*QuerySignalHistoryResponse, error) {
+	out := new(QuerySignalHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/SignalHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/UpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// VersionTally enables a client to query for the tally of voting power that
 	// has signalled for a particular version.
 	VersionTally(context.Context, *QueryVersionTallyRequest) (*QueryVersionTallyResponse, error)
@@ -422,12 +830,22 @@ type QueryServer interface {
 	// GetMissingValidators enables a client to query for the validators that
 	// have not yet signalled for a particular version
 	GetMissingValidators(context.Context, *QueryGetMissingValidatorsRequest) (*QueryGetMissingValidatorsResponse, error)
+	// SignalHistory enables a client to query for the recent signals of a
+	// validator.
+	SignalHistory(context.Context, *QuerySignalHistoryRequest) (*QuerySignalHistoryResponse, error)
+	// UpgradeReadiness enables a client to query for how close the network is to
+	// the threshold for a particular version, which validators are missing and
+	// how the tally changes as signals expire.
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) VersionTally(ctx context.Context, req *QueryVersionTallyRequest) (*QueryVersionTallyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VersionTally not implemented")
 }
@@ -437,11 +855,35 @@ func (*UnimplementedQueryServer) GetUpgrade(ctx context.Context, req *QueryGetUp
 func (*UnimplementedQueryServer) GetMissingValidators(ctx context.Context, req *QueryGetMissingValidatorsRequest) (*QueryGetMissingValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMissingValidators not implemented")
 }
+func (*UnimplementedQueryServer) SignalHistory(ctx context.Context, req *QuerySignalHistoryRequest) (*QuerySignalHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalHistory not implemented")
+}
+func (*UnimplementedQueryServer) UpgradeReadiness(ctx context.Context, req *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_VersionTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVersionTallyRequest)
 	if err := dec(in); err != nil {
@@ -496,26 +938,130 @@ func _Query_GetMissingValidators_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.signal.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "VersionTally",
-			Handler:    _Query_VersionTally_Handler,
-		},
-		{
-			MethodName: "GetUpgrade",
-			Handler:    _Query_GetUpgrade_Handler,
-		},
-		{
-			MethodName: "GetMissingValidators",
-			Handler:    _Query_GetMissingValidators_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/signal/v1/query.proto",
+func _Query_SignalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/SignalHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalHistory(ctx, req.(*QuerySignalHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/UpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.signal.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "VersionTally",
+			Handler:    _Query_VersionTally_Handler,
+		},
+		{
+			MethodName: "GetUpgrade",
+			Handler:    _Query_GetUpgrade_Handler,
+		},
+		{
+			MethodName: "GetMissingValidators",
+			Handler:    _Query_GetMissingValidators_Handler,
+		},
+		{
+			MethodName: "SignalHistory",
+			Handler:    _Query_SignalHistory_Handler,
+		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/signal/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *QueryVersionTallyRequest) Marshal() (dAtA []byte, err error) {
@@ -702,6 +1248,201 @@ func (m *QueryGetMissingValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySignalHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projection) > 0 {
+		for iNdEx := len(m.Projection) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projection[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Missing) > 0 {
+		for iNdEx := len(m.Missing) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Missing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Signalled) > 0 {
+		for iNdEx := len(m.Signalled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signalled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MissingVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissingVotingPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ThresholdPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ThresholdPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -713,6 +1454,26 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryVersionTallyRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -792,11 +1553,229 @@ func (m *QueryGetMissingValidatorsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QuerySignalHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUpgradeReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	if m.MissingVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.MissingVotingPower))
+	}
+	if m.Ready {
+		n += 2
+	}
+	if len(m.Signalled) > 0 {
+		for _, e := range m.Signalled {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Missing) > 0 {
+		for _, e := range m.Missing {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Projection) > 0 {
+		for _, e := range m.Projection {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -919,7 +1898,300 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
 			}
-			m.ThresholdPower = 0
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMissingValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMissingValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMissingValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMissingValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -929,30 +2201,24 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ThresholdPower |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.TotalVotingPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalVotingPower |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingValidators = append(m.MissingValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -974,7 +2240,7 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -997,12 +2263,44 @@ func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUpgradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1024,7 +2322,7 @@ func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1047,15 +2345,15 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1082,10 +2380,8 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Upgrade == nil {
-				m.Upgrade = &Upgrade{}
-			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signals = append(m.Signals, SignalRecord{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1110,7 +2406,7 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeReadinessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1133,10 +2429,10 @@ func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMissingValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMissingValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1179,7 +2475,7 @@ func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1202,17 +2498,132 @@ func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMissingValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMissingValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+			}
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingVotingPower", wireType)
+			}
+			m.MissingVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signalled", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1222,23 +2633,93 @@ func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissingValidators = append(m.MissingValidators, string(dAtA[iNdEx:postIndex]))
+			m.Signalled = append(m.Signalled, &ValidatorReadiness{})
+			if err := m.Signalled[len(m.Signalled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Missing = append(m.Missing, &ValidatorReadiness{})
+			if err := m.Missing[len(m.Missing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projection = append(m.Projection, &ReadinessPoint{})
+			if err := m.Projection[len(m.Projection)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
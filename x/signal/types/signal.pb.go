@@ -0,0 +1,958 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/signal/v1/signal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SignalRecord is a version signal of a validator.
+type SignalRecord struct {
+	// version is the app version that was signalled.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the height at which the version was signalled.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the version was signalled.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *SignalRecord) Reset()         { *m = SignalRecord{} }
+func (m *SignalRecord) String() string { return proto.CompactTextString(m) }
+func (*SignalRecord) ProtoMessage()    {}
+func (*SignalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81f9c6693a696b96, []int{0}
+}
+func (m *SignalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalRecord.Merge(m, src)
+}
+func (m *SignalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalRecord proto.InternalMessageInfo
+
+func (m *SignalRecord) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// ValidatorReadiness is the signal of a bonded validator for an upgrade.
+type ValidatorReadiness struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Moniker          string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	VotingPower      int64  `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// signal is the latest unexpired signal of the validator. It is empty if
+	// the validator hasn't signalled or its signal expired.
+	Signal *SignalRecord `protobuf:"bytes,4,opt,name=signal,proto3" json:"signal,omitempty"`
+	// expiry_height is the height at which the signal expires. It is zero if
+	// the signal doesn't expire.
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *ValidatorReadiness) Reset()         { *m = ValidatorReadiness{} }
+func (m *ValidatorReadiness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReadiness) ProtoMessage()    {}
+func (*ValidatorReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81f9c6693a696b96, []int{1}
+}
+func (m *ValidatorReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReadiness.Merge(m, src)
+}
+func (m *ValidatorReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReadiness proto.InternalMessageInfo
+
+func (m *ValidatorReadiness) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorReadiness) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *ValidatorReadiness) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *ValidatorReadiness) GetSignal() *SignalRecord {
+	if m != nil {
+		return m.Signal
+	}
+	return nil
+}
+
+func (m *ValidatorReadiness) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// ReadinessPoint is the voting power that signals for a version from a height
+// on if no signal is renewed.
+type ReadinessPoint struct {
+	Height      int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *ReadinessPoint) Reset()         { *m = ReadinessPoint{} }
+func (m *ReadinessPoint) String() string { return proto.CompactTextString(m) }
+func (*ReadinessPoint) ProtoMessage()    {}
+func (*ReadinessPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81f9c6693a696b96, []int{2}
+}
+func (m *ReadinessPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadinessPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadinessPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadinessPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessPoint.Merge(m, src)
+}
+func (m *ReadinessPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadinessPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadinessPoint proto.InternalMessageInfo
+
+func (m *ReadinessPoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReadinessPoint) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SignalRecord)(nil), "celestia.signal.v1.SignalRecord")
+	proto.RegisterType((*ValidatorReadiness)(nil), "celestia.signal.v1.ValidatorReadiness")
+	proto.RegisterType((*ReadinessPoint)(nil), "celestia.signal.v1.ReadinessPoint")
+}
+
+func init() { proto.RegisterFile("celestia/signal/v1/signal.proto", fileDescriptor_81f9c6693a696b96) }
+
+var fileDescriptor_81f9c6693a696b96 = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x8d, 0x93, 0x10, 0xa8, 0x1b, 0x10, 0x58, 0x08, 0x2d, 0x91, 0xd8, 0xa4, 0xe1, 0x92, 0x4b,
+	0xbd, 0x6a, 0xb9, 0xf4, 0x4a, 0x4e, 0x48, 0x20, 0x54, 0xb9, 0x88, 0x03, 0x97, 0xc8, 0xd9, 0x35,
+	0x8e, 0xc5, 0xee, 0xce, 0xca, 0x76, 0x97, 0x96, 0x13, 0x9f, 0xd0, 0x8f, 0xe1, 0x23, 0x7a, 0xac,
+	0x38, 0x71, 0x02, 0x94, 0xfc, 0x08, 0x5a, 0x7b, 0x1d, 0x85, 0xf6, 0x36, 0xef, 0xf9, 0xcd, 0xec,
+	0x9b, 0x37, 0x8b, 0xc7, 0xa9, 0xc8, 0x85, 0xb1, 0x8a, 0x27, 0x46, 0xc9, 0x92, 0xe7, 0x49, 0x7d,
+	0xd4, 0x56, 0xb4, 0xd2, 0x60, 0x81, 0x90, 0x20, 0xa0, 0x2d, 0x5d, 0x1f, 0x8d, 0x9e, 0x4a, 0x90,
+	0xe0, 0x9e, 0x93, 0xa6, 0xf2, 0xca, 0xd1, 0x58, 0x02, 0xc8, 0x5c, 0x24, 0x0e, 0x2d, 0xcf, 0x3f,
+	0x27, 0x56, 0x15, 0xc2, 0x58, 0x5e, 0x54, 0xad, 0xe0, 0x79, 0x0a, 0xa6, 0x00, 0xb3, 0xf0, 0x9d,
+	0x1e, 0xf8, 0xa7, 0xe9, 0x37, 0x3c, 0x3c, 0x73, 0xe3, 0x99, 0x48, 0x41, 0x67, 0x24, 0xc2, 0xf7,
+	0x6b, 0xa1, 0x8d, 0x82, 0x32, 0x42, 0x13, 0x34, 0xeb, 0xb3, 0x00, 0xc9, 0x33, 0x3c, 0x58, 0x09,
+	0x25, 0x57, 0x36, 0xea, 0x4e, 0xd0, 0xac, 0xc7, 0x5a, 0x44, 0x4e, 0x70, 0xbf, 0xf9, 0x5e, 0xd4,
+	0x9b, 0xa0, 0xd9, 0xfe, 0xf1, 0x88, 0x7a, 0x33, 0x34, 0x98, 0xa1, 0x1f, 0x82, 0x99, 0xf9, 0x83,
+	0xeb, 0xdf, 0xe3, 0xce, 0xd5, 0x9f, 0x31, 0x62, 0xae, 0x63, 0xfa, 0xbd, 0x8b, 0xc9, 0x47, 0x9e,
+	0xab, 0x8c, 0x5b, 0xd0, 0x4c, 0xf0, 0x4c, 0x95, 0xc2, 0x18, 0xf2, 0x1e, 0x3f, 0xa9, 0x03, 0xbb,
+	0xe0, 0x59, 0xa6, 0x85, 0x31, 0xce, 0xcc, 0xde, 0xfc, 0xe0, 0xe7, 0x8f, 0xc3, 0x17, 0xad, 0xff,
+	0x6d, 0xe7, 0x6b, 0x2f, 0x39, 0xb3, 0x5a, 0x95, 0x92, 0x3d, 0xae, 0x6f, 0xf1, 0xcd, 0x4a, 0x05,
+	0x94, 0xea, 0x8b, 0xd0, 0xce, 0xf9, 0x1e, 0x0b, 0x90, 0x1c, 0xe0, 0x61, 0x0d, 0x56, 0x95, 0x72,
+	0x51, 0xc1, 0x57, 0xa1, 0xdd, 0x0a, 0x3d, 0xb6, 0xef, 0xb9, 0xd3, 0x86, 0x22, 0x27, 0x78, 0xe0,
+	0xe3, 0x8f, 0xfa, 0x6e, 0xbf, 0x09, 0xbd, 0x7b, 0x16, 0xba, 0x9b, 0x20, 0x6b, 0xf5, 0xe4, 0x25,
+	0x7e, 0x28, 0x2e, 0x2a, 0xa5, 0x2f, 0x17, 0x6d, 0x6c, 0xf7, 0xdc, 0xf4, 0xa1, 0x27, 0xdf, 0x38,
+	0x6e, 0xfa, 0x16, 0x3f, 0xda, 0x2e, 0x7e, 0x0a, 0xaa, 0xb4, 0x3b, 0x31, 0xa3, 0xff, 0x62, 0xbe,
+	0xed, 0xb5, 0xeb, 0xae, 0xb3, 0xeb, 0x75, 0xfe, 0xee, 0x7a, 0x1d, 0xa3, 0x9b, 0x75, 0x8c, 0xfe,
+	0xae, 0x63, 0x74, 0xb5, 0x89, 0x3b, 0x37, 0x9b, 0xb8, 0xf3, 0x6b, 0x13, 0x77, 0x3e, 0x1d, 0x4b,
+	0x65, 0x57, 0xe7, 0x4b, 0x9a, 0x42, 0x91, 0x04, 0xff, 0xa0, 0xe5, 0xb6, 0x3e, 0xe4, 0x55, 0x95,
+	0x5c, 0x84, 0x3f, 0xd1, 0x5e, 0x56, 0xc2, 0x2c, 0x07, 0xee, 0x82, 0xaf, 0xfe, 0x05, 0x00, 0x00,
+	0xff, 0xff, 0xcf, 0x01, 0x00, 0xd3, 0xa9, 0x02, 0x00, 0x00,
+}
+
+func (m *SignalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSignal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Signal != nil {
+		{
+			size, err := m.Signal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSignal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintSignal(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSignal(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadinessPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadinessPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSignal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSignal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSignal(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSignal(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSignal(uint64(l))
+	return n
+}
+
+func (m *ValidatorReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSignal(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovSignal(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovSignal(uint64(m.VotingPower))
+	}
+	if m.Signal != nil {
+		l = m.Signal.Size()
+		n += 1 + l + sovSignal(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovSignal(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *ReadinessPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSignal(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovSignal(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func sovSignal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSignal(x uint64) (n int) {
+	return sovSignal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSignal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSignal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSignal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSignal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSignal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSignal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSignal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signal == nil {
+				m.Signal = &SignalRecord{}
+			}
+			if err := m.Signal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSignal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadinessPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSignal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSignal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSignal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSignal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSignal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSignal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSignal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSignal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSignal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSignal = fmt.Errorf("proto: unexpected end of group")
+)